@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func (s *Service) ListFailedAsyncCalls(ctx context.Context, req *connect.Request[ftlv1.ListFailedAsyncCallsRequest]) (*connect.Response[ftlv1.ListFailedAsyncCallsResponse], error) {
+	var origin optional.Option[dal.AsyncCallOrigin]
+	if req.Msg.Origin != nil {
+		o, err := dal.ParseAsyncCallOrigin(*req.Msg.Origin)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		origin = optional.Some(o)
+	}
+	calls, err := s.dal.GetFailedAsyncCalls(ctx, origin)
+	if err != nil {
+		return nil, fmt.Errorf("could not list failed async calls: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListFailedAsyncCallsResponse{
+		Calls: slices.Map(calls, asyncCallRecordToProto),
+	}), nil
+}
+
+func (s *Service) GetAsyncCall(ctx context.Context, req *connect.Request[ftlv1.GetAsyncCallRequest]) (*connect.Response[ftlv1.GetAsyncCallResponse], error) {
+	call, err := s.dal.GetAsyncCallRecord(ctx, req.Msg.Id)
+	if errors.Is(err, dal.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("async call %d not found", req.Msg.Id))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get async call: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.GetAsyncCallResponse{Call: asyncCallRecordToProto(call)}), nil
+}
+
+func (s *Service) RequeueAsyncCall(ctx context.Context, req *connect.Request[ftlv1.RequeueAsyncCallRequest]) (*connect.Response[ftlv1.RequeueAsyncCallResponse], error) {
+	err := s.dal.RequeueFailedAsyncCall(ctx, req.Msg.Id)
+	if errors.Is(err, dal.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no failed async call %d", req.Msg.Id))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not requeue async call: %w", err)
+	}
+	log.FromContext(ctx).Infof("Requeued failed async call %d", req.Msg.Id)
+	return connect.NewResponse(&ftlv1.RequeueAsyncCallResponse{}), nil
+}
+
+func (s *Service) DiscardAsyncCall(ctx context.Context, req *connect.Request[ftlv1.DiscardAsyncCallRequest]) (*connect.Response[ftlv1.DiscardAsyncCallResponse], error) {
+	err := s.dal.DiscardFailedAsyncCall(ctx, req.Msg.Id)
+	if errors.Is(err, dal.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no failed async call %d", req.Msg.Id))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not discard async call: %w", err)
+	}
+	log.FromContext(ctx).Infof("Discarded failed async call %d", req.Msg.Id)
+	return connect.NewResponse(&ftlv1.DiscardAsyncCallResponse{}), nil
+}
+
+func asyncCallRecordToProto(call dal.AsyncCallRecord) *ftlv1.AsyncCall {
+	out := &ftlv1.AsyncCall{
+		Id:           call.ID,
+		CreatedAt:    timestamppb.New(call.CreatedAt),
+		State:        string(call.State),
+		Origin:       string(call.Origin),
+		OriginKey:    call.OriginKey,
+		Verb:         call.Verb.ToProto().(*schemapb.Ref), //nolint:forcetypeassert
+		Request:      call.Request,
+		ScheduledAt:  timestamppb.New(call.ScheduledAt),
+		RetryAttempt: int32(call.RetryAttempt),
+	}
+	if response, ok := call.Response.Get(); ok {
+		out.Response = response
+	}
+	if callError, ok := call.Error.Get(); ok {
+		out.Error = &callError
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func (s *Service) PromoteCanary(ctx context.Context, req *connect.Request[ftlv1.PromoteCanaryRequest]) (*connect.Response[ftlv1.PromoteCanaryResponse], error) {
+	key, err := model.ParseDeploymentKey(req.Msg.DeploymentKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	err = s.dal.PromoteCanaryDeployment(ctx, key)
+	if err != nil {
+		return nil, canaryError(err)
+	}
+	s.getDeploymentLogger(ctx, key).Infof("Promoted canary deployment %s", key)
+	s.cronJobs.CreatedOrReplacedDeloyment(ctx, key)
+	return connect.NewResponse(&ftlv1.PromoteCanaryResponse{}), nil
+}
+
+func (s *Service) AbortCanary(ctx context.Context, req *connect.Request[ftlv1.AbortCanaryRequest]) (*connect.Response[ftlv1.AbortCanaryResponse], error) {
+	key, err := model.ParseDeploymentKey(req.Msg.DeploymentKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	err = s.dal.AbortCanaryDeployment(ctx, key)
+	if err != nil {
+		return nil, canaryError(err)
+	}
+	s.getDeploymentLogger(ctx, key).Infof("Aborted canary deployment %s", key)
+	return connect.NewResponse(&ftlv1.AbortCanaryResponse{}), nil
+}
+
+func canaryError(err error) error {
+	switch {
+	case errors.Is(err, dal.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, dal.ErrConflict):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, dal.ErrConstraint):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return fmt.Errorf("canary deployment failed: %w", err)
+	}
+}
+
+func canaryWeightToProto(weight optional.Option[int]) *int32 {
+	if weight, ok := weight.Get(); ok {
+		w := int32(weight)
+		return &w
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"connectrpc.com/connect"
@@ -24,15 +25,37 @@ import (
 	"github.com/TBD54566975/ftl/internal/slices"
 )
 
+// CallFunc calls a verb, optionally routing the call to a specific deployment.
+type CallFunc func(
+	ctx context.Context,
+	req *connect.Request[ftlv1.CallRequest],
+	key optional.Option[model.RequestKey],
+	deployment optional.Option[model.DeploymentKey],
+	sourceAddress string,
+) (*connect.Response[ftlv1.CallResponse], error)
+
+// IngressFunc handles an HTTP ingress request, optionally routing verb calls
+// to a specific deployment.
+type IngressFunc func(
+	w http.ResponseWriter,
+	r *http.Request,
+	key model.RequestKey,
+	deployment optional.Option[model.DeploymentKey],
+)
+
 type ConsoleService struct {
-	dal *dal.DAL
+	dal     *dal.DAL
+	call    CallFunc
+	ingress IngressFunc
 }
 
 var _ pbconsoleconnect.ConsoleServiceHandler = (*ConsoleService)(nil)
 
-func NewConsoleService(dal *dal.DAL) *ConsoleService {
+func NewConsoleService(dal *dal.DAL, call CallFunc, ingress IngressFunc) *ConsoleService {
 	return &ConsoleService{
-		dal: dal,
+		dal:     dal,
+		call:    call,
+		ingress: ingress,
 	}
 }
 
@@ -143,7 +166,7 @@ func (c *ConsoleService) GetModules(ctx context.Context, req *connect.Request[pb
 					Config: c,
 				})
 
-			case *schema.Database, *schema.Enum, *schema.TypeAlias, *schema.FSM:
+			case *schema.Database, *schema.Enum, *schema.TypeAlias, *schema.FSM, *schema.Topic, *schema.Subscription:
 			}
 		}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/alecthomas/concurrency"
 	"github.com/alecthomas/kong"
 	"github.com/alecthomas/types/optional"
+	"github.com/gorilla/websocket"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jellydator/ttlcache/v3"
@@ -43,7 +44,6 @@ import (
 	"github.com/TBD54566975/ftl/backend/schema"
 	cf "github.com/TBD54566975/ftl/common/configuration"
 	frontend "github.com/TBD54566975/ftl/frontend"
-	"github.com/TBD54566975/ftl/internal/cors"
 	"github.com/TBD54566975/ftl/internal/log"
 	ftlmaps "github.com/TBD54566975/ftl/internal/maps"
 	"github.com/TBD54566975/ftl/internal/model"
@@ -57,24 +57,29 @@ import (
 
 // CommonConfig between the production controller and development server.
 type CommonConfig struct {
-	AllowOrigins   []*url.URL    `help:"Allow CORS requests to ingress endpoints from these origins." env:"FTL_CONTROLLER_ALLOW_ORIGIN"`
+	AllowOrigins   []*url.URL    `help:"Allow CORS requests to ingress endpoints from these origins, unless overridden per verb or module." env:"FTL_CONTROLLER_ALLOW_ORIGIN"`
 	NoConsole      bool          `help:"Disable the console."`
 	IdleRunners    int           `help:"Number of idle runners to keep around (not supported in production)." default:"3"`
 	WaitFor        []string      `help:"Wait for these modules to be deployed before becoming ready." placeholder:"MODULE"`
 	CronJobTimeout time.Duration `help:"Timeout for cron jobs." default:"5m"`
+	IngressCache   int           `help:"Maximum total size in bytes of cached ingress responses, 0 to disable caching." default:"67108864"`
 }
 
 type Config struct {
-	Bind                         *url.URL            `help:"Socket to bind to." default:"http://localhost:8892" env:"FTL_CONTROLLER_BIND"`
-	Key                          model.ControllerKey `help:"Controller key (auto)."`
-	DSN                          string              `help:"DAL DSN." default:"postgres://localhost:54320/ftl?sslmode=disable&user=postgres&password=secret" env:"FTL_CONTROLLER_DSN"`
-	Advertise                    *url.URL            `help:"Endpoint the Controller should advertise (must be unique across the cluster, defaults to --bind if omitted)." env:"FTL_CONTROLLER_ADVERTISE"`
-	ConsoleURL                   *url.URL            `help:"The public URL of the console (for CORS)." env:"FTL_CONTROLLER_CONSOLE_URL"`
-	ContentTime                  time.Time           `help:"Time to use for console resource timestamps." default:"${timestamp=1970-01-01T00:00:00Z}"`
-	RunnerTimeout                time.Duration       `help:"Runner heartbeat timeout." default:"10s"`
-	ControllerTimeout            time.Duration       `help:"Controller heartbeat timeout." default:"10s"`
-	DeploymentReservationTimeout time.Duration       `help:"Deployment reservation timeout." default:"120s"`
-	ArtefactChunkSize            int                 `help:"Size of each chunk streamed to the client." default:"1048576"`
+	Bind                         *url.URL                 `help:"Socket to bind to." default:"http://localhost:8892" env:"FTL_CONTROLLER_BIND"`
+	Key                          model.ControllerKey      `help:"Controller key (auto)."`
+	DSN                          string                   `help:"DAL DSN." default:"postgres://localhost:54320/ftl?sslmode=disable&user=postgres&password=secret" env:"FTL_CONTROLLER_DSN"`
+	Advertise                    *url.URL                 `help:"Endpoint the Controller should advertise (must be unique across the cluster, defaults to --bind if omitted)." env:"FTL_CONTROLLER_ADVERTISE"`
+	ConsoleURL                   *url.URL                 `help:"The public URL of the console (for CORS)." env:"FTL_CONTROLLER_CONSOLE_URL"`
+	ContentTime                  time.Time                `help:"Time to use for console resource timestamps." default:"${timestamp=1970-01-01T00:00:00Z}"`
+	RunnerTimeout                time.Duration            `help:"Runner heartbeat timeout." default:"10s"`
+	ControllerTimeout            time.Duration            `help:"Controller heartbeat timeout." default:"10s"`
+	DeploymentReservationTimeout time.Duration            `help:"Deployment reservation timeout." default:"120s"`
+	ArtefactChunkSize            int                      `help:"Size of each chunk streamed to the client." default:"1048576"`
+	ArtefactDir                  string                   `help:"Store artefact content in this directory rather than the database." env:"FTL_CONTROLLER_ARTEFACT_DIR"`
+	ArtefactGCGracePeriod        time.Duration            `help:"Minimum age of unreferenced artefacts before they are garbage collected." default:"1h"`
+	EventRetention               map[string]time.Duration `help:"Maximum age of events by type (log or call), or by MODULE.TYPE for a single module, eg. log=168h,call=720h. Events are retained forever by default." mapsep:"," placeholder:"TYPE=AGE,…" env:"FTL_CONTROLLER_EVENT_RETENTION"`
+	EventArchiveDir              string                   `help:"Archive expired events to gzipped JSONL files in this directory before deleting them." env:"FTL_CONTROLLER_EVENT_ARCHIVE_DIR"`
 	CommonConfig
 }
 
@@ -114,7 +119,15 @@ func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScali
 	if err != nil {
 		return err
 	}
-	dal, err := dal.New(ctx, conn)
+	var dalOptions []dal.Option
+	if config.ArtefactDir != "" {
+		store, err := dal.NewFilesystemArtefactStore(config.ArtefactDir)
+		if err != nil {
+			return err
+		}
+		dalOptions = append(dalOptions, dal.WithArtefactStore(store))
+	}
+	dal, err := dal.New(ctx, conn, dalOptions...)
 	if err != nil {
 		return err
 	}
@@ -125,18 +138,16 @@ func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScali
 	}
 	logger.Debugf("Listening on %s", config.Bind)
 
-	console := NewConsoleService(dal)
+	console := NewConsoleService(dal, svc.callDeploymentWithRequest, svc.replayIngress)
 
 	ingressHandler := http.StripPrefix("/ingress", svc)
-	if len(config.AllowOrigins) > 0 {
-		ingressHandler = cors.Middleware(slices.Map(config.AllowOrigins, func(u *url.URL) string { return u.String() }), ingressHandler)
-	}
 
 	return rpc.Serve(ctx, config.Bind,
 		rpc.GRPC(ftlv1connect.NewVerbServiceHandler, svc),
 		rpc.GRPC(ftlv1connect.NewControllerServiceHandler, svc),
 		rpc.GRPC(pbconsoleconnect.NewConsoleServiceHandler, console),
 		rpc.HTTP("/ingress/", ingressHandler),
+		rpc.HTTP("/_ftl/openapi.json", http.HandlerFunc(svc.serveOpenAPI)),
 		rpc.HTTP("/", consoleHandler),
 	)
 }
@@ -170,9 +181,13 @@ type Service struct {
 	// Complete schema synchronised from the database.
 	schema atomic.Value[*schema.Schema]
 
-	routes        atomic.Value[map[string][]dal.Route]
-	config        Config
-	runnerScaling scaling.RunnerScaling
+	routes         atomic.Value[map[string][]dal.Route]
+	authenticator  *ingress.Authenticator
+	ingressCache   *ingress.ResponseCache
+	connections    *ingress.Connections
+	config         Config
+	runnerScaling  scaling.RunnerScaling
+	eventRetention []dal.EventRetentionPolicy
 
 	increaseReplicaFailures map[string]int
 }
@@ -191,6 +206,11 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 		config.ControllerTimeout = time.Second * 5
 	}
 
+	eventRetention, err := dal.ParseEventRetentionPolicies(config.EventRetention)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event retention: %w", err)
+	}
+
 	svc := &Service{
 		tasks:                   scheduledtask.New(ctx, key, db),
 		dal:                     db,
@@ -199,8 +219,14 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 		clients:                 ttlcache.New(ttlcache.WithTTL[string, clients](time.Minute)),
 		config:                  config,
 		runnerScaling:           runnerScaling,
+		eventRetention:          eventRetention,
+		authenticator:           ingress.NewAuthenticator(),
 		increaseReplicaFailures: map[string]int{},
 	}
+	if config.IngressCache > 0 {
+		svc.ingressCache = ingress.NewResponseCache(config.IngressCache)
+	}
+	svc.connections = ingress.NewConnections(key, db, svc.callWithRequest)
 	svc.routes.Store(map[string][]dal.Route{})
 	svc.schema.Store(&schema.Schema{})
 
@@ -209,6 +235,10 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 	svc.controllerListListeners = append(svc.controllerListListeners, cronSvc)
 
 	go svc.syncSchema(ctx)
+	if svc.ingressCache != nil {
+		go svc.invalidateIngressCache(ctx)
+	}
+	go svc.deliverConnectionMessages(ctx)
 
 	// Use min, max backoff if we are running in production, otherwise use
 	// (1s, 1s) (or develBackoff). Will also wrap the job such that it its next
@@ -237,6 +267,7 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 	svc.tasks.Parallel(maybeDevelTask(svc.heartbeatController, time.Second, time.Second*3, time.Second*5))
 	svc.tasks.Parallel(maybeDevelTask(svc.updateControllersList, time.Second, time.Second*5, time.Second*5))
 	svc.tasks.Parallel(maybeDevelTask(svc.executeAsyncCalls, time.Second, time.Second*5, time.Second*10))
+	svc.tasks.Parallel(maybeDevelTask(svc.progressSubscriptions, time.Second, time.Second, time.Second*5))
 
 	// This should be a singleton task, but because this is the task that
 	// actually expires the leases used to run singleton tasks, it must be
@@ -249,16 +280,57 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 	svc.tasks.Singleton(maybeDevelTask(svc.releaseExpiredReservations, time.Second*2, time.Second, time.Second*20))
 	svc.tasks.Singleton(maybeDevelTask(svc.reconcileDeployments, time.Second*2, time.Second, time.Second*5))
 	svc.tasks.Singleton(maybeDevelTask(svc.reconcileRunners, time.Second*2, time.Second, time.Second*5))
+	svc.tasks.Singleton(maybeDevelTask(svc.gcArtefacts, time.Second*2, time.Minute, time.Minute*5))
+	svc.tasks.Singleton(maybeDevelTask(svc.pruneRateLimits, time.Second*2, time.Minute, time.Minute*5))
+	if len(eventRetention) > 0 {
+		svc.tasks.Singleton(maybeDevelTask(svc.pruneEvents, time.Second*2, time.Minute, time.Minute*5))
+	}
 	return svc, nil
 }
 
 func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	routes, err := s.dal.GetIngressRoutes(r.Context(), r.Method)
-	if err != nil {
-		if errors.Is(err, dal.ErrNotFound) {
-			http.NotFound(w, r)
-			return
-		}
+	requestKey := model.NewRequestKey(model.OriginIngress, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	s.serveIngress(w, r, requestKey, ingress.Handler{
+		AllowOrigins:  slices.Map(s.config.AllowOrigins, func(u *url.URL) string { return u.String() }),
+		Authenticator: s.authenticator,
+		Cache:         s.ingressCache,
+		Call:          s.callWithRequest,
+		CallStream:    s.callStreamWithRequest,
+	})
+}
+
+// replayIngress handles a replayed HTTP ingress request, routing verb calls to
+// the given deployment of their module if set.
+//
+// Replays bypass the response cache.
+func (s *Service) replayIngress(w http.ResponseWriter, r *http.Request, requestKey model.RequestKey, deployment optional.Option[model.DeploymentKey]) {
+	s.serveIngress(w, r, requestKey, ingress.Handler{
+		AllowOrigins:  slices.Map(s.config.AllowOrigins, func(u *url.URL) string { return u.String() }),
+		Authenticator: s.authenticator,
+		Call: func(ctx context.Context, req *connect.Request[ftlv1.CallRequest], key optional.Option[model.RequestKey], sourceAddress string) (*connect.Response[ftlv1.CallResponse], error) {
+			return s.callDeploymentWithRequest(ctx, req, key, deployment, sourceAddress)
+		},
+		CallStream: func(ctx context.Context, req *connect.Request[ftlv1.CallRequest], key optional.Option[model.RequestKey], sourceAddress string, send func(*ftlv1.CallResponse) error) error {
+			return s.callStreamDeploymentWithRequest(ctx, req, key, deployment, sourceAddress, send)
+		},
+	})
+}
+
+// serveIngress handles an HTTP ingress request with [handler], using the
+// routes of the request's method.
+//
+// The HTTP request and its response are recorded so that the request can be
+// replayed.
+func (s *Service) serveIngress(w http.ResponseWriter, r *http.Request, requestKey model.RequestKey, handler ingress.Handler) {
+	// CORS preflight requests are answered from the routes of the method they
+	// request.
+	method := r.Method
+	if preflightMethod, ok := ingress.PreflightMethod(r); ok {
+		method = preflightMethod
+	}
+	// Requests without matching routes are rejected by the ingress handler.
+	routes, err := s.dal.GetIngressRoutes(r.Context(), method)
+	if err != nil && !errors.Is(err, dal.ErrNotFound) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -267,8 +339,22 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	requestKey := model.NewRequestKey(model.OriginIngress, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
-	ingress.Handle(sch, requestKey, routes, w, r, s.callWithRequest)
+	if websocket.IsWebSocketUpgrade(r) {
+		s.connections.Handle(sch, routes, handler.AllowOrigins, w, r)
+		return
+	}
+	recorded, err := recordHTTPRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	recorder := &recordingResponseWriter{ResponseWriter: w}
+	handler.Routes = routes
+	handler.Handle(sch, requestKey, recorder, r)
+	recorded.Response = recorder.response()
+	if err := s.dal.RecordIngressHTTPRequest(r.Context(), requestKey, recorded); err != nil {
+		log.FromContext(r.Context()).Errorf(err, "Could not record HTTP request")
+	}
 }
 
 func (s *Service) ProcessList(ctx context.Context, req *connect.Request[ftlv1.ProcessListRequest]) (*connect.Response[ftlv1.ProcessListResponse], error) {
@@ -353,13 +439,14 @@ func (s *Service) Status(ctx context.Context, req *connect.Request[ftlv1.StatusR
 			return nil, fmt.Errorf("could not marshal attributes for deployment %s: %w", d.Key.String(), err)
 		}
 		return &ftlv1.StatusResponse_Deployment{
-			Key:         d.Key.String(),
-			Language:    d.Language,
-			Name:        d.Module,
-			MinReplicas: int32(d.MinReplicas),
-			Replicas:    replicas[d.Key.String()],
-			Schema:      d.Schema.ToProto().(*schemapb.Module), //nolint:forcetypeassert
-			Labels:      labels,
+			Key:          d.Key.String(),
+			Language:     d.Language,
+			Name:         d.Module,
+			MinReplicas:  int32(d.MinReplicas),
+			Replicas:     replicas[d.Key.String()],
+			Schema:       d.Schema.ToProto().(*schemapb.Module), //nolint:forcetypeassert
+			Labels:       labels,
+			CanaryWeight: canaryWeightToProto(d.CanaryWeight),
 		}, nil
 	})
 	if err != nil {
@@ -471,6 +558,18 @@ func (s *Service) ReplaceDeploy(ctx context.Context, c *connect.Request[ftlv1.Re
 	}
 
 	logger := s.getDeploymentLogger(ctx, newDeploymentKey)
+
+	if c.Msg.CanaryWeight != nil {
+		weight := c.Msg.GetCanaryWeight()
+		logger.Debugf("Starting canary deployment %s with %d%% of traffic", newDeploymentKey, weight)
+		err = s.dal.StartCanaryDeployment(ctx, newDeploymentKey, int(c.Msg.MinReplicas), int(weight))
+		if err != nil {
+			logger.Errorf(err, "Could not start canary deployment: %s", newDeploymentKey)
+			return nil, canaryError(err)
+		}
+		return connect.NewResponse(&ftlv1.ReplaceDeployResponse{}), nil
+	}
+
 	logger.Debugf("Replace deployment for: %s", newDeploymentKey)
 
 	err = s.dal.ReplaceDeployment(ctx, newDeploymentKey, int(c.Msg.MinReplicas))
@@ -491,6 +590,33 @@ func (s *Service) ReplaceDeploy(ctx context.Context, c *connect.Request[ftlv1.Re
 	return connect.NewResponse(&ftlv1.ReplaceDeployResponse{}), nil
 }
 
+func (s *Service) ListDeployments(ctx context.Context, req *connect.Request[ftlv1.ListDeploymentsRequest]) (*connect.Response[ftlv1.ListDeploymentsResponse], error) {
+	deployments, err := s.dal.GetDeploymentHistory(ctx, req.Msg.Module)
+	if errors.Is(err, dal.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not list deployments: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListDeploymentsResponse{
+		Deployments: slices.Map(deployments, func(d dal.DeploymentHistory) *ftlv1.ListDeploymentsResponse_Deployment {
+			return &ftlv1.ListDeploymentsResponse_Deployment{
+				Key:          d.Key.String(),
+				Language:     d.Language,
+				SchemaHash:   d.SchemaHash.String(),
+				CreatedAt:    timestamppb.New(d.CreatedAt),
+				MinReplicas:  int32(d.MinReplicas),
+				CanaryWeight: canaryWeightToProto(d.CanaryWeight),
+				MinReplicasHistory: slices.Map(d.MinReplicasHistory, func(c dal.MinReplicasChange) *ftlv1.ListDeploymentsResponse_MinReplicasChange {
+					return &ftlv1.ListDeploymentsResponse_MinReplicasChange{
+						Time:        timestamppb.New(c.Time),
+						MinReplicas: int32(c.MinReplicas),
+					}
+				}),
+			}
+		}),
+	}), nil
+}
+
 func (s *Service) RegisterRunner(ctx context.Context, stream *connect.ClientStream[ftlv1.RegisterRunnerRequest]) (*connect.Response[ftlv1.RegisterRunnerResponse], error) {
 	initialised := false
 
@@ -679,8 +805,9 @@ func (s *Service) GetModuleContext(ctx context.Context, req *connect.Request[ftl
 
 // AcquireLease acquires a lease on behalf of a module.
 //
-// This is a bidirectional stream where each request from the client must be
-// responded to with an empty response.
+// This is a bidirectional stream where the first request acquires the lease
+// and each subsequent request heartbeats it. Every request is responded to
+// with the fencing token of the lease.
 func (s *Service) AcquireLease(ctx context.Context, stream *connect.BidiStream[ftlv1.AcquireLeaseRequest, ftlv1.AcquireLeaseResponse]) error {
 	var lease leases.Lease
 	for {
@@ -701,12 +828,164 @@ func (s *Service) AcquireLease(ctx context.Context, stream *connect.BidiStream[f
 			}
 			defer lease.Release() //nolint:errcheck
 		}
-		if err = stream.Send(&ftlv1.AcquireLeaseResponse{}); err != nil {
+		if err = stream.Send(&ftlv1.AcquireLeaseResponse{FencingToken: lease.FencingToken()}); err != nil {
 			return connect.NewError(connect.CodeInternal, fmt.Errorf("could not send lease response: %w", err))
 		}
 	}
 }
 
+// PublishEvent publishes an event to a topic owned by the calling module.
+func (s *Service) PublishEvent(ctx context.Context, req *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
+	if req.Msg.Topic == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("topic is required"))
+	}
+	sch, err := s.getActiveSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	topicRef := schema.RefFromProto(req.Msg.Topic)
+	topic := &schema.Topic{}
+	if err = sch.ResolveRefToType(topicRef, topic); err != nil {
+		if errors.Is(err, schema.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	callers, err := headers.GetCallers(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	for _, caller := range callers {
+		if caller.Module != topicRef.Module {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("topic %q can only be published to by module %q", topicRef, topicRef.Module))
+		}
+	}
+	err = s.dal.PublishEventForTopic(ctx, topicRef.Module, topicRef.Name, req.Msg.Body)
+	if err != nil {
+		if errors.Is(err, dal.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to publish event: %w", err))
+	}
+	return connect.NewResponse(&ftlv1.PublishEventResponse{}), nil
+}
+
+// SendToConnection sends a message to a WebSocket connection to one of the
+// calling module's "+ingress ws" verbs.
+func (s *Service) SendToConnection(ctx context.Context, req *connect.Request[ftlv1.SendToConnectionRequest]) (*connect.Response[ftlv1.SendToConnectionResponse], error) {
+	key, err := model.ParseConnectionKey(req.Msg.ConnectionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid connection ID: %w", err))
+	}
+	callers, err := headers.GetCallers(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	for _, caller := range callers {
+		if caller.Module != key.Payload.Module {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("connection %q can only be sent messages by module %q", key, key.Payload.Module))
+		}
+	}
+	err = s.connections.Send(ctx, key, dal.IngressConnectionMessage{Body: req.Msg.Body, Binary: req.Msg.Binary})
+	if err != nil {
+		if errors.Is(err, dal.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&ftlv1.SendToConnectionResponse{}), nil
+}
+
+// SendFSMEvent sends an event to an FSM instance, starting it if it doesn't
+// exist.
+//
+// The destination state is the state whose request type matches the event,
+// out of the start states for a new instance, or the states reachable from the
+// current state for an existing instance.
+func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.SendFSMEventRequest]) (*connect.Response[ftlv1.SendFSMEventResponse], error) {
+	msg := req.Msg
+	if msg.Fsm == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("fsm is required"))
+	}
+	if msg.Event == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("event is required"))
+	}
+	sch, err := s.getActiveSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fsmRef := schema.RefFromProto(msg.Fsm)
+	fsm := &schema.FSM{}
+	if err = sch.ResolveRefToType(fsmRef, fsm); err != nil {
+		if errors.Is(err, schema.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	callers, err := headers.GetCallers(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	for _, caller := range callers {
+		if caller.Module != fsmRef.Module {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("FSM %q can only be sent events by module %q", fsmRef, fsmRef.Module))
+		}
+	}
+
+	var candidates []*schema.Ref
+	instance, err := s.dal.GetFSMInstance(ctx, fsmRef.ToRefKey(), msg.Instance)
+	switch {
+	case errors.Is(err, dal.ErrNotFound):
+		candidates = fsm.Start
+
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not load FSM instance: %w", err))
+
+	case instance.Status != dal.FSMStatusRunning:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("FSM instance %q is %s", msg.Instance, instance.Status))
+
+	case instance.DestinationState.Ok():
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("FSM instance %q is already transitioning", msg.Instance))
+
+	default:
+		current, ok := instance.CurrentState.Get()
+		if !ok {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("FSM instance %q has no current state", msg.Instance))
+		}
+		candidates = fsm.NextStates(current)
+	}
+
+	eventType := schema.TypeFromProto(msg.Event)
+	var destination *schema.Ref
+	var destinationVerb *schema.Verb
+	for _, candidate := range candidates {
+		verb := &schema.Verb{}
+		if err := sch.ResolveRefToType(candidate, verb); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not resolve FSM state %s: %w", candidate, err))
+		}
+		if verb.Request.String() == eventType.String() {
+			destination = candidate
+			destinationVerb = verb
+			break
+		}
+	}
+	if destination == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no transition found for event %s in FSM %s", eventType, fsmRef))
+	}
+	if err = ingress.ValidateCallBody(msg.Body, destinationVerb, sch); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = s.dal.StartFSMTransition(ctx, fsmRef.ToRefKey(), msg.Instance, destination.ToRefKey(), msg.Body)
+	if err != nil {
+		if errors.Is(err, dal.ErrConflict) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not start FSM transition: %w", err))
+	}
+	return connect.NewResponse(&ftlv1.SendFSMEventResponse{}), nil
+}
+
 func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
 	return s.callWithRequest(ctx, req, optional.None[model.RequestKey](), "")
 }
@@ -716,8 +995,134 @@ func (s *Service) callWithRequest(
 	req *connect.Request[ftlv1.CallRequest],
 	key optional.Option[model.RequestKey],
 	sourceAddress string,
+) (*connect.Response[ftlv1.CallResponse], error) {
+	return s.callDeploymentWithRequest(ctx, req, key, optional.None[model.DeploymentKey](), sourceAddress)
+}
+
+// callDeploymentWithRequest calls a verb, routing the call to the given
+// deployment of the verb's module if set.
+func (s *Service) callDeploymentWithRequest(
+	ctx context.Context,
+	req *connect.Request[ftlv1.CallRequest],
+	key optional.Option[model.RequestKey],
+	deployment optional.Option[model.DeploymentKey],
+	sourceAddress string,
 ) (*connect.Response[ftlv1.CallResponse], error) {
 	start := time.Now()
+	call, err := s.prepareCall(ctx, req, key, deployment, sourceAddress)
+	if err != nil {
+		return nil, err
+	}
+	if call.verb.Kind() == schema.VerbKindStream {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q is a streaming verb and must be called with ServerStream", call.verbRef))
+	}
+	ctx = rpc.WithVerbs(ctx, append(call.callers, call.verbRef))
+	headers.AddCaller(req.Header(), call.verbRef)
+
+	response, err := call.client.verb.Call(ctx, req)
+	resp := connect.NewResponse(response.Msg)
+	var maybeResponse optional.Option[*ftlv1.CallResponse]
+	if resp != nil {
+		maybeResponse = optional.Some(resp.Msg)
+	}
+	s.recordCall(ctx, &Call{
+		deploymentKey: call.route.Deployment,
+		requestKey:    call.requestKey,
+		startTime:     start,
+		destVerb:      call.verbRef,
+		callers:       call.callers,
+		callError:     optional.Nil(err),
+		request:       req.Msg,
+		response:      maybeResponse,
+	})
+	return resp, err
+}
+
+func (s *Service) ServerStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	return s.callStreamWithRequest(ctx, req, optional.None[model.RequestKey](), "", stream.Send)
+}
+
+// callStreamWithRequest calls a streaming verb, passing each of its responses
+// to [send].
+func (s *Service) callStreamWithRequest(
+	ctx context.Context,
+	req *connect.Request[ftlv1.CallRequest],
+	key optional.Option[model.RequestKey],
+	sourceAddress string,
+	send func(*ftlv1.CallResponse) error,
+) error {
+	return s.callStreamDeploymentWithRequest(ctx, req, key, optional.None[model.DeploymentKey](), sourceAddress, send)
+}
+
+// callStreamDeploymentWithRequest calls a streaming verb, routing the call to
+// the given deployment of the verb's module if set.
+func (s *Service) callStreamDeploymentWithRequest(
+	ctx context.Context,
+	req *connect.Request[ftlv1.CallRequest],
+	key optional.Option[model.RequestKey],
+	deployment optional.Option[model.DeploymentKey],
+	sourceAddress string,
+	send func(*ftlv1.CallResponse) error,
+) (err error) {
+	start := time.Now()
+	call, err := s.prepareCall(ctx, req, key, deployment, sourceAddress)
+	if err != nil {
+		return err
+	}
+	if call.verb.Kind() != schema.VerbKindStream {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q is not a streaming verb", call.verbRef))
+	}
+	ctx = rpc.WithVerbs(ctx, append(call.callers, call.verbRef))
+	headers.AddCaller(req.Header(), call.verbRef)
+
+	// The last response is recorded, as it contains the error if the verb
+	// failed.
+	var lastResponse optional.Option[*ftlv1.CallResponse]
+	defer func() {
+		s.recordCall(ctx, &Call{
+			deploymentKey: call.route.Deployment,
+			requestKey:    call.requestKey,
+			startTime:     start,
+			destVerb:      call.verbRef,
+			callers:       call.callers,
+			callError:     optional.Nil(err),
+			request:       req.Msg,
+			response:      lastResponse,
+		})
+	}()
+	responses, err := call.client.verb.ServerStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer responses.Close()
+	for responses.Receive() {
+		lastResponse = optional.Some(responses.Msg())
+		if err = send(responses.Msg()); err != nil {
+			return err
+		}
+	}
+	return responses.Err()
+}
+
+// preparedCall is a validated call to a verb, routed to a runner.
+type preparedCall struct {
+	verb       *schema.Verb
+	verbRef    *schema.Ref
+	route      dal.Route
+	client     clients
+	callers    []*schema.Ref
+	requestKey model.RequestKey
+}
+
+// prepareCall validates a call to a verb, routes it to a runner, and assigns
+// it a request key, creating the request if the key is new.
+func (s *Service) prepareCall(
+	ctx context.Context,
+	req *connect.Request[ftlv1.CallRequest],
+	key optional.Option[model.RequestKey],
+	deployment optional.Option[model.DeploymentKey],
+	sourceAddress string,
+) (*preparedCall, error) {
 	if req.Msg.Verb == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
 	}
@@ -725,14 +1130,30 @@ func (s *Service) callWithRequest(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("body is required"))
 	}
 
-	sch, err := s.getActiveSchema(ctx)
+	deployments, err := s.dal.GetActiveDeployments(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	verbRef := schema.RefFromProto(req.Msg.Verb)
-	verb := &schema.Verb{}
+	module := verbRef.Module
+	routes, ok := s.routes.Load()[module]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for module %q", module))
+	}
+	if deploymentKey, ok := deployment.Get(); ok {
+		routes = slices.Filter(routes, func(route dal.Route) bool { return route.Deployment.String() == deploymentKey.String() })
+		if len(routes) == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for deployment %q", deploymentKey))
+		}
+	}
+	route := pickRoute(routes, rand.Intn) //nolint:gosec
 
+	sch, err := schemaForRoute(deployments, route)
+	if err != nil {
+		return nil, err
+	}
+	verb := &schema.Verb{}
 	if err = sch.ResolveRefToType(verbRef, verb); err != nil {
 		if errors.Is(err, schema.ErrNotFound) {
 			return nil, connect.NewError(connect.CodeNotFound, err)
@@ -745,12 +1166,6 @@ func (s *Service) callWithRequest(
 		return nil, err
 	}
 
-	module := verbRef.Module
-	routes, ok := s.routes.Load()[module]
-	if !ok {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for module %q", module))
-	}
-	route := routes[rand.Intn(len(routes))] //nolint:gosec
 	client := s.clientsForEndpoint(route.Endpoint)
 
 	callers, err := headers.GetCallers(req.Header())
@@ -776,7 +1191,7 @@ func (s *Service) callWithRequest(
 		if err != nil {
 			return nil, err
 		} else if !ok {
-			requestKey = model.NewRequestKey(model.OriginIngress, "grpc")
+			requestKey = model.NewRequestKey(model.OriginIngress, grpcRequestKey)
 			sourceAddress = req.Peer().Addr
 			isNewRequestKey = true
 		} else {
@@ -789,27 +1204,29 @@ func (s *Service) callWithRequest(
 			return nil, err
 		}
 	}
-
-	ctx = rpc.WithVerbs(ctx, append(callers, verbRef))
-	headers.AddCaller(req.Header(), schema.RefFromProto(req.Msg.Verb))
-
-	response, err := client.verb.Call(ctx, req)
-	resp := connect.NewResponse(response.Msg)
-	var maybeResponse optional.Option[*ftlv1.CallResponse]
-	if resp != nil {
-		maybeResponse = optional.Some(resp.Msg)
+	if sourceAddress == "" {
+		sourceAddress = req.Peer().Addr
+	}
+	if err = s.checkRateLimit(ctx, verbRef, verb, req.Header(), callers, sourceAddress); err != nil {
+		s.recordCall(ctx, &Call{
+			deploymentKey: route.Deployment,
+			requestKey:    requestKey,
+			startTime:     time.Now(),
+			destVerb:      verbRef,
+			callers:       callers,
+			callError:     optional.Some(err),
+			request:       req.Msg,
+		})
+		return nil, err
 	}
-	s.recordCall(ctx, &Call{
-		deploymentKey: route.Deployment,
-		requestKey:    requestKey,
-		startTime:     start,
-		destVerb:      verbRef,
-		callers:       callers,
-		callError:     optional.Nil(err),
-		request:       req.Msg,
-		response:      maybeResponse,
-	})
-	return resp, err
+	return &preparedCall{
+		verb:       verb,
+		verbRef:    verbRef,
+		route:      route,
+		client:     client,
+		callers:    callers,
+		requestKey: requestKey,
+	}, nil
 }
 
 func (s *Service) GetArtefactDiffs(ctx context.Context, req *connect.Request[ftlv1.GetArtefactDiffsRequest]) (*connect.Response[ftlv1.GetArtefactDiffsResponse], error) {
@@ -946,6 +1363,32 @@ func (s *Service) reapStaleRunners(ctx context.Context) (time.Duration, error) {
 	return s.config.RunnerTimeout, nil
 }
 
+// Delete artefacts that are no longer referenced by any deployment.
+func (s *Service) gcArtefacts(ctx context.Context) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	count, err := s.dal.GCArtefacts(ctx, s.config.ArtefactGCGracePeriod, 100)
+	if count > 0 {
+		logger.Debugf("Garbage collected %d artefacts", count)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to garbage collect artefacts: %w", err)
+	}
+	return time.Minute * 5, nil
+}
+
+// Delete rate limit windows that have ended.
+func (s *Service) pruneRateLimits(ctx context.Context) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	count, err := s.dal.DeleteExpiredRateLimits(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune rate limits: %w", err)
+	}
+	if count > 0 {
+		logger.Debugf("Pruned %d expired rate limits", count)
+	}
+	return time.Minute, nil
+}
+
 // Release any expired runner deployment reservations.
 func (s *Service) releaseExpiredReservations(ctx context.Context) (time.Duration, error) {
 	logger := log.FromContext(ctx)
@@ -1090,34 +1533,172 @@ func (s *Service) executeAsyncCalls(ctx context.Context) (time.Duration, error)
 		Verb: call.Verb.ToProto().(*schemapb.Ref),
 		Body: call.Request,
 	}
-	resp, err := s.callWithRequest(ctx, connect.NewRequest(req), optional.None[model.RequestKey](), s.config.Advertise.String())
+	// Each attempt is a separate request so that it shows up in the timeline.
+	requestKey := model.NewRequestKey(asyncCallRequestOrigin(call.Origin), fmt.Sprintf("%s-%s", call.Verb.Module, call.Verb.Name))
+	resp, err := s.callWithRequest(ctx, connect.NewRequest(req), optional.Some(requestKey), s.config.Advertise.String())
+	var callResponse *ftlv1.CallResponse
 	if err != nil {
-		return 0, fmt.Errorf("async call failed: %w", err)
+		callResponse = &ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: err.Error()}}}
+	} else {
+		callResponse = resp.Msg
 	}
 	var callError optional.Option[string]
-	if perr := resp.Msg.GetError(); perr != nil {
+	if perr := callResponse.GetError(); perr != nil {
+		if backoff, ok := s.asyncCallRetryBackoff(ctx, call); ok {
+			logger.Warnf("Async call failed, retrying in %s (attempt %d): %s", backoff, call.RetryAttempt+1, perr.Message)
+			if err := s.dal.RetryAsyncCall(ctx, call, perr.Message, backoff); err != nil {
+				return 0, fmt.Errorf("failed to retry async call: %w", err)
+			}
+			return time.Millisecond * 100, nil
+		}
 		logger.Warnf("Async call failed: %s", perr.Message)
 		callError = optional.Some(perr.Message)
 	} else {
 		logger.Debugf("Async call succeeded")
 	}
-	err = s.dal.CompleteAsyncCall(ctx, call, resp.Msg.GetBody(), callError)
-	if err != nil {
-		return 0, fmt.Errorf("failed to complete async call: %w", err)
-	}
 	switch call.Origin {
 	case dal.AsyncCallOriginFSM:
-		return time.Millisecond * 100, s.onAsyncFSMCallCompletion(ctx, call, resp.Msg)
+		// The call is completed along with the FSM transition.
+		return time.Millisecond * 100, s.onAsyncFSMCallCompletion(ctx, call, callResponse)
+
+	case dal.AsyncCallOriginPubSub:
+		err = s.dal.CompleteAsyncCall(ctx, call, callResponse.GetBody(), callError)
+		if err != nil {
+			return 0, fmt.Errorf("failed to complete async call: %w", err)
+		}
+		return time.Millisecond * 100, s.onAsyncPubSubCallCompletion(ctx, call, callResponse)
 
 	default:
 		panic(fmt.Sprintf("unexpected async call origin: %s", call.Origin))
 	}
 }
 
+// pubSubRetryParams is the retry policy for delivering events to subscribers.
+//
+// Subscribers can't declare "+retry". Once a delivery has exhausted its
+// retries the subscription is blocked on the failed call until it is requeued
+// or discarded.
+var pubSubRetryParams = schema.RetryParams{Count: 10, MinBackoff: time.Second, MaxBackoff: time.Minute * 10}
+
+// asyncCallRetryBackoff returns the backoff before the next retry of a failed
+// async call, or false if the call should not be retried.
+func (s *Service) asyncCallRetryBackoff(ctx context.Context, call *dal.AsyncCall) (time.Duration, bool) {
+	if call.Origin == dal.AsyncCallOriginPubSub {
+		if call.RetryAttempt >= pubSubRetryParams.Count {
+			return 0, false
+		}
+		return pubSubRetryParams.Backoff(call.RetryAttempt), true
+	}
+	logger := log.FromContext(ctx)
+	sch, err := s.getActiveSchema(ctx)
+	if err != nil {
+		logger.Errorf(err, "Could not load schema to determine retry policy")
+		return 0, false
+	}
+	verb := &schema.Verb{}
+	if err := sch.ResolveRefToType(&call.Verb, verb); err != nil {
+		return 0, false
+	}
+	retry, ok := verb.GetMetadataRetry().Get()
+	if !ok {
+		return 0, false
+	}
+	params, err := retry.RetryParams()
+	if err != nil {
+		logger.Errorf(err, "Invalid retry policy for %s", call.Verb)
+		return 0, false
+	}
+	if call.RetryAttempt >= params.Count {
+		return 0, false
+	}
+	return params.Backoff(call.RetryAttempt), true
+}
+
+func asyncCallRequestOrigin(origin dal.AsyncCallOrigin) model.Origin {
+	switch origin {
+	case dal.AsyncCallOriginFSM:
+		return model.OriginFSM
+	case dal.AsyncCallOriginCron:
+		return model.OriginCron
+	case dal.AsyncCallOriginPubSub:
+		return model.OriginPubsub
+	default:
+		panic(fmt.Sprintf("unexpected async call origin: %s", origin))
+	}
+}
+
+// onAsyncFSMCallCompletion completes [call] along with the FSM transition it
+// executes, moving the FSM instance to the destination state or failing it.
 func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, call *dal.AsyncCall, response *ftlv1.CallResponse) error {
+	logger := log.FromContext(ctx)
+	instance, err := s.dal.GetFSMInstanceForAsyncCall(ctx, call)
+	if err != nil {
+		return fmt.Errorf("could not find FSM instance for async call %d: %w", call.ID, err)
+	}
+	destination, ok := instance.DestinationState.Get()
+	if !ok {
+		return fmt.Errorf("FSM instance %s:%s is not transitioning", instance.FSM, instance.Key)
+	}
+
+	if perr := response.GetError(); perr != nil {
+		logger.Warnf("FSM instance %s:%s failed transitioning to %s: %s", instance.FSM, instance.Key, destination, perr.Message)
+		if err := s.dal.FailFSMTransition(ctx, instance, call, perr.Message); err != nil {
+			return fmt.Errorf("could not fail FSM transition: %w", err)
+		}
+		return nil
+	}
+
+	sch, err := s.getActiveSchema(ctx)
+	if err != nil {
+		return err
+	}
+	fsm := &schema.FSM{}
+	if err := sch.ResolveRefToType(&schema.Ref{Module: instance.FSM.Module, Name: instance.FSM.Name}, fsm); err != nil {
+		return fmt.Errorf("could not resolve FSM %s: %w", instance.FSM, err)
+	}
+	// A state without outgoing transitions is terminal.
+	terminal := len(fsm.NextStates(destination)) == 0
+	if err := s.dal.FinishFSMTransition(ctx, instance, call, response.GetBody(), terminal); err != nil {
+		return fmt.Errorf("could not finish FSM transition: %w", err)
+	}
+	logger.Debugf("FSM instance %s:%s transitioned to %s", instance.FSM, instance.Key, destination)
+	if terminal {
+		logger.Debugf("FSM instance %s:%s completed", instance.FSM, instance.Key)
+	}
 	return nil
 }
 
+// onAsyncPubSubCallCompletion marks the event delivered by [call] as consumed
+// so that the subscription can progress to the next event.
+//
+// If the delivery failed the event is left unconsumed and the subscription
+// stays blocked on [call] until it is requeued or discarded.
+func (s *Service) onAsyncPubSubCallCompletion(ctx context.Context, call *dal.AsyncCall, response *ftlv1.CallResponse) error {
+	subscription, err := model.ParseSubscriptionKey(call.OriginKey)
+	if err != nil {
+		return fmt.Errorf("invalid subscription key %q: %w", call.OriginKey, err)
+	}
+	if perr := response.GetError(); perr != nil {
+		log.FromContext(ctx).Warnf("Delivery to %s failed after %d retries, subscription is blocked until the call is requeued or discarded: %s", subscription, call.RetryAttempt, perr.Message)
+		return nil
+	}
+	return s.dal.CompleteEventForSubscription(ctx, subscription, call)
+}
+
+// progressSubscriptions schedules the next pending event of each idle
+// subscription for delivery to one of its subscribers.
+func (s *Service) progressSubscriptions(ctx context.Context) (time.Duration, error) {
+	count, err := s.dal.ProgressSubscriptions(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to progress subscriptions: %w", err)
+	}
+	if count > 0 {
+		// Keep going while there are events to deliver.
+		return time.Millisecond * 100, nil
+	}
+	return time.Second, nil
+}
+
 func (s *Service) expireStaleLeases(ctx context.Context) (time.Duration, error) {
 	err := s.dal.ExpireLeases(ctx)
 	if err != nil {
@@ -1190,6 +1771,12 @@ func (s *Service) reapStaleControllers(ctx context.Context) (time.Duration, erro
 	} else if count > 0 {
 		logger.Debugf("Reaped %d stale controllers", count)
 	}
+	count, err = s.dal.DeleteStaleIngressConnections(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale ingress connections: %w", err)
+	} else if count > 0 {
+		logger.Debugf("Removed %d ingress connections of stale controllers", count)
+	}
 	return time.Second * 10, nil
 }
 
@@ -1227,6 +1814,8 @@ func (s *Service) watchModuleChanges(ctx context.Context, sendChange func(respon
 	if err != nil {
 		return err
 	}
+	// Canary deployments are invisible to schema consumers until promoted.
+	seedDeployments = slices.Filter(seedDeployments, func(d dal.Deployment) bool { return !d.CanaryWeight.Ok() })
 	initialCount := len(seedDeployments)
 	deploymentChanges := make(chan dal.DeploymentNotification, len(seedDeployments))
 	for _, deployment := range seedDeployments {
@@ -1269,6 +1858,9 @@ func (s *Service) watchModuleChanges(ctx context.Context, sendChange func(respon
 				delete(moduleState, name)
 				delete(moduleByDeploymentKey, deletion.String())
 			} else if message, ok := notification.Message.Get(); ok {
+				if message.CanaryWeight.Ok() {
+					continue
+				}
 				moduleSchema := message.Schema.ToProto().(*schemapb.Module) //nolint:forcetypeassert
 				moduleSchema.Runtime = &schemapb.ModuleRuntime{
 					Language:    message.Language,
@@ -1389,11 +1981,54 @@ func (s *Service) syncSchema(ctx context.Context) {
 	}
 }
 
+// Invalidates the cached ingress responses of modules when they are
+// redeployed.
+func (s *Service) invalidateIngressCache(ctx context.Context) {
+	deploymentChanges := make(chan dal.DeploymentNotification, 64)
+	s.dal.DeploymentChanges.Subscribe(deploymentChanges)
+	defer s.dal.DeploymentChanges.Unsubscribe(deploymentChanges)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case notification := <-deploymentChanges:
+			if deletion, ok := notification.Deleted.Get(); ok {
+				s.ingressCache.InvalidateModule(deletion.Payload.Module)
+			} else if deployment, ok := notification.Message.Get(); ok {
+				s.ingressCache.InvalidateModule(deployment.Module)
+			}
+		}
+	}
+}
+
+// deliverConnectionMessages delivers messages queued by other controllers to
+// the WebSocket connections held by this controller.
+func (s *Service) deliverConnectionMessages(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	connectionMessages := make(chan model.ConnectionKey, 64)
+	s.dal.ConnectionMessages.Subscribe(connectionMessages)
+	defer s.dal.ConnectionMessages.Unsubscribe(connectionMessages)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case key := <-connectionMessages:
+			if err := s.connections.Deliver(ctx, key); err != nil {
+				logger.Warnf("Failed to deliver messages to WebSocket connection %s: %s", key, err)
+			}
+		}
+	}
+}
+
 func (s *Service) getActiveSchema(ctx context.Context) (*schema.Schema, error) {
 	deployments, err := s.dal.GetActiveDeployments(ctx)
 	if err != nil {
 		return nil, err
 	}
+	// Canary deployments are not part of the active schema.
+	deployments = slices.Filter(deployments, func(d dal.Deployment) bool { return !d.CanaryWeight.Ok() })
 	return schema.ValidateSchema(&schema.Schema{
 		Modules: slices.Map(deployments, func(d dal.Deployment) *schema.Module {
 			return d.Schema
@@ -1447,10 +2082,16 @@ func extractIngressRoutingEntries(req *ftlv1.CreateDeploymentRequest) []dal.Ingr
 		if verb, ok := decl.Value.(*schemapb.Decl_Verb); ok {
 			for _, metadata := range verb.Verb.Metadata {
 				if ingress, ok := metadata.Value.(*schemapb.Metadata_Ingress); ok {
+					method := ingress.Ingress.Method
+					if ingress.Ingress.Type == "ws" {
+						// WebSocket connections are opened with a GET request.
+						method = http.MethodGet
+					}
 					ingressRoutes = append(ingressRoutes, dal.IngressRoutingEntry{
 						Verb:   verb.Verb.Name,
-						Method: ingress.Ingress.Method,
+						Method: method,
 						Path:   ingressPathString(ingress.Ingress.Path),
+						Host:   strings.ToLower(ingress.Ingress.Host),
 					})
 				}
 			}
@@ -1466,7 +2107,11 @@ func ingressPathString(path []*schemapb.IngressPathComponent) string {
 		case *schemapb.IngressPathComponent_IngressPathLiteral:
 			pathString[i] = p.GetIngressPathLiteral().Text
 		case *schemapb.IngressPathComponent_IngressPathParameter:
-			pathString[i] = fmt.Sprintf("{%s}", p.GetIngressPathParameter().Name)
+			if p.GetIngressPathParameter().Wildcard {
+				pathString[i] = fmt.Sprintf("{%s...}", p.GetIngressPathParameter().Name)
+			} else {
+				pathString[i] = fmt.Sprintf("{%s}", p.GetIngressPathParameter().Name)
+			}
 		}
 	}
 	return "/" + strings.Join(pathString, "/")
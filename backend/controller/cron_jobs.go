@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func (s *Service) ListCronJobs(ctx context.Context, req *connect.Request[ftlv1.ListCronJobsRequest]) (*connect.Response[ftlv1.ListCronJobsResponse], error) {
+	jobs, err := s.dal.GetCronJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list cron jobs: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListCronJobsResponse{
+		Jobs: slices.Map(jobs, cronJobToProto),
+	}), nil
+}
+
+func (s *Service) PauseCronJob(ctx context.Context, req *connect.Request[ftlv1.PauseCronJobRequest]) (*connect.Response[ftlv1.PauseCronJobResponse], error) {
+	job, err := s.findCronJob(ctx, req.Msg.Verb)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cronJobs.PauseJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("could not pause cron job: %w", err)
+	}
+	log.FromContext(ctx).Infof("Paused cron job %s", job.Verb)
+	return connect.NewResponse(&ftlv1.PauseCronJobResponse{}), nil
+}
+
+func (s *Service) ResumeCronJob(ctx context.Context, req *connect.Request[ftlv1.ResumeCronJobRequest]) (*connect.Response[ftlv1.ResumeCronJobResponse], error) {
+	job, err := s.findCronJob(ctx, req.Msg.Verb)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cronJobs.ResumeJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("could not resume cron job: %w", err)
+	}
+	log.FromContext(ctx).Infof("Resumed cron job %s", job.Verb)
+	return connect.NewResponse(&ftlv1.ResumeCronJobResponse{}), nil
+}
+
+func (s *Service) TriggerCronJob(ctx context.Context, req *connect.Request[ftlv1.TriggerCronJobRequest]) (*connect.Response[ftlv1.TriggerCronJobResponse], error) {
+	job, err := s.findCronJob(ctx, req.Msg.Verb)
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).Infof("Triggering cron job %s", job.Verb)
+	err = s.cronJobs.TriggerJob(ctx, job)
+	if errors.Is(err, dal.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("cron job %s is already executing", job.Verb))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, err)
+	}
+	return connect.NewResponse(&ftlv1.TriggerCronJobResponse{}), nil
+}
+
+func (s *Service) GetCronJobHistory(ctx context.Context, req *connect.Request[ftlv1.GetCronJobHistoryRequest]) (*connect.Response[ftlv1.GetCronJobHistoryResponse], error) {
+	if req.Msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	limit := 10
+	if req.Msg.Limit > 0 {
+		limit = int(req.Msg.Limit)
+	}
+	events, err := s.dal.QueryEvents(ctx, limit,
+		dal.FilterTypes(dal.EventTypeCall),
+		dal.FilterCall(optional.None[string](), req.Msg.Verb.Module, optional.Some(req.Msg.Verb.Name)),
+		dal.FilterOrigins(model.OriginCron),
+		dal.FilterDescending(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not query cron job history: %w", err)
+	}
+	out := &ftlv1.GetCronJobHistoryResponse{}
+	for _, event := range events {
+		call, ok := event.(*dal.CallEvent)
+		if !ok {
+			continue
+		}
+		execution := &ftlv1.CronJobExecution{
+			DeploymentKey: call.DeploymentKey.String(),
+			TimeStamp:     timestamppb.New(call.Time),
+			Duration:      durationpb.New(call.Duration),
+		}
+		if requestKey, ok := call.RequestKey.Get(); ok {
+			execution.RequestKey = requestKey.String()
+		}
+		if callError, ok := call.Error.Get(); ok {
+			execution.Error = &callError
+		}
+		out.Executions = append(out.Executions, execution)
+	}
+	return connect.NewResponse(out), nil
+}
+
+// findCronJob returns the cron job of the active deployment of a verb.
+func (s *Service) findCronJob(ctx context.Context, verb *schemapb.Ref) (model.CronJob, error) {
+	if verb == nil {
+		return model.CronJob{}, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	ref := schema.RefFromProto(verb)
+	jobs, err := s.dal.GetCronJobs(ctx)
+	if err != nil {
+		return model.CronJob{}, fmt.Errorf("could not list cron jobs: %w", err)
+	}
+	for _, job := range jobs {
+		if job.Verb.Module == ref.Module && job.Verb.Name == ref.Name {
+			return job, nil
+		}
+	}
+	return model.CronJob{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("no cron job for verb %s", ref))
+}
+
+func cronJobToProto(job model.CronJob) *ftlv1.CronJob {
+	return &ftlv1.CronJob{
+		Key:           job.Key.String(),
+		DeploymentKey: job.DeploymentKey.String(),
+		Verb:          job.Verb.ToProto().(*schemapb.Ref), //nolint:forcetypeassert
+		Schedule:      job.Schedule,
+		State:         string(job.State),
+		Paused:        job.Paused,
+		NextExecution: timestamppb.New(job.NextExecution),
+		StartTime:     timestamppb.New(job.StartTime),
+	}
+}
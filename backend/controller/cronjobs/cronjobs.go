@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"connectrpc.com/connect"
@@ -30,6 +31,12 @@ const (
 	controllersPerJob              = 2
 	jobResetInterval               = time.Minute
 	newJobHashRingOverrideInterval = time.Minute + time.Second*20
+	// Executions that are overdue by more than this were missed, eg. because no
+	// controller was running.
+	missedExecutionThreshold = time.Minute
+	// How often executions of jobs with the "replace" concurrency policy check
+	// whether they were replaced by an execution on another controller.
+	replacedCheckInterval = time.Second * 5
 )
 
 type Config struct {
@@ -55,6 +62,12 @@ type endedJobsEvent struct {
 
 func (endedJobsEvent) cronJobEvent() {}
 
+type rescheduledJobsEvent struct {
+	jobs []model.CronJob
+}
+
+func (rescheduledJobsEvent) cronJobEvent() {}
+
 type updatedHashRingEvent struct{}
 
 func (updatedHashRingEvent) cronJobEvent() {}
@@ -69,7 +82,11 @@ type DAL interface {
 	GetCronJobs(ctx context.Context) ([]model.CronJob, error)
 	StartCronJobs(ctx context.Context, jobs []model.CronJob) (attemptedJobs []dal.AttemptedCronJob, err error)
 	EndCronJob(ctx context.Context, job model.CronJob, next time.Time) (model.CronJob, error)
+	ScheduleCronJob(ctx context.Context, job model.CronJob, next time.Time) (model.CronJob, error)
+	IsCronJobExecuting(ctx context.Context, job model.CronJob) (bool, error)
 	GetStaleCronJobs(ctx context.Context, duration time.Duration) ([]model.CronJob, error)
+	SetCronJobPaused(ctx context.Context, key model.CronJobKey, paused bool, next time.Time) error
+	TriggerCronJob(ctx context.Context, key model.CronJobKey) (model.CronJob, error)
 }
 
 type Scheduler interface {
@@ -144,15 +161,34 @@ func (s *Service) NewCronJobsForModule(ctx context.Context, module *schemapb.Mod
 				merr = append(merr, fmt.Errorf("failed to calculate next execution for cron job %v:%v with schedule %q: %w", module.Name, verb.Verb.Name, schedule, err))
 				continue
 			}
-			newJobs = append(newJobs, model.CronJob{
-				Key:           model.NewCronJobKey(module.Name, verb.Verb.Name),
-				Verb:          schema.Ref{Module: module.Name, Name: verb.Verb.Name},
-				Schedule:      cronStr,
-				StartTime:     start,
-				NextExecution: next,
-				State:         model.CronJobStateIdle,
+			md := schema.MetadataCronJob{
+				Concurrency: cronMetadata.CronJob.Concurrency,
+				Jitter:      cronMetadata.CronJob.Jitter,
+				Catchup:     cronMetadata.CronJob.Catchup,
+				MaxCatchup:  int(cronMetadata.CronJob.MaxCatchup),
+			}
+			jitter, err := md.JitterDuration()
+			if err != nil {
+				merr = append(merr, fmt.Errorf("cron job %v:%v: %w", module.Name, verb.Verb.Name, err))
+				continue
+			}
+			concurrency := model.CronJobConcurrencyForbid
+			if md.Concurrency != "" {
+				concurrency = model.CronJobConcurrency(md.Concurrency)
+			}
+			job := model.CronJob{
+				Key:          model.NewCronJobKey(module.Name, verb.Verb.Name),
+				Verb:         schema.Ref{Module: module.Name, Name: verb.Verb.Name},
+				Schedule:     cronStr,
+				StartTime:    start,
+				State:        model.CronJobStateIdle,
+				Concurrency:  concurrency,
+				Jitter:       jitter,
+				CatchupLimit: md.CatchupLimit(),
 				// DeploymentKey: Filled in by DAL
-			})
+			}
+			job.NextExecution = addJitter(job, next)
+			newJobs = append(newJobs, job)
 		}
 	}
 	if len(merr) > 0 {
@@ -194,13 +230,112 @@ func (s *Service) syncJobsWithNewDeploymentKey(ctx context.Context, deploymentKe
 	return nil
 }
 
-func (s *Service) executeJob(ctx context.Context, job model.CronJob) {
+// PauseJob stops a cron job from being executed until it is resumed.
+//
+// The paused state is inherited by the job's replacement when the module is
+// redeployed.
+func (s *Service) PauseJob(ctx context.Context, job model.CronJob) error {
+	if err := s.dal.SetCronJobPaused(ctx, job.Key, true, job.NextExecution); err != nil {
+		return fmt.Errorf("failed to pause cron job %s: %w", job.Key, err)
+	}
+	return s.syncJobsWithNewDeploymentKey(ctx, optional.None[model.DeploymentKey]())
+}
+
+// ResumeJob resumes a paused cron job, scheduling its next execution from now.
+//
+// Executions missed while the job was paused are skipped.
+func (s *Service) ResumeJob(ctx context.Context, job model.CronJob) error {
+	pattern, err := cron.Parse(job.Schedule)
+	if err != nil {
+		return fmt.Errorf("failed to parse cron schedule %q: %w", job.Schedule, err)
+	}
+	next, err := cron.NextAfter(pattern, s.clock.Now().UTC(), false)
+	if err != nil {
+		return fmt.Errorf("failed to calculate next execution for cron job %v with schedule %q: %w", job.Key, job.Schedule, err)
+	}
+	if err := s.dal.SetCronJobPaused(ctx, job.Key, false, addJitter(job, next)); err != nil {
+		return fmt.Errorf("failed to resume cron job %s: %w", job.Key, err)
+	}
+	return s.syncJobsWithNewDeploymentKey(ctx, optional.None[model.DeploymentKey]())
+}
+
+// TriggerJob executes a cron job immediately, even if it is paused, and waits
+// for it to finish.
+//
+// Returns [dal.ErrNotFound] if the job is already executing.
+func (s *Service) TriggerJob(ctx context.Context, job model.CronJob) error {
+	started, err := s.dal.TriggerCronJob(ctx, job.Key)
+	if err != nil {
+		return fmt.Errorf("failed to trigger cron job %s: %w", job.Key, err)
+	}
+	// The job must be ended even if the caller goes away.
+	return s.executeJob(context.WithoutCancel(ctx), started)
+}
+
+// executeScheduledJob executes a job that was started because it was due.
+//
+// Missed executions beyond the job's catch-up limit are skipped rather than
+// executed. If the job allows concurrent executions its next execution is
+// scheduled before this one starts.
+func (s *Service) executeScheduledJob(ctx context.Context, job model.CronJob) error {
+	logger := log.FromContext(ctx)
+	pattern, err := cron.Parse(job.Schedule)
+	if err != nil {
+		logger.Errorf(err, "failed to parse cron schedule %q", job.Schedule)
+		return err
+	}
+	if s.clock.Now().UTC().Sub(job.NextExecution) > missedExecutionThreshold {
+		next, err := s.nextExecution(job, pattern, job.NextExecution)
+		if err != nil {
+			logger.Errorf(err, "failed to calculate next execution for cron job %v with schedule %q", job.Key, job.Schedule)
+			return err
+		}
+		if !next.Equal(job.NextExecution) {
+			logger.Infof("skipping missed execution of cron job %v scheduled for %v", job.Key, job.NextExecution)
+			updatedJob, err := s.dal.EndCronJob(ctx, job, next)
+			if err != nil {
+				logger.Errorf(err, "failed to end cron job %v", job.Key)
+				return err
+			}
+			s.events.Publish(endedJobsEvent{
+				jobs: []model.CronJob{updatedJob},
+			})
+			return nil
+		}
+	}
+	if allowsConcurrentExecutions(job) {
+		next, err := cron.NextAfter(pattern, job.NextExecution, false)
+		if err == nil {
+			next, err = s.nextExecution(job, pattern, next)
+		}
+		if err != nil {
+			logger.Errorf(err, "failed to calculate next execution for cron job %v with schedule %q", job.Key, job.Schedule)
+			return err
+		}
+		updatedJob, err := s.dal.ScheduleCronJob(ctx, job, next)
+		if err != nil {
+			logger.Errorf(err, "failed to schedule next execution of cron job %v", job.Key)
+		} else {
+			job = updatedJob
+			s.events.Publish(rescheduledJobsEvent{
+				jobs: []model.CronJob{updatedJob},
+			})
+		}
+	}
+	return s.executeJob(ctx, job)
+}
+
+// executeJob calls the verb of a cron job, then ends the job and schedules its
+// next execution.
+//
+// Errors are logged, and returned if the verb could not be called or failed.
+func (s *Service) executeJob(ctx context.Context, job model.CronJob) error {
 	logger := log.FromContext(ctx)
 	requestBody := map[string]any{}
 	requestJSON, err := json.Marshal(requestBody)
 	if err != nil {
 		logger.Errorf(err, "could not build body for cron job: %v", job.Key)
-		return
+		return err
 	}
 
 	req := connect.NewRequest(&ftlv1.CallRequest{
@@ -212,31 +347,83 @@ func (s *Service) executeJob(ctx context.Context, job model.CronJob) {
 
 	callCtx, cancel := context.WithTimeout(ctx, s.config.Timeout)
 	defer cancel()
-	_, err = s.call(callCtx, req, optional.Some(requestKey), s.requestSource)
-	if err != nil {
-		logger.Errorf(err, "failed to execute cron job %v", job.Key)
+	if job.Concurrency == model.CronJobConcurrencyReplace {
+		go s.cancelIfReplaced(callCtx, job, cancel)
+	}
+	resp, callErr := s.call(callCtx, req, optional.Some(requestKey), s.requestSource)
+	if callErr != nil {
+		logger.Errorf(callErr, "failed to execute cron job %v", job.Key)
 		// Do not return, continue to end the job and schedule the next execution
+	} else if verbErr := resp.Msg.GetError(); verbErr != nil {
+		callErr = fmt.Errorf("cron job %v failed: %s", job.Key, verbErr.Message)
+		logger.Errorf(callErr, "cron job %v returned an error", job.Key)
 	}
 
-	schedule, err := cron.Parse(job.Schedule)
-	if err != nil {
-		logger.Errorf(err, "failed to parse cron schedule %q", job.Schedule)
-		return
-	}
-	next, err := cron.NextAfter(schedule, s.clock.Now().UTC(), false)
-	if err != nil {
-		logger.Errorf(err, "failed to calculate next execution for cron job %v with schedule %q", job.Key, job.Schedule)
-		return
+	next := job.NextExecution
+	if !allowsConcurrentExecutions(job) {
+		// Executions that were due while this one was running are skipped,
+		// unless this execution was itself catching up on missed executions.
+		schedule, err := cron.Parse(job.Schedule)
+		if err != nil {
+			logger.Errorf(err, "failed to parse cron schedule %q", job.Schedule)
+			return err
+		}
+		after := s.clock.Now().UTC()
+		if job.StartTime.Sub(job.NextExecution) > missedExecutionThreshold {
+			after = job.NextExecution
+		}
+		next, err = cron.NextAfter(schedule, after, false)
+		if err == nil {
+			next, err = s.nextExecution(job, schedule, next)
+		}
+		if err != nil {
+			logger.Errorf(err, "failed to calculate next execution for cron job %v with schedule %q", job.Key, job.Schedule)
+			return err
+		}
 	}
 
-	updatedJob, err := s.dal.EndCronJob(ctx, job, next)
-	if err != nil {
+	// The job has to be ended even if this execution was cancelled.
+	updatedJob, err := s.dal.EndCronJob(context.WithoutCancel(ctx), job, next)
+	if allowsConcurrentExecutions(job) && errors.Is(err, dal.ErrNotFound) {
+		// A later execution has started since, and will end the job.
+		logger.Debugf("cron job %v was started again before execution finished", job.Key)
+	} else if err != nil {
 		logger.Errorf(err, "failed to end cron job %v", job.Key)
 	} else {
 		s.events.Publish(endedJobsEvent{
 			jobs: []model.CronJob{updatedJob},
 		})
 	}
+	return callErr
+}
+
+// cancelIfReplaced cancels an execution of a job once a later execution has
+// started, until ctx is done.
+//
+// Later executions started by this controller cancel earlier ones directly,
+// but those started by other controllers can only be detected through the
+// database.
+func (s *Service) cancelIfReplaced(ctx context.Context, job model.CronJob, cancel context.CancelFunc) {
+	logger := log.FromContext(ctx)
+	ticker := s.clock.Ticker(replacedCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			executing, err := s.dal.IsCronJobExecuting(ctx, job)
+			if err != nil {
+				logger.Warnf("failed to check whether cron job %v was replaced: %s", job.Key, err)
+				continue
+			}
+			if !executing {
+				logger.Infof("cancelling execution of cron job %v, it has been ended or replaced since", job.Key)
+				cancel()
+				return
+			}
+		}
+	}
 }
 
 // killOldJobs looks for jobs that have been executing for too long.
@@ -266,7 +453,7 @@ func (s *Service) killOldJobs(ctx context.Context) (time.Duration, error) {
 			continue
 		}
 
-		updated, err := s.dal.EndCronJob(ctx, stale, next)
+		updated, err := s.dal.EndCronJob(ctx, stale, addJitter(stale, next))
 		if err != nil {
 			logger.Errorf(err, "Could not kill stale cron job %s because: %v", stale.Key, err)
 			continue
@@ -296,7 +483,7 @@ func (s *Service) watchForUpdates(ctx context.Context) {
 	defer s.events.Unsubscribe(events)
 
 	state := &state{
-		executing:    map[string]bool{},
+		executing:    map[string]context.CancelFunc{},
 		newJobs:      map[string]time.Time{},
 		blockedUntil: s.clock.Now(),
 	}
@@ -360,8 +547,13 @@ func (s *Service) watchForUpdates(ctx context.Context) {
 					continue
 				}
 				logger.Infof("executing job %v", job.Key)
-				state.startedExecutingJob(job.CronJob)
-				go s.executeJob(ctx, job.CronJob)
+				jobCtx, cancel := context.WithCancel(ctx)
+				state.startedExecutingJob(job.CronJob, cancel)
+				go func() {
+					defer cancel()
+					// Errors are logged by executeScheduledJob.
+					_ = s.executeScheduledJob(jobCtx, job.CronJob)
+				}()
 			}
 
 			// Update job list
@@ -377,6 +569,9 @@ func (s *Service) watchForUpdates(ctx context.Context) {
 			case endedJobsEvent:
 				logger.Tracef("updating %d jobs", len(event.jobs))
 				state.updateJobs(event.jobs)
+			case rescheduledJobsEvent:
+				logger.Tracef("rescheduling %d jobs", len(event.jobs))
+				state.updateJobs(event.jobs)
 			case updatedHashRingEvent:
 				// do another cycle through the loop to see if new jobs need to be scheduled
 			}
@@ -390,6 +585,10 @@ func (s *Service) nextAttemptForJob(job model.CronJob, state *state, allowsNow b
 	}
 	if job.State == model.CronJobStateExecuting {
 		if state.isExecutingInCurrentController(job) {
+			if allowsConcurrentExecutions(job) {
+				// The next execution was scheduled when this one started
+				return job.NextExecution, nil
+			}
 			// no need to schedule this job until it finishes
 			return s.clock.Now(), fmt.Errorf("controller is already waiting for job to finish")
 		}
@@ -407,6 +606,45 @@ func (s *Service) nextAttemptForJob(job model.CronJob, state *state, allowsNow b
 	return job.NextExecution, nil
 }
 
+// nextExecution returns the execution of a job to run next, out of first and
+// the scheduled executions after it.
+//
+// Only the most recent executions that were missed, up to the job's catch-up
+// limit, are run. If no missed executions are to be run, the next execution
+// that was not missed is returned, delayed by the job's jitter.
+func (s *Service) nextExecution(job model.CronJob, pattern cron.Pattern, first time.Time) (time.Time, error) {
+	now := s.clock.Now().UTC()
+	missed := []time.Time{}
+	next := first
+	for now.Sub(next) > missedExecutionThreshold {
+		missed = append(missed, next)
+		if len(missed) > job.CatchupLimit {
+			missed = missed[1:]
+		}
+		var err error
+		next, err = cron.NextAfter(pattern, next, false)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	if len(missed) > 0 {
+		return missed[0], nil
+	}
+	return addJitter(job, next), nil
+}
+
+// addJitter delays an execution by a random duration of up to the job's jitter.
+func addJitter(job model.CronJob, t time.Time) time.Time {
+	if job.Jitter <= 0 {
+		return t
+	}
+	return t.Add(time.Duration(rand.Int63n(int64(job.Jitter)))) //nolint:gosec
+}
+
+func allowsConcurrentExecutions(job model.CronJob) bool {
+	return job.Concurrency == model.CronJobConcurrencyAllow || job.Concurrency == model.CronJobConcurrencyReplace
+}
+
 // UpdatedControllerList synchronises the hash ring with the active controllers.
 func (s *Service) UpdatedControllerList(ctx context.Context, controllers []dal.Controller) {
 	logger := log.FromContext(ctx).Scope("cron")
@@ -448,7 +686,11 @@ func (s *Service) UpdatedControllerList(ctx context.Context, controllers []dal.C
 
 // isResponsibleForJob indicates whether a this service should be responsible for attempting jobs,
 // or if enough other controllers will handle it. This allows us to spread the job load across controllers.
+// No controller is responsible for paused jobs.
 func (s *Service) isResponsibleForJob(job model.CronJob, state *state) bool {
+	if job.Paused {
+		return false
+	}
 	if state.isJobTooNewForHashRing(job) {
 		return true
 	}
@@ -14,6 +14,7 @@ import (
 
 	db "github.com/TBD54566975/ftl/backend/controller/dal"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
@@ -119,3 +120,300 @@ func TestHashRing(t *testing.T) {
 	}
 	assert.True(t, hasFoundNonMatchingKeys, "expected at least one verb to have different controllers assigned")
 }
+
+func TestPauseResumeAndTrigger(t *testing.T) {
+	t.Parallel()
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	clk := clock.NewMock()
+	clk.Add(time.Second)
+	mockDal := &mockDAL{
+		clock:           clk,
+		lock:            sync.Mutex{},
+		attemptCountMap: map[string]int{},
+	}
+	jobs := newJobs(t, "initial", "*/10 * * * * * *", clk, 1)
+	_, err := mockDal.CreateDeployment(ctx, "go", &schema.Module{Name: "initial"}, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobs)
+	assert.NoError(t, err)
+
+	callCountLock := sync.Mutex{}
+	callCount := 0
+	controllers := newControllers(ctx, 1, mockDal, func() clock.Clock { return clk }, func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], o optional.Option[model.RequestKey], s string) (*connect.Response[ftlv1.CallResponse], error) {
+		callCountLock.Lock()
+		defer callCountLock.Unlock()
+		callCount++
+		return &connect.Response[ftlv1.CallResponse]{}, nil
+	})
+	svc := controllers[0].cronJobs
+	calls := func() int {
+		time.Sleep(time.Millisecond * 100)
+		callCountLock.Lock()
+		defer callCountLock.Unlock()
+		return callCount
+	}
+	currentJob := func() model.CronJob {
+		jobs, err := mockDal.GetCronJobs(ctx)
+		assert.NoError(t, err)
+		return jobs[0]
+	}
+
+	assert.NoError(t, svc.PauseJob(ctx, currentJob()))
+	assert.True(t, currentJob().Paused)
+	clk.Add(time.Second * 10)
+	assert.Equal(t, 0, calls(), "paused job should not be executed")
+
+	// Paused jobs can still be triggered.
+	assert.NoError(t, svc.TriggerJob(ctx, currentJob()))
+	assert.Equal(t, 1, calls())
+	assert.Equal(t, model.CronJobState(model.CronJobStateIdle), currentJob().State)
+
+	assert.NoError(t, svc.ResumeJob(ctx, currentJob()))
+	assert.False(t, currentJob().Paused)
+	assert.True(t, currentJob().NextExecution.After(clk.Now()), "missed executions should be skipped")
+	// Give the service time to reschedule the job before advancing the clock.
+	time.Sleep(time.Millisecond * 100)
+	clk.Add(time.Second * 10)
+	assert.Equal(t, 2, calls())
+}
+
+func TestCatchup(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name         string
+		catchupLimit int
+		expected     int
+	}{
+		{name: "None", catchupLimit: 0, expected: 0},
+		{name: "Last", catchupLimit: 1, expected: 1},
+		{name: "All", catchupLimit: 3, expected: 3},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := log.ContextWithNewDefaultLogger(context.Background())
+			ctx, cancel := context.WithCancel(ctx)
+			t.Cleanup(cancel)
+
+			clk := clock.NewMock()
+			clk.Add(time.Second)
+			mockDal := &mockDAL{
+				clock:           clk,
+				lock:            sync.Mutex{},
+				attemptCountMap: map[string]int{},
+			}
+			jobs := newJobs(t, "initial", "0 */5 * * * * *", clk, 1)
+			jobs[0].CatchupLimit = tt.catchupLimit
+			_, err := mockDal.CreateDeployment(ctx, "go", &schema.Module{Name: "initial"}, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobs)
+			assert.NoError(t, err)
+
+			// No controller is running for the 12 executions between 00:05 and 01:00.
+			clk.Add(time.Hour + time.Minute*2 + time.Second*30)
+
+			callCountLock := sync.Mutex{}
+			callCount := 0
+			_ = newControllers(ctx, 1, mockDal, func() clock.Clock { return clk }, func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], o optional.Option[model.RequestKey], s string) (*connect.Response[ftlv1.CallResponse], error) {
+				callCountLock.Lock()
+				defer callCountLock.Unlock()
+				callCount++
+				return &connect.Response[ftlv1.CallResponse]{}, nil
+			})
+			for range 15 {
+				clk.Add(time.Second)
+				time.Sleep(time.Millisecond * 50)
+			}
+
+			callCountLock.Lock()
+			assert.Equal(t, tt.expected, callCount)
+			callCountLock.Unlock()
+			current, err := mockDal.GetCronJobs(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, time.Unix(0, 0).Add(time.Hour+time.Minute*5).UTC(), current[0].NextExecution.UTC())
+			assert.Equal(t, model.CronJobState(model.CronJobStateIdle), current[0].State)
+		})
+	}
+}
+
+func TestConcurrency(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		concurrency       model.CronJobConcurrency
+		expectedStarted   int
+		expectedCancelled int
+	}{
+		{concurrency: model.CronJobConcurrencyForbid, expectedStarted: 1, expectedCancelled: 0},
+		{concurrency: model.CronJobConcurrencyAllow, expectedStarted: 3, expectedCancelled: 0},
+		{concurrency: model.CronJobConcurrencyReplace, expectedStarted: 3, expectedCancelled: 2},
+	} {
+		t.Run(string(tt.concurrency), func(t *testing.T) {
+			t.Parallel()
+			ctx := log.ContextWithNewDefaultLogger(context.Background())
+			ctx, cancel := context.WithCancel(ctx)
+			t.Cleanup(cancel)
+
+			clk := clock.NewMock()
+			clk.Add(time.Second)
+			mockDal := &mockDAL{
+				clock:           clk,
+				lock:            sync.Mutex{},
+				attemptCountMap: map[string]int{},
+			}
+			jobs := newJobs(t, "initial", "*/10 * * * * * *", clk, 1)
+			jobs[0].Concurrency = tt.concurrency
+			_, err := mockDal.CreateDeployment(ctx, "go", &schema.Module{Name: "initial"}, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobs)
+			assert.NoError(t, err)
+
+			// Executions run until released or cancelled.
+			release := make(chan struct{})
+			countLock := sync.Mutex{}
+			started := 0
+			cancelled := 0
+			_ = newControllers(ctx, 1, mockDal, func() clock.Clock { return clk }, func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], o optional.Option[model.RequestKey], s string) (*connect.Response[ftlv1.CallResponse], error) {
+				countLock.Lock()
+				started++
+				countLock.Unlock()
+				select {
+				case <-release:
+					return &connect.Response[ftlv1.CallResponse]{}, nil
+				case <-ctx.Done():
+					countLock.Lock()
+					cancelled++
+					countLock.Unlock()
+					return nil, ctx.Err()
+				}
+			})
+			for range 3 {
+				clk.Add(time.Second * 10)
+				time.Sleep(time.Millisecond * 100)
+			}
+
+			countLock.Lock()
+			assert.Equal(t, tt.expectedStarted, started)
+			assert.Equal(t, tt.expectedCancelled, cancelled)
+			countLock.Unlock()
+
+			close(release)
+			time.Sleep(time.Millisecond * 100)
+			current, err := mockDal.GetCronJobs(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, model.CronJobState(model.CronJobStateIdle), current[0].State)
+			assert.True(t, current[0].NextExecution.After(clk.Now()), "next execution should be in the future")
+		})
+	}
+}
+
+func TestReplaceAcrossControllers(t *testing.T) {
+	// Each controller and the DAL have their own mock clock, so that each
+	// execution can be started by a chosen controller.
+	t.Parallel()
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	dalClock := clock.NewMock()
+	dalClock.Add(time.Second)
+	mockDal := &mockDAL{
+		clock:           dalClock,
+		lock:            sync.Mutex{},
+		attemptCountMap: map[string]int{},
+	}
+	jobs := newJobs(t, "initial", "*/10 * * * * * *", dalClock, 1)
+	jobs[0].Concurrency = model.CronJobConcurrencyReplace
+	_, err := mockDal.CreateDeployment(ctx, "go", &schema.Module{Name: "initial"}, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobs)
+	assert.NoError(t, err)
+
+	release := make(chan struct{})
+	countLock := sync.Mutex{}
+	started := 0
+	cancelled := 0
+	controllers := newControllers(ctx, 2, mockDal, func() clock.Clock {
+		clk := clock.NewMock()
+		clk.Add(time.Second)
+		return clk
+	}, func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], o optional.Option[model.RequestKey], s string) (*connect.Response[ftlv1.CallResponse], error) {
+		countLock.Lock()
+		started++
+		countLock.Unlock()
+		select {
+		case <-release:
+			return &connect.Response[ftlv1.CallResponse]{}, nil
+		case <-ctx.Done():
+			countLock.Lock()
+			cancelled++
+			countLock.Unlock()
+			return nil, ctx.Err()
+		}
+	})
+
+	// The first controller starts the first execution.
+	dalClock.Add(time.Second * 10)
+	controllers[0].mockClock.Add(time.Second * 10)
+	time.Sleep(time.Millisecond * 100)
+
+	// The second controller replaces it with the next execution.
+	dalClock.Add(time.Second * 10)
+	controllers[1].mockClock.Add(time.Second * 20)
+	time.Sleep(time.Millisecond * 100)
+
+	countLock.Lock()
+	assert.Equal(t, 2, started)
+	assert.Equal(t, 0, cancelled)
+	countLock.Unlock()
+
+	// The first controller notices its execution was replaced.
+	controllers[0].mockClock.Add(replacedCheckInterval)
+	time.Sleep(time.Millisecond * 100)
+
+	countLock.Lock()
+	assert.Equal(t, 2, started)
+	assert.Equal(t, 1, cancelled)
+	countLock.Unlock()
+
+	close(release)
+	time.Sleep(time.Millisecond * 100)
+	current, err := mockDal.GetCronJobs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, model.CronJobState(model.CronJobStateIdle), current[0].State)
+}
+
+func TestJitter(t *testing.T) {
+	t.Parallel()
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	clk := clock.NewMock()
+	mockDal := &mockDAL{
+		clock:           clk,
+		lock:            sync.Mutex{},
+		attemptCountMap: map[string]int{},
+	}
+	svc := NewForTesting(ctx, model.NewControllerKey("localhost", "8080"), "test.com", Config{Timeout: time.Minute}, mockDal, &mockScheduler{}, nil, clk)
+
+	module := &schema.Module{
+		Name: "test",
+		Decls: []schema.Decl{
+			&schema.Verb{
+				Name:     "jittered",
+				Request:  &schema.Unit{},
+				Response: &schema.Unit{},
+				Metadata: []schema.Metadata{&schema.MetadataCronJob{Cron: "@hourly", Concurrency: "allow", Jitter: "30m", Catchup: "all", MaxCatchup: 5}},
+			},
+		},
+	}
+	hour := time.Unix(0, 0).Add(time.Hour)
+	nexts := map[time.Time]bool{}
+	for range 10 {
+		jobs, err := svc.NewCronJobsForModule(ctx, module.ToProto().(*schemapb.Module)) //nolint:forcetypeassert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobs))
+		job := jobs[0]
+		assert.Equal(t, model.CronJobConcurrencyAllow, job.Concurrency)
+		assert.Equal(t, time.Minute*30, job.Jitter)
+		assert.Equal(t, 5, job.CatchupLimit)
+		assert.False(t, job.NextExecution.Before(hour), "next execution %s is before %s", job.NextExecution, hour)
+		assert.True(t, job.NextExecution.Before(hour.Add(time.Minute*30)), "next execution %s is not within jitter", job.NextExecution)
+		nexts[job.NextExecution] = true
+	}
+	assert.True(t, len(nexts) > 1, "expected executions to be spread out by jitter")
+}
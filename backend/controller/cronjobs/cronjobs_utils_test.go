@@ -56,7 +56,8 @@ func (d *mockDAL) GetCronJobs(ctx context.Context) ([]model.CronJob, error) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
-	return d.jobs, nil
+	// Copy the jobs, as they are updated in place.
+	return append([]model.CronJob{}, d.jobs...), nil
 }
 
 func (d *mockDAL) indexForJob(job model.CronJob) (int, error) {
@@ -81,7 +82,8 @@ func (d *mockDAL) StartCronJobs(ctx context.Context, jobs []model.CronJob) (atte
 			return nil, err
 		}
 		job := d.jobs[i]
-		if !job.NextExecution.After(now) && job.State == model.CronJobStateIdle {
+		canOverlap := job.Concurrency == model.CronJobConcurrencyAllow || job.Concurrency == model.CronJobConcurrencyReplace
+		if !job.NextExecution.After(now) && (job.State == model.CronJobStateIdle || (canOverlap && job.StartTime.Before(job.NextExecution))) && !job.Paused {
 			job.State = model.CronJobStateExecuting
 			job.StartTime = d.clock.Now()
 			d.jobs[i] = job
@@ -112,10 +114,10 @@ func (d *mockDAL) EndCronJob(ctx context.Context, job model.CronJob, next time.T
 	}
 	internalJob := d.jobs[i]
 	if internalJob.State != model.CronJobStateExecuting {
-		return model.CronJob{}, fmt.Errorf("job can not be stopped, it isnt running")
+		return model.CronJob{}, fmt.Errorf("job can not be stopped, it isnt running: %w", db.ErrNotFound)
 	}
 	if internalJob.StartTime != job.StartTime {
-		return model.CronJob{}, fmt.Errorf("job can not be stopped, start time does not match")
+		return model.CronJob{}, fmt.Errorf("job can not be stopped, start time does not match: %w", db.ErrNotFound)
 	}
 	internalJob.State = model.CronJobStateIdle
 	internalJob.NextExecution = next
@@ -123,6 +125,35 @@ func (d *mockDAL) EndCronJob(ctx context.Context, job model.CronJob, next time.T
 	return internalJob, nil
 }
 
+func (d *mockDAL) ScheduleCronJob(ctx context.Context, job model.CronJob, next time.Time) (model.CronJob, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	i, err := d.indexForJob(job)
+	if err != nil {
+		return model.CronJob{}, err
+	}
+	internalJob := d.jobs[i]
+	if internalJob.State != model.CronJobStateExecuting || internalJob.StartTime != job.StartTime {
+		return model.CronJob{}, db.ErrNotFound
+	}
+	internalJob.NextExecution = next
+	d.jobs[i] = internalJob
+	return internalJob, nil
+}
+
+func (d *mockDAL) IsCronJobExecuting(ctx context.Context, job model.CronJob) (bool, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	i, err := d.indexForJob(job)
+	if err != nil {
+		return false, err
+	}
+	internalJob := d.jobs[i]
+	return internalJob.State == model.CronJobStateExecuting && internalJob.StartTime == job.StartTime, nil
+}
+
 func (d *mockDAL) GetStaleCronJobs(ctx context.Context, duration time.Duration) ([]model.CronJob, error) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -132,6 +163,37 @@ func (d *mockDAL) GetStaleCronJobs(ctx context.Context, duration time.Duration)
 	}), nil
 }
 
+func (d *mockDAL) SetCronJobPaused(ctx context.Context, key model.CronJobKey, paused bool, next time.Time) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	i, err := d.indexForJob(model.CronJob{Key: key})
+	if err != nil {
+		return err
+	}
+	d.jobs[i].Paused = paused
+	d.jobs[i].NextExecution = next
+	return nil
+}
+
+func (d *mockDAL) TriggerCronJob(ctx context.Context, key model.CronJobKey) (model.CronJob, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	i, err := d.indexForJob(model.CronJob{Key: key})
+	if err != nil {
+		return model.CronJob{}, err
+	}
+	job := d.jobs[i]
+	if job.State != model.CronJobStateIdle {
+		return model.CronJob{}, db.ErrNotFound
+	}
+	job.State = model.CronJobStateExecuting
+	job.StartTime = d.clock.Now()
+	d.jobs[i] = job
+	return job, nil
+}
+
 type mockScheduler struct {
 }
 
@@ -167,6 +229,8 @@ func newJobs(t *testing.T, moduleName string, cronPattern string, clock clock.Cl
 			StartTime:     now,
 			NextExecution: next,
 			State:         model.CronJobStateIdle,
+			Concurrency:   model.CronJobConcurrencyForbid,
+			CatchupLimit:  1,
 		})
 	}
 	return newJobs
@@ -1,6 +1,7 @@
 package cronjobs
 
 import (
+	"context"
 	"time"
 
 	"github.com/alecthomas/types/optional"
@@ -13,8 +14,11 @@ import (
 type state struct {
 	jobs []model.CronJob
 
-	// Used to determine if this controller is currently executing a job
-	executing map[string]bool
+	// Used to determine if this controller is currently executing a job, and to
+	// cancel the execution if it is replaced by a later one on this controller.
+	// Executions replaced on other controllers cancel themselves, see
+	// [Service.cancelIfReplaced].
+	executing map[string]context.CancelFunc
 
 	// Newly created jobs should be attempted by the controller that created them until other controllers
 	// have a chance to resync their job lists and share responsibilities through the hash ring
@@ -25,11 +29,15 @@ type state struct {
 }
 
 func (s *state) isExecutingInCurrentController(job model.CronJob) bool {
-	return s.executing[job.Key.String()]
+	_, ok := s.executing[job.Key.String()]
+	return ok
 }
 
-func (s *state) startedExecutingJob(job model.CronJob) {
-	s.executing[job.Key.String()] = true
+func (s *state) startedExecutingJob(job model.CronJob, cancel context.CancelFunc) {
+	if previous, ok := s.executing[job.Key.String()]; ok && job.Concurrency == model.CronJobConcurrencyReplace {
+		previous()
+	}
+	s.executing[job.Key.String()] = cancel
 }
 
 func (s *state) isJobTooNewForHashRing(job model.CronJob) bool {
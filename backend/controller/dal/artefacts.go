@@ -0,0 +1,277 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// ArtefactStore stores the content of artefacts, addressed by their SHA256
+// digest.
+//
+// The DAL tracks which artefacts exist and which deployments reference them,
+// the store is only responsible for their content.
+type ArtefactStore interface {
+	// Upload stores content under its digest.
+	//
+	// Uploading content that already exists is a no-op.
+	Upload(ctx context.Context, digest sha256.SHA256, content []byte) error
+	// Download returns a reader for the content with the given digest.
+	//
+	// Returns ErrNotFound if the content does not exist.
+	Download(ctx context.Context, digest sha256.SHA256) (io.ReadCloser, error)
+	// Delete removes the content with the given digest.
+	//
+	// Deleting content that does not exist is a no-op.
+	Delete(ctx context.Context, digest sha256.SHA256) error
+}
+
+// Option configures the DAL.
+type Option func(d *DAL)
+
+// WithArtefactStore sets the store used for artefact content.
+//
+// Defaults to storing artefact content in Postgres.
+func WithArtefactStore(store ArtefactStore) Option {
+	return func(d *DAL) {
+		d.artefacts = store
+	}
+}
+
+// CreateArtefact stores an artefact and returns its digest.
+//
+// The artefact row is created, or locked if it already exists, in a
+// transaction that is held while the content is uploaded. Garbage collection
+// of the same digest locks the row too, so the two are serialised. Creating
+// an existing artefact also restarts its grace period, so it is not collected
+// as soon as the lock is released.
+//
+// The content is not part of the transaction: if the transaction fails after
+// the upload, the content is left in the store without a row.
+func (d *DAL) CreateArtefact(ctx context.Context, content []byte) (digest sha256.SHA256, err error) {
+	digest = sha256.Sum(content)
+
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return digest, translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	err = tx.CreateArtefact(ctx, digest[:])
+	if err != nil {
+		return digest, translatePGError(err)
+	}
+	err = d.artefacts.Upload(ctx, digest, content)
+	if err != nil {
+		return digest, fmt.Errorf("failed to store artefact %s: %w", digest, err)
+	}
+	return digest, nil
+}
+
+// GCArtefacts deletes up to [limit] artefacts older than [gracePeriod] that
+// are not referenced by any deployment.
+//
+// The age of artefacts is measured by the database clock, which also records
+// their creation.
+//
+// Returns the number of artefacts deleted.
+func (d *DAL) GCArtefacts(ctx context.Context, gracePeriod time.Duration, limit int) (int, error) {
+	logger := log.FromContext(ctx)
+	digests, err := d.db.GetUnreferencedArtefacts(ctx, gracePeriod, int32(limit))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get unreferenced artefacts: %w", translatePGError(err))
+	}
+	deleted := 0
+	var errs []error
+	for _, digest := range digests {
+		ok, err := d.gcArtefact(ctx, sha256.FromBytes(digest), gracePeriod)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if ok {
+			logger.Debugf("Deleted unreferenced artefact %s", sha256.FromBytes(digest))
+			deleted++
+		}
+	}
+	return deleted, errors.Join(errs...)
+}
+
+// gcArtefact deletes a single artefact and its content, if it is still
+// unreferenced and was not created again since we looked.
+//
+// The artefact row stays locked until its content has been deleted, see
+// [DAL.CreateArtefact].
+func (d *DAL) gcArtefact(ctx context.Context, digest sha256.SHA256, gracePeriod time.Duration) (deleted bool, err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return false, translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	_, err = tx.DeleteUnreferencedArtefact(ctx, digest[:], gracePeriod)
+	if isNotFound(err) {
+		// Referenced by a deployment or created again since we looked.
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to delete artefact %s: %w", digest, translatePGError(err))
+	}
+	err = d.artefacts.Delete(ctx, digest)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete content of artefact %s: %w", digest, err)
+	}
+	return true, nil
+}
+
+var _ ArtefactStore = (*postgresArtefactStore)(nil)
+
+// postgresArtefactStore stores artefact content in the controller database.
+//
+// Content is read and written through the connection pool, outside of the
+// caller's transaction, like any other [ArtefactStore].
+type postgresArtefactStore struct {
+	db sql.DBI
+}
+
+func (p *postgresArtefactStore) Upload(ctx context.Context, digest sha256.SHA256, content []byte) error {
+	return translatePGError(p.db.UpsertArtefactContent(ctx, digest[:], content))
+}
+
+func (p *postgresArtefactStore) Download(ctx context.Context, digest sha256.SHA256) (io.ReadCloser, error) {
+	return &postgresArtefactReader{digest: digest, db: p.db}, nil
+}
+
+func (p *postgresArtefactStore) Delete(ctx context.Context, digest sha256.SHA256) error {
+	return translatePGError(p.db.DeleteArtefactContent(ctx, digest[:]))
+}
+
+// postgresArtefactReader reads artefact content from the database in chunks.
+type postgresArtefactReader struct {
+	digest sha256.SHA256
+	db     sql.DBI
+	offset int32
+}
+
+func (r *postgresArtefactReader) Close() error { return nil }
+
+func (r *postgresArtefactReader) Read(p []byte) (n int, err error) {
+	content, err := r.db.GetArtefactContentRange(context.Background(), r.offset+1, int32(len(p)), r.digest[:])
+	if err != nil {
+		return 0, translatePGError(err)
+	}
+	copy(p, content)
+	clen := len(content)
+	r.offset += int32(clen)
+	if clen == 0 {
+		err = io.EOF
+	}
+	return clen, err
+}
+
+var _ ArtefactStore = (*FilesystemArtefactStore)(nil)
+
+// FilesystemArtefactStore stores artefact content as files in a directory,
+// named by their digest.
+//
+// The directory may be shared between controllers, eg. via a network
+// filesystem.
+type FilesystemArtefactStore struct {
+	root string
+}
+
+// NewFilesystemArtefactStore creates a new FilesystemArtefactStore rooted at
+// [root], creating the directory if necessary.
+func NewFilesystemArtefactStore(root string) (*FilesystemArtefactStore, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create artefact store directory: %w", err)
+	}
+	return &FilesystemArtefactStore{root: root}, nil
+}
+
+func (f *FilesystemArtefactStore) Upload(ctx context.Context, digest sha256.SHA256, content []byte) error {
+	path := f.path(digest)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create artefact directory: %w", err)
+	}
+	// Write to a temporary file and rename it into place so that partially
+	// written content is never visible.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create artefact: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write artefact: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write artefact: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store artefact: %w", err)
+	}
+	return nil
+}
+
+func (f *FilesystemArtefactStore) Download(ctx context.Context, digest sha256.SHA256) (io.ReadCloser, error) {
+	r, err := os.Open(f.path(digest))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("artefact %s: %w", digest, ErrNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open artefact: %w", err)
+	}
+	return r, nil
+}
+
+func (f *FilesystemArtefactStore) Delete(ctx context.Context, digest sha256.SHA256) error {
+	err := os.Remove(f.path(digest))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete artefact: %w", err)
+	}
+	return nil
+}
+
+// path returns the path to the content for a digest, sharded by the first byte
+// of the digest to keep directory sizes manageable.
+func (f *FilesystemArtefactStore) path(digest sha256.SHA256) string {
+	hex := digest.String()
+	return filepath.Join(f.root, hex[:2], hex)
+}
+
+// lazyArtefactReader defers opening artefact content until it is first read.
+type lazyArtefactReader struct {
+	ctx    context.Context
+	store  ArtefactStore
+	digest sha256.SHA256
+	r      io.ReadCloser
+}
+
+func (l *lazyArtefactReader) Read(p []byte) (int, error) {
+	if l.r == nil {
+		r, err := l.store.Download(l.ctx, l.digest)
+		if err != nil {
+			return 0, err
+		}
+		l.r = r
+	}
+	return l.r.Read(p)
+}
+
+func (l *lazyArtefactReader) Close() error {
+	if l.r == nil {
+		return nil
+	}
+	return l.r.Close()
+}
@@ -0,0 +1,140 @@
+package dal
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+func TestFilesystemArtefactStore(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	store, err := NewFilesystemArtefactStore(t.TempDir())
+	assert.NoError(t, err)
+
+	content := []byte("hello world")
+	digest := sha256.Sum(content)
+
+	_, err = store.Download(ctx, digest)
+	assert.IsError(t, err, ErrNotFound)
+
+	err = store.Upload(ctx, digest, content)
+	assert.NoError(t, err)
+	err = store.Upload(ctx, digest, content)
+	assert.NoError(t, err, "uploading existing content should be a no-op")
+
+	assert.Equal(t, content, readArtefact(t, store, digest))
+
+	err = store.Delete(ctx, digest)
+	assert.NoError(t, err)
+	err = store.Delete(ctx, digest)
+	assert.NoError(t, err, "deleting missing content should be a no-op")
+
+	_, err = store.Download(ctx, digest)
+	assert.IsError(t, err, ErrNotFound)
+}
+
+func TestGCArtefacts(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	store, err := NewFilesystemArtefactStore(t.TempDir())
+	assert.NoError(t, err)
+	dal, err := New(ctx, conn, WithArtefactStore(store))
+	assert.NoError(t, err)
+
+	referenced, err := dal.CreateArtefact(ctx, []byte("referenced"))
+	assert.NoError(t, err)
+	unreferenced, err := dal.CreateArtefact(ctx, []byte("unreferenced"))
+	assert.NoError(t, err)
+
+	err = dal.UpsertModule(ctx, "go", "test")
+	assert.NoError(t, err)
+	_, err = dal.CreateDeployment(ctx, "go", &schema.Module{Name: "test"}, []DeploymentArtefact{
+		{Digest: referenced, Path: "main", Executable: true},
+	}, nil, nil)
+	assert.NoError(t, err)
+
+	// Recently created artefacts are not collected.
+	count, err := dal.GCArtefacts(ctx, time.Hour, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// Creating an artefact again restarts its grace period.
+	ageArtefact(ctx, t, conn, unreferenced, 2*time.Hour)
+	_, err = dal.CreateArtefact(ctx, []byte("unreferenced"))
+	assert.NoError(t, err)
+	count, err = dal.GCArtefacts(ctx, time.Hour, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	ageArtefact(ctx, t, conn, referenced, 2*time.Hour)
+	ageArtefact(ctx, t, conn, unreferenced, 2*time.Hour)
+	count, err = dal.GCArtefacts(ctx, time.Hour, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	missing, err := dal.GetMissingArtefacts(ctx, []sha256.SHA256{referenced, unreferenced})
+	assert.NoError(t, err)
+	assert.Equal(t, []sha256.SHA256{unreferenced}, missing)
+
+	assert.Equal(t, []byte("referenced"), readArtefact(t, store, referenced))
+	_, err = store.Download(ctx, unreferenced)
+	assert.IsError(t, err, ErrNotFound)
+}
+
+func TestGCArtefactsAfterDiff(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	store, err := NewFilesystemArtefactStore(t.TempDir())
+	assert.NoError(t, err)
+	dal, err := New(ctx, conn, WithArtefactStore(store))
+	assert.NoError(t, err)
+
+	digest, err := dal.CreateArtefact(ctx, []byte("artefact"))
+	assert.NoError(t, err)
+	// The artefact is past its grace period by the time the client diffs.
+	ageArtefact(ctx, t, conn, digest, 2*time.Hour)
+
+	missing, err := dal.GetMissingArtefacts(ctx, []sha256.SHA256{digest})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(missing))
+
+	// An artefact reported as present must survive until the client creates
+	// its deployment.
+	count, err := dal.GCArtefacts(ctx, time.Hour, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	err = dal.UpsertModule(ctx, "go", "test")
+	assert.NoError(t, err)
+	_, err = dal.CreateDeployment(ctx, "go", &schema.Module{Name: "test"}, []DeploymentArtefact{
+		{Digest: digest, Path: "main", Executable: true},
+	}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("artefact"), readArtefact(t, store, digest))
+}
+
+// ageArtefact moves the creation time of an artefact back by age.
+func ageArtefact(ctx context.Context, t *testing.T, conn *pgxpool.Pool, digest sha256.SHA256, age time.Duration) {
+	t.Helper()
+	_, err := conn.Exec(ctx, "UPDATE artefacts SET created_at = created_at - $2::interval WHERE digest = $1", digest[:], age)
+	assert.NoError(t, err)
+}
+
+func readArtefact(t *testing.T, store ArtefactStore, digest sha256.SHA256) []byte {
+	t.Helper()
+	r, err := store.Download(context.Background(), digest)
+	assert.NoError(t, err)
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	return content
+}
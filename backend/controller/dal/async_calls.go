@@ -11,6 +11,7 @@ import (
 
 	"github.com/TBD54566975/ftl/backend/controller/sql"
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 // AsyncCallOrigin represents the kind of originator of the async call.
@@ -22,6 +23,27 @@ const (
 	AsyncCallOriginPubSub = AsyncCallOrigin(sql.AsyncCallOriginPubsub)
 )
 
+// ParseAsyncCallOrigin parses an async call origin, eg. "fsm".
+func ParseAsyncCallOrigin(origin string) (AsyncCallOrigin, error) {
+	switch o := AsyncCallOrigin(origin); o {
+	case AsyncCallOriginFSM, AsyncCallOriginCron, AsyncCallOriginPubSub:
+		return o, nil
+	default:
+		return "", fmt.Errorf("unknown async call origin %q", origin)
+	}
+}
+
+// AsyncCallState is the execution state of an async call.
+type AsyncCallState sql.AsyncCallState
+
+const (
+	AsyncCallStatePending   = AsyncCallState(sql.AsyncCallStatePending)
+	AsyncCallStateExecuting = AsyncCallState(sql.AsyncCallStateExecuting)
+	AsyncCallStateSuccess   = AsyncCallState(sql.AsyncCallStateSuccess)
+	AsyncCallStateError     = AsyncCallState(sql.AsyncCallStateError)
+	AsyncCallStateDiscarded = AsyncCallState(sql.AsyncCallStateDiscarded)
+)
+
 type AsyncCall struct {
 	*Lease
 	ID     int64
@@ -30,6 +52,8 @@ type AsyncCall struct {
 	OriginKey string
 	Verb      schema.Ref
 	Request   json.RawMessage
+	// RetryAttempt is the number of times the call has previously been retried.
+	RetryAttempt int
 }
 
 // AcquireAsyncCall acquires a pending async call to execute.
@@ -47,12 +71,13 @@ func (d *DAL) AcquireAsyncCall(ctx context.Context) (*AsyncCall, error) {
 		return nil, fmt.Errorf("failed to acquire async call: %w", err)
 	}
 	return &AsyncCall{
-		ID:        row.AsyncCallID,
-		Verb:      row.Verb,
-		Origin:    AsyncCallOrigin(row.Origin),
-		OriginKey: row.OriginKey,
-		Request:   row.Request,
-		Lease:     d.newLease(ctx, row.LeaseKey, row.LeaseIdempotencyKey, ttl),
+		ID:           row.AsyncCallID,
+		Verb:         row.Verb,
+		Origin:       AsyncCallOrigin(row.Origin),
+		OriginKey:    row.OriginKey,
+		Request:      row.Request,
+		RetryAttempt: int(row.RetryAttempt),
+		Lease:        d.newLease(ctx, row.LeaseKey, row.LeaseIdempotencyKey, row.LeaseFencingToken, ttl),
 	}, nil
 }
 
@@ -63,7 +88,22 @@ func (d *DAL) CompleteAsyncCall(ctx context.Context, call *AsyncCall, response [
 	if (response == nil) != responseError.Ok() {
 		return fmt.Errorf("must provide exactly one of response or error")
 	}
-	_, err := d.db.CompleteAsyncCall(ctx, response, responseError, call.ID)
+	var err error
+	if callError, ok := responseError.Get(); ok {
+		_, err = d.db.FailAsyncCall(ctx, callError, call.ID)
+	} else {
+		_, err = d.db.SucceedAsyncCall(ctx, response, call.ID)
+	}
+	if err != nil {
+		return translatePGError(err)
+	}
+	return nil
+}
+
+// RetryAsyncCall records a failed attempt of an async call and reschedules it
+// to be executed again once [backoff] has elapsed.
+func (d *DAL) RetryAsyncCall(ctx context.Context, call *AsyncCall, responseError string, backoff time.Duration) error {
+	_, err := d.db.RetryAsyncCall(ctx, responseError, backoff, call.ID)
 	if err != nil {
 		return translatePGError(err)
 	}
@@ -76,10 +116,130 @@ func (d *DAL) LoadAsyncCall(ctx context.Context, id int64) (*AsyncCall, error) {
 		return nil, translatePGError(err)
 	}
 	return &AsyncCall{
-		ID:        row.ID,
-		Verb:      row.Verb,
-		Origin:    AsyncCallOrigin(row.Origin),
-		OriginKey: row.OriginKey,
-		Request:   row.Request,
+		ID:           row.ID,
+		Verb:         row.Verb,
+		Origin:       AsyncCallOrigin(row.Origin),
+		OriginKey:    row.OriginKey,
+		Request:      row.Request,
+		RetryAttempt: int(row.RetryAttempt),
 	}, nil
 }
+
+// AsyncCallRecord is the persisted state of an async call, as presented to
+// operators.
+type AsyncCallRecord struct {
+	ID           int64
+	CreatedAt    time.Time
+	State        AsyncCallState
+	Origin       AsyncCallOrigin
+	OriginKey    string
+	Verb         schema.Ref
+	Request      json.RawMessage
+	Response     optional.Option[json.RawMessage]
+	Error        optional.Option[string]
+	ScheduledAt  time.Time
+	RetryAttempt int
+}
+
+// GetAsyncCallRecord returns the persisted state of an async call.
+//
+// Returns ErrNotFound if the call does not exist.
+func (d *DAL) GetAsyncCallRecord(ctx context.Context, id int64) (AsyncCallRecord, error) {
+	row, err := d.db.LoadAsyncCall(ctx, id)
+	if err != nil {
+		return AsyncCallRecord{}, translatePGError(err)
+	}
+	return asyncCallRecordFromRow(row), nil
+}
+
+// GetFailedAsyncCalls returns async calls that have failed and exhausted their
+// retries, optionally filtered by origin.
+func (d *DAL) GetFailedAsyncCalls(ctx context.Context, origin optional.Option[AsyncCallOrigin]) ([]AsyncCallRecord, error) {
+	var originFilter sql.NullAsyncCallOrigin
+	if origin, ok := origin.Get(); ok {
+		originFilter = sql.NullAsyncCallOrigin{AsyncCallOrigin: sql.AsyncCallOrigin(origin), Valid: true}
+	}
+	rows, err := d.db.GetFailedAsyncCalls(ctx, originFilter)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	return slices.Map(rows, asyncCallRecordFromRow), nil
+}
+
+// RequeueFailedAsyncCall schedules a failed async call for immediate
+// execution, resetting its retry attempts.
+//
+// If the call is an FSM transition the failed FSM instance is resumed. If it
+// is a pub/sub delivery the subscription blocked on it is re-armed.
+//
+// Returns ErrNotFound if there is no failed call with the given ID.
+func (d *DAL) RequeueFailedAsyncCall(ctx context.Context, id int64) (err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	origin, err := tx.RequeueFailedAsyncCall(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to requeue async call %d: %w", id, translatePGError(err))
+	}
+	switch AsyncCallOrigin(origin) {
+	case AsyncCallOriginFSM:
+		if err = tx.ResumeFSMExecutionForAsyncCall(ctx, id); err != nil {
+			return fmt.Errorf("failed to resume FSM instance for async call %d: %w", id, translatePGError(err))
+		}
+	case AsyncCallOriginPubSub:
+		if err = tx.ResumeSubscriptionForAsyncCall(ctx, id); err != nil {
+			return fmt.Errorf("failed to resume subscription for async call %d: %w", id, translatePGError(err))
+		}
+	case AsyncCallOriginCron:
+	}
+	return nil
+}
+
+// DiscardFailedAsyncCall marks a failed async call as discarded so that it no
+// longer shows up as failed.
+//
+// If the call is a pub/sub delivery the subscription blocked on it skips the
+// event and moves on to the next one.
+//
+// Returns ErrNotFound if there is no failed call with the given ID.
+func (d *DAL) DiscardFailedAsyncCall(ctx context.Context, id int64) (err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	origin, err := tx.DiscardFailedAsyncCall(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to discard async call %d: %w", id, translatePGError(err))
+	}
+	if AsyncCallOrigin(origin) == AsyncCallOriginPubSub {
+		if err = tx.DiscardEventForAsyncCall(ctx, id); err != nil {
+			return fmt.Errorf("failed to skip event for async call %d: %w", id, translatePGError(err))
+		}
+	}
+	return nil
+}
+
+func asyncCallRecordFromRow(row sql.AsyncCall) AsyncCallRecord {
+	var response optional.Option[json.RawMessage]
+	if row.Response != nil {
+		response = optional.Some(json.RawMessage(row.Response))
+	}
+	return AsyncCallRecord{
+		ID:           row.ID,
+		CreatedAt:    row.CreatedAt,
+		State:        AsyncCallState(row.State),
+		Origin:       AsyncCallOrigin(row.Origin),
+		OriginKey:    row.OriginKey,
+		Verb:         row.Verb,
+		Request:      row.Request,
+		Response:     response,
+		Error:        row.Error,
+		ScheduledAt:  row.ScheduledAt,
+		RetryAttempt: int(row.RetryAttempt),
+	}
+}
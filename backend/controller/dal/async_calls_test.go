@@ -3,6 +3,7 @@ package dal
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/alecthomas/assert/v2"
 	"github.com/alecthomas/types/optional"
@@ -22,9 +23,13 @@ func TestSendFSMEvent(t *testing.T) {
 	assert.IsError(t, err, ErrNotFound)
 
 	ref := schema.Ref{Module: "module", Name: "verb"}
-	err = dal.SendFSMEvent(ctx, "test", "invoiceID", "state", ref, []byte(`{}`))
+	fsm := schema.RefKey{Module: "module", Name: "test"}
+	err = dal.StartFSMTransition(ctx, fsm, "invoiceID", ref.ToRefKey(), []byte(`{}`))
 	assert.NoError(t, err)
 
+	err = dal.StartFSMTransition(ctx, fsm, "invoiceID", ref.ToRefKey(), []byte(`{}`))
+	assert.IsError(t, err, ErrConflict)
+
 	call, err := dal.AcquireAsyncCall(ctx)
 	assert.NoError(t, err)
 	t.Cleanup(func() {
@@ -45,10 +50,201 @@ func TestSendFSMEvent(t *testing.T) {
 	err = dal.CompleteAsyncCall(ctx, call, nil, optional.None[string]())
 	assert.EqualError(t, err, "must provide exactly one of response or error")
 
-	err = dal.CompleteAsyncCall(ctx, call, []byte(`{}`), optional.None[string]())
+	instance, err := dal.GetFSMInstanceForAsyncCall(ctx, call)
+	assert.NoError(t, err)
+	assert.Equal(t, &FSMInstance{
+		FSM:              fsm,
+		Key:              "invoiceID",
+		Status:           FSMStatusRunning,
+		DestinationState: optional.Some(ref.ToRefKey()),
+	}, instance)
+
+	err = dal.FinishFSMTransition(ctx, instance, call, []byte(`{}`), false)
 	assert.NoError(t, err)
 
 	actual, err := dal.LoadAsyncCall(ctx, call.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, call, actual, assert.Exclude[*Lease]())
+	record, err := dal.GetAsyncCallRecord(ctx, call.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, AsyncCallStateSuccess, record.State)
+
+	instance, err = dal.GetFSMInstance(ctx, fsm, "invoiceID")
+	assert.NoError(t, err)
+	assert.Equal(t, &FSMInstance{
+		FSM:          fsm,
+		Key:          "invoiceID",
+		Status:       FSMStatusRunning,
+		CurrentState: optional.Some(ref.ToRefKey()),
+	}, instance)
+}
+
+func TestFinishFSMTransitionToTerminalState(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	ref := schema.Ref{Module: "module", Name: "verb"}
+	fsm := schema.RefKey{Module: "module", Name: "test"}
+	err = dal.StartFSMTransition(ctx, fsm, "invoiceID", ref.ToRefKey(), []byte(`{}`))
+	assert.NoError(t, err)
+
+	call, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	instance, err := dal.GetFSMInstanceForAsyncCall(ctx, call)
+	assert.NoError(t, err)
+	err = dal.FinishFSMTransition(ctx, instance, call, []byte(`{}`), true)
+	assert.NoError(t, err)
+	assert.NoError(t, call.Lease.Release())
+
+	instance, err = dal.GetFSMInstance(ctx, fsm, "invoiceID")
+	assert.NoError(t, err)
+	assert.Equal(t, &FSMInstance{
+		FSM:          fsm,
+		Key:          "invoiceID",
+		Status:       FSMStatusCompleted,
+		CurrentState: optional.Some(ref.ToRefKey()),
+	}, instance)
+
+	// A completed instance can not transition again.
+	err = dal.StartFSMTransition(ctx, fsm, "invoiceID", ref.ToRefKey(), []byte(`{}`))
+	assert.IsError(t, err, ErrConflict)
+}
+
+func TestFailFSMTransition(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	ref := schema.Ref{Module: "module", Name: "verb"}
+	fsm := schema.RefKey{Module: "module", Name: "test"}
+	err = dal.StartFSMTransition(ctx, fsm, "invoiceID", ref.ToRefKey(), []byte(`{}`))
+	assert.NoError(t, err)
+
+	call, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	instance, err := dal.GetFSMInstanceForAsyncCall(ctx, call)
+	assert.NoError(t, err)
+	err = dal.FailFSMTransition(ctx, instance, call, "failed")
+	assert.NoError(t, err)
+	assert.NoError(t, call.Lease.Release())
+
+	record, err := dal.GetAsyncCallRecord(ctx, call.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, AsyncCallStateError, record.State)
+	instance, err = dal.GetFSMInstance(ctx, fsm, "invoiceID")
+	assert.NoError(t, err)
+	assert.Equal(t, &FSMInstance{
+		FSM:    fsm,
+		Key:    "invoiceID",
+		Status: FSMStatusFailed,
+	}, instance)
+
+	// Requeuing the call resumes the transition.
+	err = dal.RequeueFailedAsyncCall(ctx, call.ID)
+	assert.NoError(t, err)
+	instance, err = dal.GetFSMInstanceForAsyncCall(ctx, call)
+	assert.NoError(t, err)
+	assert.Equal(t, &FSMInstance{
+		FSM:              fsm,
+		Key:              "invoiceID",
+		Status:           FSMStatusRunning,
+		DestinationState: optional.Some(ref.ToRefKey()),
+	}, instance)
+}
+
+func TestRetryAsyncCall(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	ref := schema.Ref{Module: "module", Name: "verb"}
+	fsm := schema.RefKey{Module: "module", Name: "test"}
+	err = dal.StartFSMTransition(ctx, fsm, "invoiceID", ref.ToRefKey(), []byte(`{}`))
+	assert.NoError(t, err)
+
+	call, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, call.RetryAttempt)
+
+	err = dal.RetryAsyncCall(ctx, call, "failed", time.Hour)
+	assert.NoError(t, err)
+	assert.NoError(t, call.Lease.Release())
+
+	// The call is not eligible for execution until the backoff has elapsed.
+	_, err = dal.AcquireAsyncCall(ctx)
+	assert.IsError(t, err, ErrNotFound)
+
+	err = dal.RetryAsyncCall(ctx, call, "failed", 0)
+	assert.NoError(t, err)
+
+	call, err = dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, call.RetryAttempt)
+
+	err = dal.CompleteAsyncCall(ctx, call, nil, optional.Some("failed again"))
+	assert.NoError(t, err)
+	assert.NoError(t, call.Lease.Release())
+
+	_, err = dal.AcquireAsyncCall(ctx)
+	assert.IsError(t, err, ErrNotFound)
+}
+
+func TestFailedAsyncCalls(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	ref := schema.Ref{Module: "module", Name: "verb"}
+	fsm := schema.RefKey{Module: "module", Name: "test"}
+	err = dal.StartFSMTransition(ctx, fsm, "invoiceID", ref.ToRefKey(), []byte(`{}`))
+	assert.NoError(t, err)
+
+	call, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	instance, err := dal.GetFSMInstanceForAsyncCall(ctx, call)
+	assert.NoError(t, err)
+	err = dal.FailFSMTransition(ctx, instance, call, "failed")
+	assert.NoError(t, err)
+	assert.NoError(t, call.Lease.Release())
+
+	failed, err := dal.GetFailedAsyncCalls(ctx, optional.None[AsyncCallOrigin]())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(failed))
+	assert.Equal(t, AsyncCallStateError, failed[0].State)
+	assert.Equal(t, optional.Some("failed"), failed[0].Error)
+
+	failed, err = dal.GetFailedAsyncCalls(ctx, optional.Some(AsyncCallOriginPubSub))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(failed))
+
+	// Requeuing resumes the FSM instance.
+	err = dal.RequeueFailedAsyncCall(ctx, call.ID)
+	assert.NoError(t, err)
+	instance, err = dal.GetFSMInstance(ctx, fsm, "invoiceID")
+	assert.NoError(t, err)
+	assert.Equal(t, FSMStatusRunning, instance.Status)
+
+	err = dal.RequeueFailedAsyncCall(ctx, call.ID)
+	assert.IsError(t, err, ErrNotFound)
+
+	call, err = dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	err = dal.CompleteAsyncCall(ctx, call, nil, optional.Some("failed again"))
+	assert.NoError(t, err)
+	assert.NoError(t, call.Lease.Release())
+
+	err = dal.DiscardFailedAsyncCall(ctx, call.ID)
+	assert.NoError(t, err)
+	record, err := dal.GetAsyncCallRecord(ctx, call.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, AsyncCallStateDiscarded, record.State)
+
+	failed, err = dal.GetFailedAsyncCalls(ctx, optional.None[AsyncCallOrigin]())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(failed))
 }
@@ -0,0 +1,164 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// StartCanaryDeployment activates a deployment as a canary alongside the
+// module's existing deployment, routing [weight] percent of the module's
+// traffic to it.
+//
+// Returns ErrNotFound if the module has no existing deployment to canary
+// against, and ErrConflict if the module already has a canary or the
+// deployment is already the module's primary deployment.
+func (d *DAL) StartCanaryDeployment(ctx context.Context, key model.DeploymentKey, minReplicas int, weight int) (err error) {
+	if weight < 1 || weight > 99 {
+		return fmt.Errorf("canary weight must be between 1 and 99, not %d: %w", weight, ErrConstraint)
+	}
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	deployment, err := tx.GetDeployment(ctx, key)
+	if err != nil {
+		return translatePGError(err)
+	}
+	primary, err := tx.GetExistingDeploymentForModule(ctx, deployment.ModuleName)
+	if isNotFound(err) {
+		return fmt.Errorf("module %q has no existing deployment to canary against: %w", deployment.ModuleName, ErrNotFound)
+	} else if err != nil {
+		return translatePGError(err)
+	}
+	if primary.Key.String() == key.String() {
+		return fmt.Errorf("deployment %s is already active: %w", key, ErrConflict)
+	}
+
+	err = tx.StartCanaryDeployment(ctx, int32(minReplicas), int32(weight), key)
+	if err != nil {
+		return translatePGError(err)
+	}
+
+	err = tx.InsertDeploymentCreatedEvent(ctx, sql.InsertDeploymentCreatedEventParams{
+		DeploymentKey: key,
+		Language:      deployment.Language,
+		ModuleName:    deployment.ModuleName,
+		MinReplicas:   int32(minReplicas),
+	})
+	if err != nil {
+		return translatePGError(err)
+	}
+	return nil
+}
+
+// PromoteCanaryDeployment replaces the module's primary deployment with the
+// given canary deployment, routing all of the module's traffic to it.
+//
+// Returns ErrConflict if the deployment is not a canary.
+func (d *DAL) PromoteCanaryDeployment(ctx context.Context, key model.DeploymentKey) (err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	canary, err := tx.GetDeployment(ctx, key)
+	if err != nil {
+		return translatePGError(err)
+	}
+	if !canary.Deployment.CanaryWeight.Ok() || canary.Deployment.MinReplicas == 0 {
+		return fmt.Errorf("deployment %s is not an active canary: %w", key, ErrConflict)
+	}
+
+	var replacedDeploymentKey optional.Option[model.DeploymentKey]
+	primary, err := tx.GetExistingDeploymentForModule(ctx, canary.ModuleName)
+	if err == nil {
+		_, err = tx.ReplaceDeployment(ctx, primary.Key, key, canary.Deployment.MinReplicas)
+		if err != nil {
+			return translatePGError(err)
+		}
+		replacedDeploymentKey = optional.Some(primary.Key)
+		err = tx.InsertDeploymentUpdatedEvent(ctx, sql.InsertDeploymentUpdatedEventParams{
+			DeploymentKey:   primary.Key,
+			Language:        canary.Language,
+			ModuleName:      canary.ModuleName,
+			MinReplicas:     0,
+			PrevMinReplicas: primary.MinReplicas,
+		})
+		if err != nil {
+			return translatePGError(err)
+		}
+	} else if !isNotFound(err) {
+		return translatePGError(err)
+	}
+
+	err = tx.SetDeploymentCanaryWeight(ctx, optional.None[int32](), key)
+	if err != nil {
+		return translatePGError(err)
+	}
+
+	err = tx.InsertDeploymentCreatedEvent(ctx, sql.InsertDeploymentCreatedEventParams{
+		DeploymentKey: key,
+		Language:      canary.Language,
+		ModuleName:    canary.ModuleName,
+		MinReplicas:   canary.Deployment.MinReplicas,
+		Replaced:      replacedDeploymentKey,
+	})
+	if err != nil {
+		return translatePGError(err)
+	}
+	return nil
+}
+
+// AbortCanaryDeployment deactivates a canary deployment, routing all of the
+// module's traffic back to its primary deployment.
+//
+// Returns ErrConflict if the deployment is not a canary.
+func (d *DAL) AbortCanaryDeployment(ctx context.Context, key model.DeploymentKey) (err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	canary, err := tx.GetDeployment(ctx, key)
+	if err != nil {
+		return translatePGError(err)
+	}
+	if !canary.Deployment.CanaryWeight.Ok() || canary.Deployment.MinReplicas == 0 {
+		return fmt.Errorf("deployment %s is not an active canary: %w", key, ErrConflict)
+	}
+
+	// The canary weight is retained so that the deployment is still
+	// recognisable as a canary.
+	err = tx.SetDeploymentDesiredReplicas(ctx, key, 0)
+	if err != nil {
+		return translatePGError(err)
+	}
+
+	err = tx.InsertDeploymentUpdatedEvent(ctx, sql.InsertDeploymentUpdatedEventParams{
+		DeploymentKey:   key,
+		Language:        canary.Language,
+		ModuleName:      canary.ModuleName,
+		MinReplicas:     0,
+		PrevMinReplicas: canary.Deployment.MinReplicas,
+	})
+	if err != nil {
+		return translatePGError(err)
+	}
+	return nil
+}
+
+func canaryWeight(weight optional.Option[int32]) optional.Option[int] {
+	if weight, ok := weight.Get(); ok {
+		return optional.Some(int(weight))
+	}
+	return optional.None[int]()
+}
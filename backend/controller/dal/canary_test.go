@@ -0,0 +1,70 @@
+package dal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func TestCanaryDeployment(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	primary, err := dal.CreateDeployment(ctx, "go", &schema.Module{Name: "test"}, nil, nil, nil)
+	assert.NoError(t, err)
+	canary, err := dal.CreateDeployment(ctx, "go", &schema.Module{Name: "test", Comments: []string{"canary"}}, nil, nil, nil)
+	assert.NoError(t, err)
+
+	err = dal.StartCanaryDeployment(ctx, canary, 1, 10)
+	assert.IsError(t, err, ErrNotFound, "canary requires an existing deployment")
+
+	err = dal.ReplaceDeployment(ctx, primary, 1)
+	assert.NoError(t, err)
+
+	err = dal.StartCanaryDeployment(ctx, canary, 1, 100)
+	assert.IsError(t, err, ErrConstraint)
+	err = dal.StartCanaryDeployment(ctx, primary, 1, 10)
+	assert.IsError(t, err, ErrConflict)
+
+	err = dal.StartCanaryDeployment(ctx, canary, 1, 10)
+	assert.NoError(t, err)
+
+	deployments, err := dal.GetActiveDeployments(ctx)
+	assert.NoError(t, err)
+	weights := map[string]optional.Option[int]{}
+	for _, d := range deployments {
+		weights[d.Key.String()] = d.CanaryWeight
+	}
+	assert.Equal(t, map[string]optional.Option[int]{
+		primary.String(): optional.None[int](),
+		canary.String():  optional.Some(10),
+	}, weights)
+
+	schemas, err := dal.GetActiveDeploymentSchemas(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(schemas), "canary must not be part of the active schema")
+
+	err = dal.PromoteCanaryDeployment(ctx, primary)
+	assert.IsError(t, err, ErrConflict)
+
+	err = dal.PromoteCanaryDeployment(ctx, canary)
+	assert.NoError(t, err)
+
+	deployments, err = dal.GetActiveDeployments(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.DeploymentKey{canary}, slices.Map(deployments, func(d Deployment) model.DeploymentKey { return d.Key }))
+	assert.Equal(t, optional.None[int](), deployments[0].CanaryWeight)
+
+	err = dal.AbortCanaryDeployment(ctx, canary)
+	assert.IsError(t, err, ErrConflict)
+}
@@ -0,0 +1,83 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// IngressConnectionMessage is a message queued for an ingress connection held
+// by another controller.
+type IngressConnectionMessage struct {
+	Body   []byte
+	Binary bool
+}
+
+// CreateIngressConnection records that a controller holds an ingress
+// connection to a verb.
+func (d *DAL) CreateIngressConnection(ctx context.Context, key model.ConnectionKey, controller model.ControllerKey, module, verb string) error {
+	err := d.db.CreateIngressConnection(ctx, sql.CreateIngressConnectionParams{
+		Key:        key,
+		Controller: controller,
+		Module:     module,
+		Verb:       verb,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create ingress connection: %w", translatePGError(err))
+	}
+	return nil
+}
+
+// DeleteIngressConnection removes a closed ingress connection along with any
+// messages queued for it.
+func (d *DAL) DeleteIngressConnection(ctx context.Context, key model.ConnectionKey) error {
+	err := d.db.DeleteIngressConnection(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to delete ingress connection: %w", translatePGError(err))
+	}
+	return nil
+}
+
+// DeleteStaleIngressConnections removes the ingress connections of dead
+// controllers.
+func (d *DAL) DeleteStaleIngressConnections(ctx context.Context) (int64, error) {
+	count, err := d.db.DeleteStaleIngressConnections(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale ingress connections: %w", translatePGError(err))
+	}
+	return count, nil
+}
+
+// QueueIngressConnectionMessage queues a message for the controller holding an
+// ingress connection, which is notified via [DAL.ConnectionMessages].
+//
+// Returns ErrNotFound if the connection does not exist or its controller is
+// dead.
+func (d *DAL) QueueIngressConnectionMessage(ctx context.Context, key model.ConnectionKey, msg IngressConnectionMessage) error {
+	count, err := d.db.QueueIngressConnectionMessage(ctx, key, msg.Body, msg.Binary)
+	if err != nil {
+		return fmt.Errorf("failed to queue ingress connection message: %w", translatePGError(err))
+	}
+	if count == 0 {
+		return fmt.Errorf("ingress connection %s: %w", key, ErrNotFound)
+	}
+	return nil
+}
+
+// DequeueIngressConnectionMessages removes and returns the messages queued for
+// an ingress connection, in the order they were queued.
+func (d *DAL) DequeueIngressConnectionMessages(ctx context.Context, key model.ConnectionKey) ([]IngressConnectionMessage, error) {
+	rows, err := d.db.DequeueIngressConnectionMessages(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dequeue ingress connection messages: %w", translatePGError(err))
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+	out := make([]IngressConnectionMessage, len(rows))
+	for i, row := range rows {
+		out[i] = IngressConnectionMessage{Body: row.Body, Binary: row.IsBinary}
+	}
+	return out, nil
+}
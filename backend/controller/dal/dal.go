@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"strings"
 	"time"
 
@@ -46,8 +45,10 @@ type IngressRoute struct {
 	Deployment model.DeploymentKey
 	Endpoint   string
 	Path       string
-	Module     string
-	Verb       string
+	// Host the route is restricted to, or empty for any host.
+	Host   string
+	Module string
+	Verb   string
 }
 
 type IngressRouteEntry struct {
@@ -56,6 +57,7 @@ type IngressRouteEntry struct {
 	Verb       string
 	Method     string
 	Path       string
+	Host       string
 }
 
 type DeploymentArtefact struct {
@@ -161,6 +163,7 @@ const (
 	RequestOriginIngress = RequestOrigin(sql.OriginIngress)
 	RequestOriginCron    = RequestOrigin(sql.OriginCron)
 	RequestOriginPubsub  = RequestOrigin(sql.OriginPubsub)
+	RequestOriginFSM     = RequestOrigin(sql.OriginFsm)
 )
 
 type Deployment struct {
@@ -172,6 +175,9 @@ type Deployment struct {
 	Schema      *schema.Module
 	CreatedAt   time.Time
 	Labels      model.Labels
+	// CanaryWeight is the percentage of the module's traffic routed to this
+	// deployment if it is a canary.
+	CanaryWeight optional.Option[int]
 }
 
 func (d Deployment) String() string { return d.Key.String() }
@@ -204,6 +210,8 @@ type Route struct {
 	Runner     model.RunnerKey
 	Deployment model.DeploymentKey
 	Endpoint   string
+	// CanaryWeight is set if the route is to a canary deployment.
+	CanaryWeight optional.Option[int]
 }
 
 func (r Route) String() string {
@@ -222,24 +230,34 @@ func WithReservation(ctx context.Context, reservation Reservation, fn func() err
 	return reservation.Commit(ctx)
 }
 
-func New(ctx context.Context, pool *pgxpool.Pool) (*DAL, error) {
+func New(ctx context.Context, pool *pgxpool.Pool, options ...Option) (*DAL, error) {
 	conn, err := pool.Acquire(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire PG PubSub connection: %w", err)
 	}
+	db := sql.NewDB(pool)
 	dal := &DAL{
-		db:                sql.NewDB(pool),
-		DeploymentChanges: pubsub.New[DeploymentNotification](),
+		db:                 db,
+		artefacts:          &postgresArtefactStore{db: db},
+		DeploymentChanges:  pubsub.New[DeploymentNotification](),
+		ConnectionMessages: pubsub.New[model.ConnectionKey](),
+	}
+	for _, option := range options {
+		option(dal)
 	}
 	go dal.runListener(ctx, conn.Hijack())
 	return dal, nil
 }
 
 type DAL struct {
-	db sql.DBI
+	db        sql.DBI
+	artefacts ArtefactStore
 
 	// DeploymentChanges is a Topic that receives changes to the deployments table.
 	DeploymentChanges *pubsub.Topic[DeploymentNotification]
+	// ConnectionMessages is a Topic that receives the keys of ingress
+	// connections that have new messages queued.
+	ConnectionMessages *pubsub.Topic[model.ConnectionKey]
 	// RouteChanges is a Topic that receives changes to the routing table.
 }
 
@@ -291,8 +309,10 @@ func (d *DAL) Begin(ctx context.Context) (*Tx, error) {
 		return nil, translatePGError(err)
 	}
 	return &Tx{&DAL{
-		db:                stx,
-		DeploymentChanges: d.DeploymentChanges,
+		db:                 stx,
+		artefacts:          d.artefacts,
+		DeploymentChanges:  d.DeploymentChanges,
+		ConnectionMessages: d.ConnectionMessages,
 	}}, nil
 }
 
@@ -337,12 +357,13 @@ func (d *DAL) GetStatus(ctx context.Context) (Status, error) {
 			return Deployment{}, fmt.Errorf("%q: invalid labels in database: %w", in.ModuleName, err)
 		}
 		return Deployment{
-			Key:         in.Deployment.Key,
-			Module:      in.ModuleName,
-			Language:    in.Language,
-			MinReplicas: int(in.Deployment.MinReplicas),
-			Schema:      in.Deployment.Schema,
-			Labels:      labels,
+			Key:          in.Deployment.Key,
+			Module:       in.ModuleName,
+			Language:     in.Language,
+			MinReplicas:  int(in.Deployment.MinReplicas),
+			Schema:       in.Deployment.Schema,
+			Labels:       labels,
+			CanaryWeight: canaryWeight(in.Deployment.CanaryWeight),
 		}, nil
 	})
 	if err != nil {
@@ -384,6 +405,7 @@ func (d *DAL) GetStatus(ctx context.Context) (Status, error) {
 				Verb:       in.Verb,
 				Method:     in.Method,
 				Path:       in.Path,
+				Host:       in.Host,
 			}
 		}),
 		Routes: slices.Map(routes, func(row sql.GetRoutingTableRow) Route {
@@ -426,28 +448,26 @@ func (d *DAL) UpsertModule(ctx context.Context, language, name string) (err erro
 }
 
 // GetMissingArtefacts returns the digests of the artefacts that are missing from the database.
+//
+// Artefacts that are present have their garbage collection grace period
+// restarted, so that they are not collected before a deployment created from
+// this diff references them.
 func (d *DAL) GetMissingArtefacts(ctx context.Context, digests []sha256.SHA256) ([]sha256.SHA256, error) {
-	have, err := d.db.GetArtefactDigests(ctx, sha256esToBytes(digests))
+	have, err := d.db.TouchArtefacts(ctx, sha256esToBytes(digests))
 	if err != nil {
 		return nil, translatePGError(err)
 	}
-	haveStr := slices.Map(have, func(in sql.GetArtefactDigestsRow) sha256.SHA256 {
+	haveStr := slices.Map(have, func(in sql.TouchArtefactsRow) sha256.SHA256 {
 		return sha256.FromBytes(in.Digest)
 	})
 	return sets.NewSet(digests...).Difference(sets.NewSet(haveStr...)).ToSlice(), nil
 }
 
-// CreateArtefact inserts a new artefact into the database and returns its ID.
-func (d *DAL) CreateArtefact(ctx context.Context, content []byte) (digest sha256.SHA256, err error) {
-	sha256digest := sha256.Sum(content)
-	_, err = d.db.CreateArtefact(ctx, sha256digest[:], content)
-	return sha256digest, translatePGError(err)
-}
-
 type IngressRoutingEntry struct {
 	Verb   string
 	Method string
 	Path   string
+	Host   string
 }
 
 // CreateDeployment (possibly) creates a new deployment and associates
@@ -496,8 +516,11 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 		return model.DeploymentKey{}, fmt.Errorf("failed to create deployment: %w", translatePGError(err))
 	}
 
+	// Touching the artefacts locks them against garbage collection, which
+	// either deleted them first, in which case they are reported missing, or
+	// waits and then finds their grace period restarted.
 	uploadedDigests := slices.Map(artefacts, func(in DeploymentArtefact) []byte { return in.Digest[:] })
-	artefactDigests, err := tx.GetArtefactDigests(ctx, uploadedDigests)
+	artefactDigests, err := tx.TouchArtefacts(ctx, uploadedDigests)
 	if err != nil {
 		return model.DeploymentKey{}, fmt.Errorf("failed to get artefact digests: %w", err)
 	}
@@ -525,6 +548,7 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 			Key:    deploymentKey,
 			Method: ingressRoute.Method,
 			Path:   ingressRoute.Path,
+			Host:   ingressRoute.Host,
 			Module: moduleSchema.Name,
 			Verb:   ingressRoute.Verb,
 		})
@@ -544,12 +568,69 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 			StartTime:     job.StartTime,
 			Schedule:      job.Schedule,
 			NextExecution: job.NextExecution,
+			Concurrency:   job.Concurrency,
+			Jitter:        job.Jitter,
+			CatchupLimit:  int32(job.CatchupLimit),
 		})
 		if err != nil {
 			return model.DeploymentKey{}, fmt.Errorf("failed to create cron job: %w", translatePGError(err))
 		}
 	}
 
+	for _, decl := range moduleSchema.Decls {
+		topic, ok := decl.(*schema.Topic)
+		if !ok {
+			continue
+		}
+		err = tx.UpsertTopic(ctx, sql.UpsertTopicParams{
+			Topic:     model.NewTopicKey(moduleSchema.Name, topic.Name),
+			Module:    moduleSchema.Name,
+			Name:      topic.Name,
+			EventType: topic.Event.String(),
+		})
+		if err != nil {
+			return model.DeploymentKey{}, fmt.Errorf("failed to upsert topic: %w", translatePGError(err))
+		}
+	}
+
+	for _, decl := range moduleSchema.Decls {
+		subscription, ok := decl.(*schema.Subscription)
+		if !ok {
+			continue
+		}
+		err = tx.UpsertSubscription(ctx, sql.UpsertSubscriptionParams{
+			Key:         model.NewSubscriptionKey(moduleSchema.Name, subscription.Name),
+			TopicModule: subscription.Topic.Module,
+			TopicName:   subscription.Topic.Name,
+			Module:      moduleSchema.Name,
+			Name:        subscription.Name,
+		})
+		if err != nil {
+			return model.DeploymentKey{}, fmt.Errorf("failed to upsert subscription: %w", translatePGError(err))
+		}
+	}
+
+	for _, decl := range moduleSchema.Decls {
+		verb, ok := decl.(*schema.Verb)
+		if !ok {
+			continue
+		}
+		subscriber, ok := verb.GetMetadataSubscriber().Get()
+		if !ok {
+			continue
+		}
+		err = tx.InsertSubscriber(ctx, sql.InsertSubscriberParams{
+			Key:              model.NewSubscriberKey(moduleSchema.Name, subscriber.Name, verb.Name),
+			Module:           moduleSchema.Name,
+			SubscriptionName: subscriber.Name,
+			Deployment:       deploymentKey,
+			Verb:             verb.Name,
+		})
+		if err != nil {
+			return model.DeploymentKey{}, fmt.Errorf("failed to insert subscriber: %w", translatePGError(err))
+		}
+	}
+
 	return deploymentKey, nil
 }
 
@@ -733,6 +814,16 @@ func (d *DAL) ReplaceDeployment(ctx context.Context, newDeploymentKey model.Depl
 			return fmt.Errorf("deployment already exists: %w", ErrConflict)
 		}
 		replacedDeploymentKey = optional.Some(oldDeployment.Key)
+		err = tx.InsertDeploymentUpdatedEvent(ctx, sql.InsertDeploymentUpdatedEventParams{
+			DeploymentKey:   oldDeployment.Key,
+			Language:        newDeployment.Language,
+			ModuleName:      newDeployment.ModuleName,
+			MinReplicas:     0,
+			PrevMinReplicas: oldDeployment.MinReplicas,
+		})
+		if err != nil {
+			return translatePGError(err)
+		}
 	} else if !isNotFound(err) {
 		return translatePGError(err)
 	} else {
@@ -789,13 +880,14 @@ func (d *DAL) GetActiveDeployments(ctx context.Context) ([]Deployment, error) {
 	}
 	return slices.MapErr(rows, func(in sql.GetActiveDeploymentsRow) (Deployment, error) {
 		return Deployment{
-			Key:         in.Deployment.Key,
-			Module:      in.ModuleName,
-			Language:    in.Language,
-			MinReplicas: int(in.Deployment.MinReplicas),
-			Replicas:    optional.Some(int(in.Replicas)),
-			Schema:      in.Deployment.Schema,
-			CreatedAt:   in.Deployment.CreatedAt,
+			Key:          in.Deployment.Key,
+			Module:       in.ModuleName,
+			Language:     in.Language,
+			MinReplicas:  int(in.Deployment.MinReplicas),
+			Replicas:     optional.Some(int(in.Replicas)),
+			Schema:       in.Deployment.Schema,
+			CreatedAt:    in.Deployment.CreatedAt,
+			CanaryWeight: canaryWeight(in.Deployment.CanaryWeight),
 		}, nil
 	})
 }
@@ -810,12 +902,78 @@ func (d *DAL) GetDeploymentsWithMinReplicas(ctx context.Context) ([]Deployment,
 	}
 	return slices.MapErr(rows, func(in sql.GetDeploymentsWithMinReplicasRow) (Deployment, error) {
 		return Deployment{
-			Key:         in.Deployment.Key,
-			Module:      in.ModuleName,
-			Language:    in.Language,
-			MinReplicas: int(in.Deployment.MinReplicas),
-			Schema:      in.Deployment.Schema,
-			CreatedAt:   in.Deployment.CreatedAt,
+			Key:          in.Deployment.Key,
+			Module:       in.ModuleName,
+			Language:     in.Language,
+			MinReplicas:  int(in.Deployment.MinReplicas),
+			Schema:       in.Deployment.Schema,
+			CreatedAt:    in.Deployment.CreatedAt,
+			CanaryWeight: canaryWeight(in.Deployment.CanaryWeight),
+		}, nil
+	})
+}
+
+// MinReplicasChange records a change to the minimum number of replicas of a
+// deployment.
+type MinReplicasChange struct {
+	Time        time.Time
+	MinReplicas int
+}
+
+// DeploymentHistory is a deployment of a module, active or not.
+type DeploymentHistory struct {
+	Deployment
+	// SchemaHash is the SHA256 of the deployment's protobuf encoded schema.
+	SchemaHash sha256.SHA256
+	// MinReplicasHistory is the changes to the deployment's minimum number
+	// of replicas, oldest first.
+	MinReplicasHistory []MinReplicasChange
+}
+
+// GetDeploymentHistory returns all deployments of a module, most recent first.
+//
+// The history of each deployment's minimum number of replicas is built from
+// its deployment events, which are exempt from event retention policies so
+// that the history is kept for as long as the deployment.
+//
+// Returns ErrNotFound if the module has no deployments.
+func (d *DAL) GetDeploymentHistory(ctx context.Context, module string) ([]DeploymentHistory, error) {
+	rows, err := d.db.GetDeploymentsForModule(ctx, module)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no deployments for module %q: %w", module, ErrNotFound)
+	}
+	changes, err := d.db.GetDeploymentReplicaHistoryForModule(ctx, module)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	changesByDeployment := map[string][]MinReplicasChange{}
+	for _, change := range changes {
+		key := change.DeploymentKey.String()
+		changesByDeployment[key] = append(changesByDeployment[key], MinReplicasChange{
+			Time:        change.TimeStamp,
+			MinReplicas: int(change.MinReplicas),
+		})
+	}
+	return slices.MapErr(rows, func(in sql.GetDeploymentsForModuleRow) (DeploymentHistory, error) {
+		schemaBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(in.Deployment.Schema.ToProto())
+		if err != nil {
+			return DeploymentHistory{}, fmt.Errorf("%s: failed to marshal schema: %w", in.Deployment.Key, err)
+		}
+		return DeploymentHistory{
+			Deployment: Deployment{
+				Key:          in.Deployment.Key,
+				Module:       in.ModuleName,
+				Language:     in.Language,
+				MinReplicas:  int(in.Deployment.MinReplicas),
+				Schema:       in.Deployment.Schema,
+				CreatedAt:    in.Deployment.CreatedAt,
+				CanaryWeight: canaryWeight(in.Deployment.CanaryWeight),
+			},
+			SchemaHash:         sha256.FromBytes(schemaBytes),
+			MinReplicasHistory: changesByDeployment[in.Deployment.Key.String()],
 		}, nil
 	})
 }
@@ -929,10 +1087,11 @@ func (d *DAL) GetRoutingTable(ctx context.Context, modules []string) (map[string
 		moduleName := route.ModuleName.MustGet()
 		deploymentKey := route.DeploymentKey.MustGet()
 		out[moduleName] = append(out[moduleName], Route{
-			Module:     moduleName,
-			Deployment: deploymentKey,
-			Runner:     route.RunnerKey,
-			Endpoint:   route.Endpoint,
+			Module:       moduleName,
+			Deployment:   deploymentKey,
+			Runner:       route.RunnerKey,
+			Endpoint:     route.Endpoint,
+			CanaryWeight: canaryWeight(route.CanaryWeight),
 		})
 	}
 	return out, nil
@@ -968,6 +1127,10 @@ func cronJobFromRow(row sql.GetCronJobsRow) model.CronJob {
 		StartTime:     row.StartTime,
 		NextExecution: row.NextExecution,
 		State:         row.State,
+		Paused:        row.Paused,
+		Concurrency:   row.Concurrency,
+		Jitter:        row.Jitter,
+		CatchupLimit:  int(row.CatchupLimit),
 	}
 }
 
@@ -1007,6 +1170,10 @@ func (d *DAL) StartCronJobs(ctx context.Context, jobs []model.CronJob) (attempte
 				StartTime:     row.StartTime,
 				NextExecution: row.NextExecution,
 				State:         row.State,
+				Paused:        row.Paused,
+				Concurrency:   row.Concurrency,
+				Jitter:        row.Jitter,
+				CatchupLimit:  int(row.CatchupLimit),
 			},
 			DidStartExecution: row.Updated,
 			HasMinReplicas:    row.HasMinReplicas,
@@ -1026,6 +1193,55 @@ func (d *DAL) EndCronJob(ctx context.Context, job model.CronJob, next time.Time)
 	return cronJobFromRow(sql.GetCronJobsRow(row)), nil
 }
 
+// ScheduleCronJob sets the next execution time of a job that is still
+// executing, allowing the next execution to overlap with the current one.
+//
+// Returns ErrNotFound if the job is no longer executing, or has been started
+// again since.
+func (d *DAL) ScheduleCronJob(ctx context.Context, job model.CronJob, next time.Time) (model.CronJob, error) {
+	row, err := d.db.ScheduleCronJob(ctx, next, job.Key, job.StartTime)
+	if err != nil {
+		return model.CronJob{}, translatePGError(err)
+	}
+	return cronJobFromRow(sql.GetCronJobsRow(row)), nil
+}
+
+// IsCronJobExecuting returns true if the execution of a job that started at
+// job.StartTime is still running, ie. it has neither ended nor been replaced by
+// a later execution.
+func (d *DAL) IsCronJobExecuting(ctx context.Context, job model.CronJob) (bool, error) {
+	executing, err := d.db.IsCronJobExecuting(ctx, job.Key, job.StartTime)
+	if err != nil {
+		return false, translatePGError(err)
+	}
+	return executing, nil
+}
+
+// SetCronJobPaused pauses or resumes a cron job, and sets its next execution
+// time.
+func (d *DAL) SetCronJobPaused(ctx context.Context, key model.CronJobKey, paused bool, next time.Time) error {
+	count, err := d.db.SetCronJobPaused(ctx, paused, next, key)
+	if err != nil {
+		return translatePGError(err)
+	}
+	if count == 0 {
+		return fmt.Errorf("cron job %s: %w", key, ErrNotFound)
+	}
+	return nil
+}
+
+// TriggerCronJob starts executing an idle cron job immediately, whether or not
+// it is due or paused.
+//
+// Returns ErrNotFound if the job does not exist or is already executing.
+func (d *DAL) TriggerCronJob(ctx context.Context, key model.CronJobKey) (model.CronJob, error) {
+	row, err := d.db.TriggerCronJob(ctx, key)
+	if err != nil {
+		return model.CronJob{}, translatePGError(err)
+	}
+	return cronJobFromRow(sql.GetCronJobsRow(row)), nil
+}
+
 // GetStaleCronJobs returns a list of cron jobs that have been executing longer than the duration
 func (d *DAL) GetStaleCronJobs(ctx context.Context, duration time.Duration) ([]model.CronJob, error) {
 	rows, err := d.db.GetStaleCronJobs(ctx, duration)
@@ -1073,7 +1289,7 @@ func (d *DAL) loadDeployment(ctx context.Context, deployment sql.GetDeploymentRo
 		return &model.Artefact{
 			Path:       row.Path,
 			Executable: row.Executable,
-			Content:    &artefactReader{id: row.ID, db: d.db},
+			Content:    &lazyArtefactReader{ctx: ctx, store: d.artefacts, digest: sha256.FromBytes(row.Digest)},
 			Digest:     sha256.FromBytes(row.Digest),
 		}
 	})
@@ -1101,6 +1317,7 @@ func (d *DAL) GetIngressRoutes(ctx context.Context, method string) ([]IngressRou
 			Deployment: row.DeploymentKey,
 			Endpoint:   row.Endpoint,
 			Path:       row.Path,
+			Host:       row.Host,
 			Module:     row.Module,
 			Verb:       row.Verb,
 		}
@@ -1171,28 +1388,6 @@ func sha256esToBytes(digests []sha256.SHA256) [][]byte {
 	return slices.Map(digests, func(digest sha256.SHA256) []byte { return digest[:] })
 }
 
-type artefactReader struct {
-	id     int64
-	db     sql.DBI
-	offset int32
-}
-
-func (r *artefactReader) Close() error { return nil }
-
-func (r *artefactReader) Read(p []byte) (n int, err error) {
-	content, err := r.db.GetArtefactContentRange(context.Background(), r.offset+1, int32(len(p)), r.id)
-	if err != nil {
-		return 0, translatePGError(err)
-	}
-	copy(p, content)
-	clen := len(content)
-	r.offset += int32(clen)
-	if clen == 0 {
-		err = io.EOF
-	}
-	return clen, err
-}
-
 func isNotFound(err error) bool {
 	return errors.Is(err, stdsql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows)
 }
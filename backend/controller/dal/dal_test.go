@@ -378,6 +378,60 @@ func artefactContent(t testing.TB, artefacts []*model.Artefact) [][]byte {
 	return result
 }
 
+func TestGetDeploymentHistory(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	_, err = dal.GetDeploymentHistory(ctx, "test")
+	assert.IsError(t, err, ErrNotFound)
+
+	first, err := dal.CreateDeployment(ctx, "go", &schema.Module{Name: "test"}, nil, nil, nil)
+	assert.NoError(t, err)
+	second, err := dal.CreateDeployment(ctx, "go", &schema.Module{Name: "test", Comments: []string{"second"}}, nil, nil, nil)
+	assert.NoError(t, err)
+
+	err = dal.ReplaceDeployment(ctx, first, 2)
+	assert.NoError(t, err)
+	err = dal.ReplaceDeployment(ctx, second, 1)
+	assert.NoError(t, err)
+
+	history, err := dal.GetDeploymentHistory(ctx, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(history))
+
+	assert.Equal(t, second, history[0].Key)
+	assert.Equal(t, 1, history[0].MinReplicas)
+	assert.Equal(t, []int{1}, minReplicasHistory(history[0]))
+
+	assert.Equal(t, first, history[1].Key)
+	assert.Equal(t, 0, history[1].MinReplicas)
+	assert.Equal(t, []int{2, 0}, minReplicasHistory(history[1]))
+
+	assert.NotEqual(t, history[0].SchemaHash, history[1].SchemaHash)
+
+	// History is built from deployment events, which are never pruned.
+	_, err = dal.PruneEvents(ctx, []EventRetentionPolicy{{Type: EventTypeDeploymentUpdated, MaxAge: time.Nanosecond}}, 100, nil)
+	assert.Error(t, err)
+	_, err = dal.PruneEvents(ctx, []EventRetentionPolicy{
+		{Type: EventTypeLog, MaxAge: time.Nanosecond},
+		{Type: EventTypeCall, MaxAge: time.Nanosecond},
+	}, 100, nil)
+	assert.NoError(t, err)
+	pruned, err := dal.GetDeploymentHistory(ctx, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, history, pruned)
+}
+
+func minReplicasHistory(deployment DeploymentHistory) []int {
+	out := make([]int, len(deployment.MinReplicasHistory))
+	for i, change := range deployment.MinReplicasHistory {
+		out[i] = change.MinReplicas
+	}
+	return out
+}
+
 func TestRunnerStateFromProto(t *testing.T) {
 	state := ftlv1.RunnerState_RUNNER_IDLE
 	assert.Equal(t, RunnerStateIdle, RunnerStateFromProto(state))
@@ -0,0 +1,192 @@
+package dal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// EventRetentionPolicy is the maximum age of events of a given type,
+// optionally for a single module.
+type EventRetentionPolicy struct {
+	Type   EventType
+	Module optional.Option[string]
+	MaxAge time.Duration
+}
+
+func (e EventRetentionPolicy) String() string {
+	if module, ok := e.Module.Get(); ok {
+		return fmt.Sprintf("%s.%s=%s", module, e.Type, e.MaxAge)
+	}
+	return fmt.Sprintf("%s=%s", e.Type, e.MaxAge)
+}
+
+// ParseEventRetentionPolicies parses retention policies keyed by event type,
+// or by "<module>.<type>" for a module specific policy.
+//
+// Only log and call events can expire. Deployment events are retained forever,
+// as the deployment history of each module is built from them.
+func ParseEventRetentionPolicies(policies map[string]time.Duration) ([]EventRetentionPolicy, error) {
+	out := make([]EventRetentionPolicy, 0, len(policies))
+	for key, maxAge := range policies {
+		policy := EventRetentionPolicy{MaxAge: maxAge}
+		eventType := key
+		if module, t, ok := strings.Cut(key, "."); ok {
+			policy.Module = optional.Some(module)
+			eventType = t
+		}
+		switch EventType(eventType) {
+		case EventTypeLog, EventTypeCall:
+			policy.Type = EventType(eventType)
+		case EventTypeDeploymentCreated, EventTypeDeploymentUpdated:
+			return nil, fmt.Errorf("%s: %s events are part of the deployment history and can not expire", key, eventType)
+		default:
+			return nil, fmt.Errorf("%s: unknown event type %q", key, eventType)
+		}
+		if maxAge <= 0 {
+			return nil, fmt.Errorf("%s: retention period must be positive", key)
+		}
+		out = append(out, policy)
+	}
+	return out, nil
+}
+
+// ExpiredEvent is a raw event that has expired under a retention policy, as
+// archived before it is deleted. Archived events can be imported again with
+// [DAL.ImportEvents].
+type ExpiredEvent struct {
+	ID            int64                   `json:"id"`
+	Time          time.Time               `json:"time"`
+	Type          EventType               `json:"type"`
+	DeploymentKey model.DeploymentKey     `json:"deploymentKey"`
+	Module        string                  `json:"module"`
+	RequestKey    optional.Option[string] `json:"requestKey"`
+	CustomKey1    optional.Option[string] `json:"customKey1"`
+	CustomKey2    optional.Option[string] `json:"customKey2"`
+	CustomKey3    optional.Option[string] `json:"customKey3"`
+	CustomKey4    optional.Option[string] `json:"customKey4"`
+	Payload       json.RawMessage         `json:"payload"`
+}
+
+// PruneEvents deletes events that have expired under the given retention
+// policies, in batches of up to [batchSize] events.
+//
+// Module specific policies take precedence over policies for all modules. If
+// [archive] is provided, each batch is passed to it before deletion, and the
+// batch is not deleted if it returns an error. Policies for deployment events
+// are rejected, see [ParseEventRetentionPolicies].
+//
+// Returns the number of events deleted.
+func (d *DAL) PruneEvents(ctx context.Context, policies []EventRetentionPolicy, batchSize int, archive func(ctx context.Context, events []ExpiredEvent) error) (int, error) {
+	now := time.Now()
+	total := 0
+	for _, policy := range policies {
+		if policy.Type != EventTypeLog && policy.Type != EventTypeCall {
+			return total, fmt.Errorf("%s: %s events can not expire", policy, policy.Type)
+		}
+		params := sql.GetExpiredEventsParams{
+			Type:           policy.Type,
+			CreatedBefore:  now.Add(-policy.MaxAge),
+			Module:         policy.Module,
+			ExcludeModules: []string{},
+			MaxEvents:      int32(batchSize),
+		}
+		if !policy.Module.Ok() {
+			for _, other := range policies {
+				if module, ok := other.Module.Get(); ok && other.Type == policy.Type {
+					params.ExcludeModules = append(params.ExcludeModules, module)
+				}
+			}
+		}
+		for {
+			rows, err := d.db.GetExpiredEvents(ctx, params)
+			if err != nil {
+				return total, fmt.Errorf("%s: failed to get expired events: %w", policy, translatePGError(err))
+			}
+			if len(rows) == 0 {
+				break
+			}
+			if archive != nil {
+				events := slices.Map(rows, func(row sql.GetExpiredEventsRow) ExpiredEvent {
+					var requestKey optional.Option[string]
+					if key, ok := row.RequestKey.Get(); ok {
+						requestKey = optional.Some(key.String())
+					}
+					return ExpiredEvent{
+						ID:            row.ID,
+						Time:          row.TimeStamp,
+						Type:          row.Type,
+						DeploymentKey: row.DeploymentKey,
+						Module:        row.ModuleName,
+						RequestKey:    requestKey,
+						CustomKey1:    row.CustomKey1,
+						CustomKey2:    row.CustomKey2,
+						CustomKey3:    row.CustomKey3,
+						CustomKey4:    row.CustomKey4,
+						Payload:       row.Payload,
+					}
+				})
+				if err := archive(ctx, events); err != nil {
+					return total, fmt.Errorf("%s: failed to archive expired events: %w", policy, err)
+				}
+			}
+			count, err := d.db.DeleteEvents(ctx, slices.Map(rows, func(row sql.GetExpiredEventsRow) int64 { return row.ID }))
+			if err != nil {
+				return total, fmt.Errorf("%s: failed to delete expired events: %w", policy, translatePGError(err))
+			}
+			total += int(count)
+			if len(rows) < batchSize {
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+// ImportEvents inserts events archived by [DAL.PruneEvents], so that they can
+// be queried again.
+//
+// Events keep their original IDs and events that already exist are skipped,
+// so an archive can be imported more than once. The deployment of each event
+// must still exist, but an event whose request no longer exists is imported
+// without it. Either all events are imported or none are.
+//
+// Returns the number of events imported.
+func (d *DAL) ImportEvents(ctx context.Context, events []ExpiredEvent) (imported int, err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return 0, translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	for _, event := range events {
+		if event.Type != EventTypeLog && event.Type != EventTypeCall {
+			return 0, fmt.Errorf("event %d: %s events are never archived", event.ID, event.Type)
+		}
+		count, err := tx.ImportEvent(ctx, sql.ImportEventParams{
+			ID:            event.ID,
+			TimeStamp:     event.Time,
+			DeploymentKey: event.DeploymentKey,
+			RequestKey:    event.RequestKey,
+			Type:          event.Type,
+			CustomKey1:    event.CustomKey1,
+			CustomKey2:    event.CustomKey2,
+			CustomKey3:    event.CustomKey3,
+			CustomKey4:    event.CustomKey4,
+			Payload:       event.Payload,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("event %d: failed to import: %w", event.ID, translatePGError(err))
+		}
+		imported += int(count)
+	}
+	return imported, nil
+}
@@ -0,0 +1,128 @@
+package dal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestParseEventRetentionPolicies(t *testing.T) {
+	policies, err := ParseEventRetentionPolicies(map[string]time.Duration{"log": time.Hour})
+	assert.NoError(t, err)
+	assert.Equal(t, []EventRetentionPolicy{{Type: EventTypeLog, MaxAge: time.Hour}}, policies)
+
+	policies, err = ParseEventRetentionPolicies(map[string]time.Duration{"echo.call": time.Minute})
+	assert.NoError(t, err)
+	assert.Equal(t, []EventRetentionPolicy{{Type: EventTypeCall, Module: optional.Some("echo"), MaxAge: time.Minute}}, policies)
+
+	_, err = ParseEventRetentionPolicies(map[string]time.Duration{"logs": time.Hour})
+	assert.EqualError(t, err, `logs: unknown event type "logs"`)
+
+	_, err = ParseEventRetentionPolicies(map[string]time.Duration{"echo.deployment_created": time.Hour})
+	assert.EqualError(t, err, `echo.deployment_created: deployment_created events are part of the deployment history and can not expire`)
+
+	_, err = ParseEventRetentionPolicies(map[string]time.Duration{"log": 0})
+	assert.EqualError(t, err, `log: retention period must be positive`)
+}
+
+func TestPruneEvents(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	for _, module := range []string{"echo", "time"} {
+		deployment, err := dal.CreateDeployment(ctx, "go", &schema.Module{Name: module}, nil, nil, nil)
+		assert.NoError(t, err)
+		for range 3 {
+			err = dal.InsertLogEvent(ctx, &LogEvent{DeploymentKey: deployment, Time: time.Now().Add(-time.Hour), Level: int32(log.Info), Message: "old"})
+			assert.NoError(t, err)
+		}
+		err = dal.InsertLogEvent(ctx, &LogEvent{DeploymentKey: deployment, Time: time.Now(), Level: int32(log.Info), Message: "new"})
+		assert.NoError(t, err)
+	}
+
+	var archived []ExpiredEvent
+	count, err := dal.PruneEvents(ctx, []EventRetentionPolicy{
+		{Type: EventTypeLog, MaxAge: time.Minute},
+		// Keep time's logs for longer.
+		{Type: EventTypeLog, Module: optional.Some("time"), MaxAge: time.Hour * 2},
+	}, 2, func(ctx context.Context, events []ExpiredEvent) error {
+		archived = append(archived, events...)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, 3, len(archived))
+	for _, event := range archived {
+		assert.Equal(t, "echo", event.Module)
+	}
+
+	events, err := dal.QueryEvents(ctx, 100, FilterTypes(EventTypeLog))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(events))
+}
+
+func TestImportEvents(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	deployment, err := dal.CreateDeployment(ctx, "go", &schema.Module{Name: "echo"}, nil, nil, nil)
+	assert.NoError(t, err)
+	for _, message := range []string{"one", "two", "three"} {
+		err = dal.InsertLogEvent(ctx, &LogEvent{DeploymentKey: deployment, Time: time.Now().Add(-time.Hour), Level: int32(log.Info), Message: message})
+		assert.NoError(t, err)
+	}
+	expected, err := dal.QueryEvents(ctx, 100, FilterTypes(EventTypeLog))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(expected))
+
+	// Archives are JSON encoded.
+	var archive []byte
+	count, err := dal.PruneEvents(ctx, []EventRetentionPolicy{{Type: EventTypeLog, MaxAge: time.Minute}}, 2, func(ctx context.Context, events []ExpiredEvent) error {
+		for _, event := range events {
+			data, err := json.Marshal(event)
+			assert.NoError(t, err)
+			archive = append(append(archive, data...), '\n')
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	events, err := dal.QueryEvents(ctx, 100, FilterTypes(EventTypeLog))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(events))
+
+	var archived []ExpiredEvent
+	dec := json.NewDecoder(bytes.NewReader(archive))
+	for dec.More() {
+		var event ExpiredEvent
+		assert.NoError(t, dec.Decode(&event))
+		archived = append(archived, event)
+	}
+	imported, err := dal.ImportEvents(ctx, archived)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, imported)
+	events, err = dal.QueryEvents(ctx, 100, FilterTypes(EventTypeLog))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, events)
+
+	// Events that already exist are skipped.
+	imported, err = dal.ImportEvents(ctx, archived)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, imported)
+	events, err = dal.QueryEvents(ctx, 100, FilterTypes(EventTypeLog))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, events)
+}
@@ -102,6 +102,7 @@ type eventFilter struct {
 	types        []EventType
 	deployments  []model.DeploymentKey
 	requests     []string
+	origins      []string
 	newerThan    time.Time
 	olderThan    time.Time
 	idHigherThan int64
@@ -140,6 +141,15 @@ func FilterRequests(requestKeys ...model.RequestKey) EventFilter {
 	}
 }
 
+// FilterOrigins filters events to those of requests with the given origins.
+func FilterOrigins(origins ...model.Origin) EventFilter {
+	return func(query *eventFilter) {
+		for _, origin := range origins {
+			query.origins = append(query.origins, string(origin))
+		}
+	}
+}
+
 func FilterTypes(types ...sql.EventType) EventFilter {
 	return func(query *eventFilter) {
 		query.types = append(query.types, types...)
@@ -279,6 +289,9 @@ func (d *DAL) QueryEvents(ctx context.Context, limit int, filters ...EventFilter
 	if filter.requests != nil {
 		q += fmt.Sprintf(` AND ir.key = ANY($%d::TEXT[])`, param(filter.requests))
 	}
+	if filter.origins != nil {
+		q += fmt.Sprintf(` AND ir.origin = ANY($%d::text[]::origin[])`, param(filter.origins))
+	}
 	if filter.types != nil {
 		// Why are we double casting? Because I hit "cannot find encode plan" and
 		// this works around it: https://github.com/jackc/pgx/issues/338#issuecomment-333399112
@@ -3,31 +3,152 @@ package dal
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/alecthomas/types/optional"
 
 	"github.com/TBD54566975/ftl/backend/controller/sql"
 	"github.com/TBD54566975/ftl/backend/schema"
 )
 
-// SendFSMEvent sends an event to an executing instance of an FSM.
+type FSMStatus = sql.FsmStatus
+
+const (
+	FSMStatusRunning   = sql.FsmStatusRunning
+	FSMStatusCompleted = sql.FsmStatusCompleted
+	FSMStatusFailed    = sql.FsmStatusFailed
+)
+
+// FSMInstance is a single execution of an FSM, identified by a user provided key.
+type FSMInstance struct {
+	FSM    schema.RefKey
+	Key    string
+	Status FSMStatus
+	// CurrentState is the last state the FSM successfully transitioned to.
+	CurrentState optional.Option[schema.RefKey]
+	// DestinationState is the state the FSM is currently transitioning to.
+	DestinationState optional.Option[schema.RefKey]
+}
+
+// StartFSMTransition sends an event to an executing instance of an FSM.
 //
 // If the instance doesn't exist a new one will be created.
 //
-// [name] is the name of the state machine to execute, [executionKey] is the
-// unique identifier for this execution of the FSM.
+// [fsm] is the FSM to execute, [executionKey] is the unique identifier for
+// this execution of the FSM.
 //
-// Returns ErrConflict if the state machine is already executing.
+// Returns ErrConflict if the state machine is already transitioning, or has
+// completed or failed.
 //
 // Note: this does not actually call the FSM, it just enqueues an async call for
 // future execution.
 //
 // Note: no validation of the FSM is performed.
-func (d *DAL) SendFSMEvent(ctx context.Context, name, executionKey, destinationState string, verb schema.Ref, request json.RawMessage) error {
-	_, err := d.db.SendFSMEvent(ctx, sql.SendFSMEventParams{
-		Key:     executionKey,
-		Name:    name,
-		State:   destinationState,
-		Verb:    verb,
-		Request: request,
+func (d *DAL) StartFSMTransition(ctx context.Context, fsm schema.RefKey, executionKey string, destinationState schema.RefKey, request json.RawMessage) error {
+	_, err := d.db.StartFSMTransition(ctx, sql.StartFSMTransitionParams{
+		Fsm:              schema.Ref{Module: fsm.Module, Name: fsm.Name},
+		Key:              executionKey,
+		OriginKey:        executionKey,
+		DestinationState: schema.Ref{Module: destinationState.Module, Name: destinationState.Name},
+		Request:          request,
 	})
-	return translatePGError(err)
+	if err != nil {
+		err = translatePGError(err)
+		// The execution CTE returns no rows if a transition is already in
+		// progress, violating the NOT NULL constraint on the transition.
+		if errors.Is(err, ErrConstraint) {
+			return fmt.Errorf("transition already executing: %w", ErrConflict)
+		}
+		return fmt.Errorf("failed to start FSM transition: %w", err)
+	}
+	return nil
+}
+
+// GetFSMInstance returns the FSM instance for the given FSM and key.
+//
+// Returns ErrNotFound if the instance does not exist.
+func (d *DAL) GetFSMInstance(ctx context.Context, fsm schema.RefKey, key string) (*FSMInstance, error) {
+	row, err := d.db.GetFSMExecution(ctx, schema.Ref{Module: fsm.Module, Name: fsm.Name}, key)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	return fsmInstanceFromRow(row), nil
+}
+
+// GetFSMInstanceForAsyncCall returns the FSM instance being transitioned by
+// the given async call.
+//
+// Returns ErrNotFound if there is no such instance.
+func (d *DAL) GetFSMInstanceForAsyncCall(ctx context.Context, call *AsyncCall) (*FSMInstance, error) {
+	row, err := d.db.GetFSMExecutionForAsyncCall(ctx, call.ID)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	return fsmInstanceFromRow(row), nil
+}
+
+// FinishFSMTransition completes the async call executing the transition of
+// an instance and moves the instance to its destination state.
+//
+// If [terminal] is true the destination state has no outgoing transitions and
+// the instance is marked as completed.
+func (d *DAL) FinishFSMTransition(ctx context.Context, instance *FSMInstance, call *AsyncCall, response []byte, terminal bool) (err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	fsm := schema.Ref{Module: instance.FSM.Module, Name: instance.FSM.Name}
+	if _, err = tx.SucceedAsyncCall(ctx, response, call.ID); err != nil {
+		return fmt.Errorf("failed to complete async call %d: %w", call.ID, translatePGError(err))
+	}
+	if _, err = tx.FinishFSMTransition(ctx, fsm, instance.Key); err != nil {
+		return fmt.Errorf("failed to finish transition of FSM instance %s:%s: %w", instance.FSM, instance.Key, translatePGError(err))
+	}
+	if terminal {
+		if _, err = tx.SucceedFSMExecution(ctx, fsm, instance.Key); err != nil {
+			return fmt.Errorf("failed to complete FSM instance %s:%s: %w", instance.FSM, instance.Key, translatePGError(err))
+		}
+	}
+	return nil
+}
+
+// FailFSMTransition fails the async call executing the transition of an
+// instance, and the instance along with it.
+//
+// The instance resumes the transition if the call is requeued, see
+// [DAL.RequeueFailedAsyncCall].
+func (d *DAL) FailFSMTransition(ctx context.Context, instance *FSMInstance, call *AsyncCall, callError string) (err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	if _, err = tx.FailAsyncCall(ctx, callError, call.ID); err != nil {
+		return fmt.Errorf("failed to fail async call %d: %w", call.ID, translatePGError(err))
+	}
+	if _, err = tx.FailFSMExecution(ctx, schema.Ref{Module: instance.FSM.Module, Name: instance.FSM.Name}, instance.Key); err != nil {
+		return fmt.Errorf("failed to fail FSM instance %s:%s: %w", instance.FSM, instance.Key, translatePGError(err))
+	}
+	return nil
+}
+
+func fsmInstanceFromRow(row sql.FsmExecution) *FSMInstance {
+	return &FSMInstance{
+		FSM:              row.Fsm.ToRefKey(),
+		Key:              row.Key,
+		Status:           row.Status,
+		CurrentState:     nullRefToRefKey(row.CurrentState),
+		DestinationState: nullRefToRefKey(row.DestinationState),
+	}
+}
+
+func nullRefToRefKey(ref sql.NullSchemaRef) optional.Option[schema.RefKey] {
+	if ref, ok := ref.Get(); ok {
+		return optional.Some(ref.ToRefKey())
+	}
+	return optional.None[schema.RefKey]()
 }
@@ -0,0 +1,100 @@
+package dal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// IngressHTTPRequest is an HTTP request received through ingress, along with
+// the response that was sent for it.
+type IngressHTTPRequest struct {
+	Method string
+	Host   string
+	Path   string
+	// Query is the raw query string, without the leading "?".
+	Query    string
+	Header   http.Header
+	Body     []byte
+	Response IngressHTTPResponse
+}
+
+// IngressHTTPResponse is the HTTP response sent for an [IngressHTTPRequest].
+type IngressHTTPResponse struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// RecordIngressHTTPRequest records the HTTP request and response of an
+// ingress request so that it can be replayed.
+//
+// Nothing is recorded if the request does not exist, eg. because it was
+// rejected before reaching a verb.
+func (d *DAL) RecordIngressHTTPRequest(ctx context.Context, key model.RequestKey, req IngressHTTPRequest) error {
+	header, err := json.Marshal(req.Header)
+	if err != nil {
+		return fmt.Errorf("failed to encode request headers: %w", err)
+	}
+	responseHeader, err := json.Marshal(req.Response.Header)
+	if err != nil {
+		return fmt.Errorf("failed to encode response headers: %w", err)
+	}
+	err = d.db.InsertIngressHTTPRequest(ctx, sql.InsertIngressHTTPRequestParams{
+		Method:          req.Method,
+		Host:            req.Host,
+		Path:            req.Path,
+		Query:           req.Query,
+		Headers:         header,
+		Body:            nonNilBytes(req.Body),
+		ResponseStatus:  int32(req.Response.Status),
+		ResponseHeaders: responseHeader,
+		ResponseBody:    nonNilBytes(req.Response.Body),
+		Key:             key,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record HTTP request %s: %w", key, translatePGError(err))
+	}
+	return nil
+}
+
+// GetIngressHTTPRequest returns the recorded HTTP request of an ingress
+// request.
+//
+// Returns ErrNotFound if no HTTP request was recorded.
+func (d *DAL) GetIngressHTTPRequest(ctx context.Context, key model.RequestKey) (IngressHTTPRequest, error) {
+	row, err := d.db.GetIngressHTTPRequest(ctx, key)
+	if err != nil {
+		return IngressHTTPRequest{}, translatePGError(err)
+	}
+	out := IngressHTTPRequest{
+		Method: row.Method,
+		Host:   row.Host,
+		Path:   row.Path,
+		Query:  row.Query,
+		Body:   row.Body,
+		Response: IngressHTTPResponse{
+			Status: int(row.ResponseStatus),
+			Body:   row.ResponseBody,
+		},
+	}
+	if err := json.Unmarshal(row.Headers, &out.Header); err != nil {
+		return IngressHTTPRequest{}, fmt.Errorf("failed to decode request headers: %w", err)
+	}
+	if err := json.Unmarshal(row.ResponseHeaders, &out.Response.Header); err != nil {
+		return IngressHTTPRequest{}, fmt.Errorf("failed to decode response headers: %w", err)
+	}
+	return out, nil
+}
+
+// nonNilBytes returns an empty slice for nil, as BYTEA columns are NOT NULL.
+func nonNilBytes(b []byte) []byte {
+	if b == nil {
+		return []byte{}
+	}
+	return b
+}
@@ -0,0 +1,47 @@
+package dal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestIngressHTTPRequest(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	requestKey := model.NewRequestKey(model.OriginIngress, "POST /greet")
+	_, err = dal.GetIngressHTTPRequest(ctx, requestKey)
+	assert.IsError(t, err, ErrNotFound)
+
+	err = dal.CreateRequest(ctx, requestKey, "127.0.0.1:1234")
+	assert.NoError(t, err)
+
+	recorded := IngressHTTPRequest{
+		Method: http.MethodPost,
+		Host:   "localhost:8891",
+		Path:   "/greet",
+		Query:  "lang=en",
+		Header: http.Header{"Content-Type": {"application/json"}},
+		Body:   []byte(`{"name":"Alice"}`),
+		Response: IngressHTTPResponse{
+			Status: http.StatusOK,
+			Header: http.Header{"Content-Type": {"application/json; charset=utf-8"}},
+			Body:   []byte(`{"name":"Alice"}`),
+		},
+	}
+	err = dal.RecordIngressHTTPRequest(ctx, requestKey, recorded)
+	assert.NoError(t, err)
+
+	actual, err := dal.GetIngressHTTPRequest(ctx, requestKey)
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, actual)
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/alecthomas/types/optional"
 	"github.com/google/uuid"
 
 	"github.com/TBD54566975/ftl/backend/controller/leases"
 	"github.com/TBD54566975/ftl/backend/controller/sql"
 	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 const leaseRenewalInterval = time.Second * 2
@@ -21,6 +23,7 @@ var _ leases.Leaser = (*DAL)(nil)
 type Lease struct {
 	key            leases.Key
 	idempotencyKey uuid.UUID
+	fencingToken   int64
 	db             sql.DBI
 	ttl            time.Duration
 	errch          chan error
@@ -68,6 +71,8 @@ func (l *Lease) renew(ctx context.Context) {
 	}
 }
 
+func (l *Lease) FencingToken() int64 { return l.fencingToken }
+
 func (l *Lease) Release() error {
 	close(l.release)
 	return <-l.errch
@@ -77,16 +82,17 @@ func (d *DAL) AcquireLease(ctx context.Context, key leases.Key, ttl time.Duratio
 	if ttl < time.Second*5 {
 		return nil, fmt.Errorf("lease TTL must be at least 5 seconds")
 	}
-	idempotencyKey, err := d.db.NewLease(ctx, key, ttl)
+	row, err := d.db.NewLease(ctx, key, ttl)
 	if err != nil {
 		return nil, translatePGError(err)
 	}
-	return d.newLease(ctx, key, idempotencyKey, ttl), nil
+	return d.newLease(ctx, key, row.IdempotencyKey, row.FencingToken, ttl), nil
 }
 
-func (d *DAL) newLease(ctx context.Context, key leases.Key, idempotencyKey uuid.UUID, ttl time.Duration) *Lease {
+func (d *DAL) newLease(ctx context.Context, key leases.Key, idempotencyKey uuid.UUID, fencingToken int64, ttl time.Duration) *Lease {
 	lease := &Lease{
 		idempotencyKey: idempotencyKey,
+		fencingToken:   fencingToken,
 		key:            key,
 		db:             d.db,
 		ttl:            ttl,
@@ -106,3 +112,40 @@ func (d *DAL) ExpireLeases(ctx context.Context) error {
 	}
 	return translatePGError(err)
 }
+
+// LeaseInfo describes a lease that is currently held.
+type LeaseInfo struct {
+	Key          leases.Key
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+	FencingToken int64
+}
+
+// GetLeases returns all leases that are currently held, optionally only those
+// of a module.
+func (d *DAL) GetLeases(ctx context.Context, module optional.Option[string]) ([]LeaseInfo, error) {
+	var prefix optional.Option[string]
+	if module, ok := module.Get(); ok {
+		prefix = optional.Some(leases.ModuleKey(module).String() + "/")
+	}
+	rows, err := d.db.GetLeases(ctx, prefix)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.GetLeasesRow) LeaseInfo {
+		return LeaseInfo{Key: row.Key, CreatedAt: row.CreatedAt, ExpiresAt: row.ExpiresAt, FencingToken: row.FencingToken}
+	}), nil
+}
+
+// ForceReleaseLease releases a lease regardless of which controller holds it.
+//
+// The holder will fail to renew the lease and release it. Returns ErrNotFound
+// if the lease is not held.
+func (d *DAL) ForceReleaseLease(ctx context.Context, key leases.Key) error {
+	_, err := d.db.ForceReleaseLease(ctx, key)
+	if err != nil {
+		return translatePGError(err)
+	}
+	log.FromContext(ctx).Warnf("Forcibly released lease %s", key)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
 	"github.com/google/uuid"
 
 	"github.com/TBD54566975/ftl/backend/controller/leases"
@@ -57,6 +58,13 @@ func TestLease(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.False(t, leaseExists(t, conn, lease.idempotencyKey, lease.key))
+
+	// Reacquiring the lease should issue a newer fencing token.
+	leasei, err = dal.AcquireLease(ctx, leases.SystemKey("test"), time.Second*5)
+	assert.NoError(t, err)
+	assert.True(t, leasei.FencingToken() > lease.FencingToken())
+	err = leasei.Release()
+	assert.NoError(t, err)
 }
 
 func TestExpireLeases(t *testing.T) {
@@ -98,3 +106,48 @@ func TestExpireLeases(t *testing.T) {
 	err = leasei.Release()
 	assert.NoError(t, err)
 }
+
+func TestGetAndForceReleaseLeases(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	system, err := dal.AcquireLease(ctx, leases.SystemKey("test"), time.Second*5)
+	assert.NoError(t, err)
+	defer system.Release() //nolint:errcheck
+	echo, err := dal.AcquireLease(ctx, leases.ModuleKey("echo", "user", "bob"), time.Second*5)
+	assert.NoError(t, err)
+	echoes, err := dal.AcquireLease(ctx, leases.ModuleKey("echoes", "user", "alice"), time.Second*5)
+	assert.NoError(t, err)
+	defer echoes.Release() //nolint:errcheck
+
+	all, err := dal.GetLeases(ctx, optional.None[string]())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(all))
+
+	held, err := dal.GetLeases(ctx, optional.Some("echo"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(held))
+	assert.Equal(t, leases.ModuleKey("echo", "user", "bob"), held[0].Key)
+	assert.True(t, held[0].ExpiresAt.After(held[0].CreatedAt))
+
+	// Expired leases are not listed, even before they are reaped.
+	_, err = conn.Exec(ctx, "UPDATE leases SET expires_at = NOW() - INTERVAL '1 minute' WHERE key = $1", leases.SystemKey("test"))
+	assert.NoError(t, err)
+	all, err = dal.GetLeases(ctx, optional.None[string]())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(all))
+
+	err = dal.ForceReleaseLease(ctx, leases.ModuleKey("echo", "user", "bob"))
+	assert.NoError(t, err)
+	err = dal.ForceReleaseLease(ctx, leases.ModuleKey("echo", "user", "bob"))
+	assert.IsError(t, err, ErrNotFound)
+
+	// The holder finds out when it releases the lease.
+	err = echo.Release()
+	assert.IsError(t, err, ErrNotFound)
+}
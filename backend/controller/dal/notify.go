@@ -58,7 +58,7 @@ func (d *DAL) runListener(ctx context.Context, conn *pgx.Conn) {
 
 	// Wait for the notification channel to be ready.
 	retry := backoff.Backoff{}
-	channels := []string{"deployments_events", "topics_events", "topic_events_events"}
+	channels := []string{"deployments_events", "topics_events", "topic_events_events", "ingress_connection_messages_events"}
 	for _, channel := range channels {
 		for {
 			_, err := conn.Exec(ctx, "LISTEN "+channel)
@@ -105,12 +105,13 @@ func (d *DAL) publishNotification(ctx context.Context, notification event, logge
 				return Deployment{}, optional.None[model.DeploymentKey](), translatePGError(err)
 			}
 			return Deployment{
-				CreatedAt:   row.Deployment.CreatedAt,
-				Key:         row.Deployment.Key,
-				Module:      row.ModuleName,
-				Schema:      row.Deployment.Schema,
-				MinReplicas: int(row.Deployment.MinReplicas),
-				Language:    row.Language,
+				CreatedAt:    row.Deployment.CreatedAt,
+				Key:          row.Deployment.Key,
+				Module:       row.ModuleName,
+				Schema:       row.Deployment.Schema,
+				MinReplicas:  int(row.Deployment.MinReplicas),
+				Language:     row.Language,
+				CanaryWeight: canaryWeight(row.Deployment.CanaryWeight),
 			}, optional.None[model.DeploymentKey](), nil
 		})
 		if err != nil {
@@ -119,6 +120,18 @@ func (d *DAL) publishNotification(ctx context.Context, notification event, logge
 		logger.Tracef("Deployment notification: %s", deployment)
 		d.DeploymentChanges.Publish(deployment)
 
+	case "ingress_connection_messages":
+		var key model.ConnectionKey
+		if err := key.UnmarshalText([]byte(notification.New)); err != nil {
+			return fmt.Errorf("failed to unmarshal connection key: %w", err)
+		}
+		d.ConnectionMessages.Publish(key)
+
+	case "topics", "topic_events":
+		// Events are delivered to subscribers by polling, so there is nothing
+		// to publish here.
+		logger.Tracef("Ignoring %s notification on %s", notification.Action, notification.Table)
+
 	default:
 		panic(fmt.Sprintf("unknown table %q in DB notification", notification.Table))
 	}
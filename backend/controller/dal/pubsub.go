@@ -0,0 +1,97 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// PublishEventForTopic appends an event to a topic.
+//
+// Returns ErrNotFound if the topic does not exist.
+func (d *DAL) PublishEventForTopic(ctx context.Context, module, topic string, payload []byte) error {
+	err := d.db.PublishEventForTopic(ctx, sql.PublishEventForTopicParams{
+		Key:     model.NewTopicEventKey(module, topic),
+		Module:  module,
+		Topic:   topic,
+		Payload: payload,
+	})
+	if err != nil {
+		err = translatePGError(err)
+		// The topic ID sub-select returns NULL if the topic doesn't exist.
+		if errors.Is(err, ErrConstraint) {
+			return fmt.Errorf("%s.%s: %w", module, topic, ErrNotFound)
+		}
+		return fmt.Errorf("failed to publish event to topic %s.%s: %w", module, topic, err)
+	}
+	return nil
+}
+
+// ProgressSubscriptions advances each idle subscription that has pending
+// events by one event, enqueuing an async call to a randomly selected
+// subscriber for that event.
+//
+// Returns the number of subscriptions that were progressed.
+func (d *DAL) ProgressSubscriptions(ctx context.Context) (count int, err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	logger := log.FromContext(ctx)
+
+	subs, err := tx.GetSubscriptionsNeedingUpdate(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get subscriptions needing update: %w", translatePGError(err))
+	}
+	for _, subscription := range subs {
+		subscriber, err := tx.GetRandomSubscriber(ctx, subscription.Key)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get subscriber for %s: %w", subscription.Key, translatePGError(err))
+		}
+		nextEvent, err := tx.GetNextEventForSubscription(ctx, subscription.Key)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get next event for %s: %w", subscription.Key, translatePGError(err))
+		}
+		asyncCallID, err := tx.AddAsyncCall(ctx, sql.AddAsyncCallParams{
+			Verb:      schema.Ref{Module: subscriber.ModuleName, Name: subscriber.Sink},
+			Origin:    sql.AsyncCallOriginPubsub,
+			OriginKey: subscription.Key.String(),
+			Request:   nextEvent.Payload,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to schedule async call for %s: %w", subscription.Key, translatePGError(err))
+		}
+		err = tx.BeginConsumingTopicEvent(ctx, nextEvent.ID, asyncCallID, subscription.Key)
+		if err != nil {
+			return 0, fmt.Errorf("failed to progress subscription %s: %w", subscription.Key, translatePGError(err))
+		}
+		logger.Debugf("Scheduled event %s from %s.%s for %s.%s", nextEvent.Key, subscription.ModuleName, subscription.Name, subscriber.ModuleName, subscriber.Sink)
+		count++
+	}
+	return count, nil
+}
+
+// CompleteEventForSubscription marks the in-flight event for a subscription
+// as consumed, allowing the subscription to progress to the next event.
+//
+// Only successful deliveries should be completed. A subscription whose
+// delivery failed stays blocked on the failed call until it is requeued or
+// discarded, see [DAL.RequeueFailedAsyncCall] and
+// [DAL.DiscardFailedAsyncCall].
+//
+// This is a no-op if [call] is not the call delivering the in-flight event,
+// eg. if it is a requeued call for an earlier event.
+func (d *DAL) CompleteEventForSubscription(ctx context.Context, subscription model.SubscriptionKey, call *AsyncCall) error {
+	err := d.db.CompleteEventForSubscription(ctx, subscription, call.ID)
+	if err != nil {
+		return fmt.Errorf("failed to complete event for subscription %s: %w", subscription, translatePGError(err))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package dal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestDiscardedSubscriptionDeliveryUnblocksSubscription(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+	createSubscriptionDeployment(ctx, t, dal)
+
+	err = dal.PublishEventForTopic(ctx, "test", "topic", []byte(`"first"`))
+	assert.NoError(t, err)
+	err = dal.PublishEventForTopic(ctx, "test", "topic", []byte(`"second"`))
+	assert.NoError(t, err)
+
+	call := deliverNextEvent(ctx, t, dal)
+	assert.Equal(t, []byte(`"first"`), call.Request)
+	err = dal.CompleteAsyncCall(ctx, call, nil, optional.Some("delivery failed"))
+	assert.NoError(t, err)
+	err = call.Lease.Release()
+	assert.NoError(t, err)
+
+	// The subscription is blocked on the failed call.
+	count, err := dal.ProgressSubscriptions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	err = dal.DiscardFailedAsyncCall(ctx, call.ID)
+	assert.NoError(t, err)
+
+	call = deliverNextEvent(ctx, t, dal)
+	assert.Equal(t, []byte(`"second"`), call.Request)
+	err = call.Lease.Release()
+	assert.NoError(t, err)
+}
+
+func TestRequeuedSubscriptionDeliveryResumesSubscription(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+	createSubscriptionDeployment(ctx, t, dal)
+
+	err = dal.PublishEventForTopic(ctx, "test", "topic", []byte(`"first"`))
+	assert.NoError(t, err)
+	err = dal.PublishEventForTopic(ctx, "test", "topic", []byte(`"second"`))
+	assert.NoError(t, err)
+
+	call := deliverNextEvent(ctx, t, dal)
+	err = dal.CompleteAsyncCall(ctx, call, nil, optional.Some("delivery failed"))
+	assert.NoError(t, err)
+	err = call.Lease.Release()
+	assert.NoError(t, err)
+
+	err = dal.RequeueFailedAsyncCall(ctx, call.ID)
+	assert.NoError(t, err)
+
+	// The requeued call delivers the same event again.
+	call, err = dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`"first"`), call.Request)
+	err = dal.CompleteAsyncCall(ctx, call, []byte(`{}`), optional.None[string]())
+	assert.NoError(t, err)
+	err = dal.CompleteEventForSubscription(ctx, model.NewSubscriptionKey("test", "sub"), call)
+	assert.NoError(t, err)
+	err = call.Lease.Release()
+	assert.NoError(t, err)
+
+	call = deliverNextEvent(ctx, t, dal)
+	assert.Equal(t, []byte(`"second"`), call.Request)
+	err = call.Lease.Release()
+	assert.NoError(t, err)
+}
+
+func createSubscriptionDeployment(ctx context.Context, t *testing.T, dal *DAL) {
+	t.Helper()
+	deployment, err := dal.CreateDeployment(ctx, "go", &schema.Module{
+		Name: "test",
+		Decls: []schema.Decl{
+			&schema.Topic{Name: "topic", Event: &schema.String{}},
+			&schema.Subscription{Name: "sub", Topic: &schema.Ref{Module: "test", Name: "topic"}},
+			&schema.Verb{
+				Name:     "sink",
+				Request:  &schema.String{},
+				Response: &schema.Unit{},
+				Metadata: []schema.Metadata{&schema.MetadataSubscriber{Name: "sub"}},
+			},
+		},
+	}, nil, nil, nil)
+	assert.NoError(t, err)
+	err = dal.SetDeploymentReplicas(ctx, deployment, 1)
+	assert.NoError(t, err)
+}
+
+// deliverNextEvent progresses the subscription and acquires the async call
+// delivering its next event.
+func deliverNextEvent(ctx context.Context, t *testing.T, dal *DAL) *AsyncCall {
+	t.Helper()
+	count, err := dal.ProgressSubscriptions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	call, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	return call
+}
@@ -0,0 +1,29 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// IncrementRateLimit counts a call against the rate limit window starting at
+// windowStart for key, and returns the number of calls counted in the window.
+//
+// The window is kept until expiresAt, after which it may be removed by
+// [DAL.DeleteExpiredRateLimits].
+func (d *DAL) IncrementRateLimit(ctx context.Context, key string, windowStart, expiresAt time.Time) (int64, error) {
+	count, err := d.db.IncrementRateLimit(ctx, key, windowStart, expiresAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment rate limit: %w", translatePGError(err))
+	}
+	return count, nil
+}
+
+// DeleteExpiredRateLimits removes rate limit windows that expired before now.
+func (d *DAL) DeleteExpiredRateLimits(ctx context.Context, now time.Time) (int64, error) {
+	count, err := d.db.DeleteExpiredRateLimits(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired rate limits: %w", translatePGError(err))
+	}
+	return count, nil
+}
@@ -0,0 +1,47 @@
+package dal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestRateLimits(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	window := time.Now().Truncate(time.Minute)
+	next := window.Add(time.Minute)
+
+	for i := int64(1); i <= 3; i++ {
+		count, err := dal.IncrementRateLimit(ctx, "test.verb|ip|127.0.0.1", window, next)
+		assert.NoError(t, err)
+		assert.Equal(t, i, count)
+	}
+
+	// Other keys are counted separately.
+	count, err := dal.IncrementRateLimit(ctx, "test.verb|ip|127.0.0.2", window, next)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// A new window resets the count.
+	count, err = dal.IncrementRateLimit(ctx, "test.verb|ip|127.0.0.1", next, next.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// Calls from a lagging clock count against the current window.
+	count, err = dal.IncrementRateLimit(ctx, "test.verb|ip|127.0.0.1", window, next)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	deleted, err := dal.DeleteExpiredRateLimits(ctx, next.Add(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
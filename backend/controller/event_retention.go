@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+const eventPruneBatchSize = 1000
+
+// Delete events that have expired under the configured retention policies.
+func (s *Service) pruneEvents(ctx context.Context) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	var archive func(ctx context.Context, events []dal.ExpiredEvent) error
+	if s.config.EventArchiveDir != "" {
+		archive = func(ctx context.Context, events []dal.ExpiredEvent) error {
+			return archiveEvents(s.config.EventArchiveDir, events)
+		}
+	}
+	count, err := s.dal.PruneEvents(ctx, s.eventRetention, eventPruneBatchSize, archive)
+	if count > 0 {
+		logger.Debugf("Pruned %d expired events", count)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune events: %w", err)
+	}
+	return time.Minute * 5, nil
+}
+
+// archiveEvents writes events to a new gzipped JSONL file in dir, one event
+// per line.
+//
+// Files are named after the type and ID range of the events they contain.
+func archiveEvents(dir string, events []dal.ExpiredEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create event archive directory: %w", err)
+	}
+	first, last := events[0], events[len(events)-1]
+	path := filepath.Join(dir, fmt.Sprintf("events-%s-%d-%d.jsonl.gz", first.Type, first.ID, last.ID))
+
+	// Write to a temporary file so partial archives are never visible.
+	tmp, err := os.CreateTemp(dir, ".events-*")
+	if err != nil {
+		return fmt.Errorf("failed to create event archive: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	defer tmp.Close()
+
+	w := gzip.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("failed to encode event %d: %w", event.ID, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to write event archive: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write event archive: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write event archive: %w", err)
+	}
+	return nil
+}
+
+// readEventArchive reads the events in an archive written by [archiveEvents].
+func readEventArchive(r io.Reader) ([]dal.ExpiredEvent, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+	dec := json.NewDecoder(gr)
+	var events []dal.ExpiredEvent
+	for {
+		var event dal.ExpiredEvent
+		err := dec.Decode(&event)
+		if errors.Is(err, io.EOF) {
+			return events, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("event %d: %w", len(events)+1, err)
+		}
+		events = append(events, event)
+	}
+}
+
+func (s *Service) ImportEvents(ctx context.Context, req *connect.Request[ftlv1.ImportEventsRequest]) (*connect.Response[ftlv1.ImportEventsResponse], error) {
+	events, err := readEventArchive(bytes.NewReader(req.Msg.Archive))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid event archive: %w", err))
+	}
+	imported, err := s.dal.ImportEvents(ctx, events)
+	if err != nil {
+		return nil, fmt.Errorf("could not import events: %w", err)
+	}
+	log.FromContext(ctx).Infof("Imported %d of %d archived events", imported, len(events))
+	return connect.NewResponse(&ftlv1.ImportEventsResponse{Imported: int64(imported)}), nil
+}
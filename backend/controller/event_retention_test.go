@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestArchiveEvents(t *testing.T) {
+	dir := t.TempDir()
+	deployment := model.NewDeploymentKey("echo")
+	events := []dal.ExpiredEvent{
+		{ID: 1, Time: time.Unix(0, 0).UTC(), Type: dal.EventTypeLog, DeploymentKey: deployment, Module: "echo", Payload: json.RawMessage(`{"message":"one"}`)},
+		{ID: 5, Time: time.Unix(1, 0).UTC(), Type: dal.EventTypeLog, DeploymentKey: deployment, Module: "echo", RequestKey: optional.Some("req-ingress-http-0000"), Payload: json.RawMessage(`{"message":"two"}`)},
+	}
+	err := archiveEvents(dir, events)
+	assert.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, "events-log-1-5.jsonl.gz", entries[0].Name())
+
+	f, err := os.Open(filepath.Join(dir, entries[0].Name()))
+	assert.NoError(t, err)
+	defer f.Close()
+	actual, err := readEventArchive(f)
+	assert.NoError(t, err)
+	assert.Equal(t, events, actual)
+}
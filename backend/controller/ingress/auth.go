@@ -0,0 +1,151 @@
+package ingress
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+const (
+	// JWKSConfig is the name of the module config containing the JWK set used
+	// to verify JWTs for verbs with "+auth jwt".
+	JWKSConfig = "jwks"
+	// JWTAudienceConfig is the name of the module config holding the audience,
+	// in the "aud" claim, that JWTs for verbs with "+auth jwt" must be issued
+	// for.
+	JWTAudienceConfig = "jwtAudience"
+	// JWTIssuerConfig is the name of the module config holding the issuer, in
+	// the "iss" claim, that JWTs for verbs with "+auth jwt" must be issued by.
+	JWTIssuerConfig = "jwtIssuer"
+	// APIKeysSecret is the name of the module secret containing the API keys,
+	// keyed by name, accepted for verbs with "+auth apikey".
+	APIKeysSecret = "apiKeys"
+)
+
+// jwtVerifierTTL is how long the JWT verification settings of a module are
+// cached for.
+const jwtVerifierTTL = time.Minute
+
+// errUnauthenticated is returned when a request does not carry valid
+// credentials.
+var errUnauthenticated = errors.New("unauthenticated")
+
+// HTTPAuth mirrors builtin.HttpAuth.
+type HTTPAuth struct {
+	Method  string         `json:"method"`
+	Subject string         `json:"subject"`
+	Claims  map[string]any `json:"claims"`
+}
+
+// Authenticator authenticates requests to verbs with "+auth" metadata.
+//
+// The JWT verification settings of each module are cached for
+// [jwtVerifierTTL], so that its key set is not loaded and parsed on every
+// request.
+type Authenticator struct {
+	clock clock.Clock
+
+	lock      sync.Mutex
+	verifiers map[string]cachedJWTVerifier
+}
+
+type cachedJWTVerifier struct {
+	verifier jwtVerifier
+	expires  time.Time
+}
+
+// NewAuthenticator creates a new [Authenticator].
+func NewAuthenticator() *Authenticator {
+	return newAuthenticator(clock.New())
+}
+
+func newAuthenticator(clock clock.Clock) *Authenticator {
+	return &Authenticator{clock: clock, verifiers: map[string]cachedJWTVerifier{}}
+}
+
+// authenticate a request to a verb in [module] with the given auth metadata.
+//
+// Credentials are read from the "Authorization: Bearer" header, or for API
+// keys also from the "X-API-Key" header. Returns an error wrapping
+// errUnauthenticated if the credentials are missing or invalid, or another
+// error if the module's keys could not be loaded.
+func (a *Authenticator) authenticate(ctx context.Context, r *http.Request, module string, md *schema.MetadataAuth) (HTTPAuth, error) {
+	credential, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok && md.Method == schema.AuthMethodAPIKey {
+		credential = r.Header.Get("X-API-Key")
+	}
+	credential = strings.TrimSpace(credential)
+	if credential == "" {
+		return HTTPAuth{}, fmt.Errorf("no credentials: %w", errUnauthenticated)
+	}
+
+	switch md.Method {
+	case schema.AuthMethodJWT:
+		verifier, err := a.jwtVerifier(ctx, module)
+		if err != nil {
+			return HTTPAuth{}, err
+		}
+		claims, err := verifier.verify(credential, a.clock.Now())
+		if err != nil {
+			return HTTPAuth{}, fmt.Errorf("%w: %w", errUnauthenticated, err)
+		}
+		subject, _ := claims["sub"].(string)
+		return HTTPAuth{Method: md.Method, Subject: subject, Claims: claims}, nil
+
+	case schema.AuthMethodAPIKey:
+		var keys map[string]string
+		if err := getModuleSecret(ctx, module, APIKeysSecret, &keys); err != nil {
+			return HTTPAuth{}, fmt.Errorf("could not load %s.%s secret: %w", module, APIKeysSecret, err)
+		}
+		for name, key := range keys {
+			if key != "" && subtle.ConstantTimeCompare([]byte(key), []byte(credential)) == 1 {
+				return HTTPAuth{Method: md.Method, Subject: name, Claims: map[string]any{}}, nil
+			}
+		}
+		return HTTPAuth{}, fmt.Errorf("unknown API key: %w", errUnauthenticated)
+
+	default:
+		return HTTPAuth{}, fmt.Errorf("unsupported auth method %q", md.Method)
+	}
+}
+
+// jwtVerifier returns the JWT verifier of a module, loading its settings from
+// config if they are not cached.
+func (a *Authenticator) jwtVerifier(ctx context.Context, module string) (jwtVerifier, error) {
+	a.lock.Lock()
+	cached, ok := a.verifiers[module]
+	a.lock.Unlock()
+	if ok && a.clock.Now().Before(cached.expires) {
+		return cached.verifier, nil
+	}
+
+	var keys JWKS
+	if err := getModuleConfig(ctx, module, JWKSConfig, &keys); err != nil {
+		return jwtVerifier{}, fmt.Errorf("could not load %s.%s config: %w", module, JWKSConfig, err)
+	}
+	var audience, issuer string
+	if err := getModuleConfig(ctx, module, JWTAudienceConfig, &audience); err != nil {
+		return jwtVerifier{}, fmt.Errorf("could not load %s.%s config: %w", module, JWTAudienceConfig, err)
+	}
+	if err := getModuleConfig(ctx, module, JWTIssuerConfig, &issuer); err != nil {
+		return jwtVerifier{}, fmt.Errorf("could not load %s.%s config: %w", module, JWTIssuerConfig, err)
+	}
+	verifier, err := newJWTVerifier(keys, audience, issuer)
+	if err != nil {
+		return jwtVerifier{}, fmt.Errorf("invalid JWT config for %s: %w", module, err)
+	}
+
+	a.lock.Lock()
+	a.verifiers[module] = cachedJWTVerifier{verifier: verifier, expires: a.clock.Now().Add(jwtVerifierTTL)}
+	a.lock.Unlock()
+	return verifier, nil
+}
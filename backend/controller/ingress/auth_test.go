@@ -0,0 +1,315 @@
+package ingress
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+	"github.com/benbjohnson/clock"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestVerifyJWT(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	edPublic, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	keys := JWKS{Keys: []JWK{
+		{Kty: "RSA", Kid: "rsa", N: b64(rsaKey.N.Bytes()), E: b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{Kty: "EC", Kid: "ec", Crv: "P-256", X: b64(ecKey.X.FillBytes(make([]byte, 32))), Y: b64(ecKey.Y.FillBytes(make([]byte, 32)))},
+		{Kty: "OKP", Kid: "ed", Crv: "Ed25519", X: b64(edPublic)},
+	}}
+	verifier, err := newJWTVerifier(keys, "ftl", "https://idp.example.com")
+	assert.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	claims := map[string]any{"sub": "alice", "exp": float64(now.Add(time.Hour).Unix()), "iss": "https://idp.example.com", "aud": "ftl"}
+	withClaims := func(overrides map[string]any) map[string]any {
+		out := map[string]any{}
+		for k, v := range claims {
+			out[k] = v
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(out, k)
+			} else {
+				out[k] = v
+			}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		err   string
+	}{
+		{name: "RS256", token: signJWT(t, "RS256", "rsa", rsaKey, claims)},
+		{name: "PS256", token: signJWT(t, "PS256", "rsa", rsaKey, claims)},
+		{name: "ES256", token: signJWT(t, "ES256", "ec", ecKey, claims)},
+		{name: "EdDSA", token: signJWT(t, "EdDSA", "ed", edKey, claims)},
+		{name: "NoKid", token: signJWT(t, "RS256", "", rsaKey, claims)},
+		{name: "WrongKey", token: signJWT(t, "RS256", "rsa", otherKey, claims), err: "JWT signature could not be verified"},
+		{name: "UnknownKid", token: signJWT(t, "RS256", "missing", rsaKey, claims), err: "JWT signature could not be verified"},
+		{name: "None", token: b64([]byte(`{"alg":"none"}`)) + "." + b64([]byte(`{"sub":"alice"}`)) + ".", err: "JWT signature could not be verified"},
+		{name: "Expired", token: signJWT(t, "RS256", "rsa", rsaKey, map[string]any{"exp": float64(now.Add(-time.Hour).Unix())}), err: "JWT has expired"},
+		{name: "NoExpiry", token: signJWT(t, "RS256", "rsa", rsaKey, withClaims(map[string]any{"exp": nil})), err: `JWT has no "exp" claim`},
+		{name: "NotYetValid", token: signJWT(t, "RS256", "rsa", rsaKey, withClaims(map[string]any{"nbf": float64(now.Add(time.Hour).Unix())})), err: "JWT is not valid yet"},
+		{name: "Malformed", token: "not-a-jwt", err: "malformed JWT"},
+		{name: "AudienceList", token: signJWT(t, "RS256", "rsa", rsaKey, withClaims(map[string]any{"aud": []string{"other", "ftl"}}))},
+		{name: "WrongAudience", token: signJWT(t, "RS256", "rsa", rsaKey, withClaims(map[string]any{"aud": "other"})), err: `JWT was not issued for "ftl"`},
+		{name: "NoAudience", token: signJWT(t, "RS256", "rsa", rsaKey, withClaims(map[string]any{"aud": nil})), err: `JWT was not issued for "ftl"`},
+		{name: "WrongIssuer", token: signJWT(t, "RS256", "rsa", rsaKey, withClaims(map[string]any{"iss": "https://other.example.com"})), err: `JWT was not issued by "https://idp.example.com"`},
+		{name: "NoIssuer", token: signJWT(t, "RS256", "rsa", rsaKey, withClaims(map[string]any{"iss": nil})), err: `JWT was not issued by "https://idp.example.com"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := verifier.verify(test.token, now)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "alice", actual["sub"])
+		})
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctx := contextWithTestConfig(t)
+	err := cf.SecretsFromContext(ctx).Set(ctx, cf.NewRef("test", APIKeysSecret), map[string]string{"frontend": "s3cr3t"})
+	assert.NoError(t, err)
+	md := &schema.MetadataAuth{Method: schema.AuthMethodAPIKey}
+	authenticator := NewAuthenticator()
+
+	for _, header := range []string{"X-API-Key", "Authorization"} {
+		r, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test", nil)
+		assert.NoError(t, err)
+		value := "s3cr3t"
+		if header == "Authorization" {
+			value = "Bearer " + value
+		}
+		r.Header.Set(header, value)
+		auth, err := authenticator.authenticate(ctx, r, "test", md)
+		assert.NoError(t, err)
+		assert.Equal(t, HTTPAuth{Method: "apikey", Subject: "frontend", Claims: map[string]any{}}, auth)
+	}
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test", nil)
+	assert.NoError(t, err)
+	_, err = authenticator.authenticate(ctx, r, "test", md)
+	assert.IsError(t, err, errUnauthenticated)
+
+	r.Header.Set("X-API-Key", "wrong")
+	_, err = authenticator.authenticate(ctx, r, "test", md)
+	assert.IsError(t, err, errUnauthenticated)
+}
+
+func TestAuthenticateJWT(t *testing.T) {
+	ctx := contextWithTestConfig(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	jwks := func(key *ecdsa.PrivateKey) JWKS {
+		return JWKS{Keys: []JWK{{Kty: "EC", Crv: "P-256", X: b64(key.X.FillBytes(make([]byte, 32))), Y: b64(key.Y.FillBytes(make([]byte, 32)))}}}
+	}
+	cm := cf.ConfigFromContext(ctx)
+	err = cm.Set(ctx, cf.NewRef("test", JWKSConfig), jwks(key))
+	assert.NoError(t, err)
+	md := &schema.MetadataAuth{Method: schema.AuthMethodJWT}
+	clk := clock.NewMock()
+	clk.Set(time.Unix(1700000000, 0))
+	authenticator := newAuthenticator(clk)
+	request := func(key *ecdsa.PrivateKey) *http.Request {
+		r, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test", nil)
+		assert.NoError(t, err)
+		token := signJWT(t, "ES256", "", key, map[string]any{"sub": "alice", "exp": float64(clk.Now().Add(time.Hour).Unix()), "iss": "https://idp.example.com", "aud": "ftl"})
+		r.Header.Set("Authorization", "Bearer "+token)
+		return r
+	}
+
+	// Audience and issuer must be configured.
+	_, err = authenticator.authenticate(ctx, request(key), "test", md)
+	assert.EqualError(t, err, "could not load test.jwtAudience config: not found")
+	assert.NotIsError(t, err, errUnauthenticated)
+	err = cm.Set(ctx, cf.NewRef("test", JWTAudienceConfig), "ftl")
+	assert.NoError(t, err)
+	err = cm.Set(ctx, cf.NewRef("test", JWTIssuerConfig), "https://idp.example.com")
+	assert.NoError(t, err)
+
+	auth, err := authenticator.authenticate(ctx, request(key), "test", md)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", auth.Subject)
+
+	// Rotated keys are picked up once the cached settings expire.
+	err = cm.Set(ctx, cf.NewRef("test", JWKSConfig), jwks(otherKey))
+	assert.NoError(t, err)
+	_, err = authenticator.authenticate(ctx, request(key), "test", md)
+	assert.NoError(t, err)
+	clk.Add(jwtVerifierTTL)
+	_, err = authenticator.authenticate(ctx, request(key), "test", md)
+	assert.IsError(t, err, errUnauthenticated)
+	_, err = authenticator.authenticate(ctx, request(otherKey), "test", md)
+	assert.NoError(t, err)
+}
+
+func TestHandleAuth(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			export verb secret(HttpRequest<Unit>) HttpResponse<String, String>
+				+ingress http GET /secret
+				+auth apikey
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/secret", Module: "test", Verb: "secret"}}
+	ctx := contextWithTestConfig(t)
+	err = cf.SecretsFromContext(ctx).Set(ctx, cf.NewRef("test", APIKeysSecret), map[string]string{"frontend": "s3cr3t"})
+	assert.NoError(t, err)
+
+	for _, test := range []struct {
+		name       string
+		apiKey     string
+		statusCode int
+	}{
+		{name: "Unauthenticated", statusCode: http.StatusUnauthorized},
+		{name: "InvalidKey", apiKey: "wrong", statusCode: http.StatusUnauthorized},
+		{name: "Authenticated", apiKey: "s3cr3t", statusCode: http.StatusOK},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/secret", nil).WithContext(ctx)
+			if test.apiKey != "" {
+				req.Header.Set("X-API-Key", test.apiKey)
+			}
+			var request map[string]any
+			Handler{Routes: routes, Authenticator: NewAuthenticator(), Call: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+				err := json.Unmarshal(r.Msg.Body, &request)
+				assert.NoError(t, err)
+				return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{"body":"ok"}`)}}), nil
+			}}.Handle(sch, model.NewRequestKey(model.OriginIngress, "test"), rec, req)
+			assert.Equal(t, test.statusCode, rec.Code, "%s", rec.Body.Bytes())
+			if test.statusCode != http.StatusOK {
+				assert.Equal(t, `Bearer realm="ftl"`, rec.Header().Get("WWW-Authenticate"))
+				assert.Zero(t, request, "verb should not have been called")
+				return
+			}
+			assert.Equal[any](t, map[string]any{"method": "apikey", "subject": "frontend", "claims": map[string]any{}}, request["auth"])
+		})
+	}
+}
+
+func TestHandleAuthWithoutConfigManager(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			export verb secret(HttpRequest<Unit>) HttpResponse<String, String>
+				+ingress http GET /secret
+				+auth apikey
+
+			export verb token(HttpRequest<Unit>) HttpResponse<String, String>
+				+ingress http GET /token
+				+auth jwt
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{
+		{Path: "/secret", Module: "test", Verb: "secret"},
+		{Path: "/token", Module: "test", Verb: "token"},
+	}
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+
+	for _, path := range []string{"/secret", "/token"} {
+		t.Run(path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, path, nil).WithContext(ctx)
+			req.Header.Set("Authorization", "Bearer s3cr3t")
+			called := false
+			Handler{Routes: routes, Authenticator: NewAuthenticator(), Call: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+				called = true
+				return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{"body":"ok"}`)}}), nil
+			}}.Handle(sch, model.NewRequestKey(model.OriginIngress, "test"), rec, req)
+			assert.Equal(t, http.StatusInternalServerError, rec.Code, "%s", rec.Body.Bytes())
+			assert.False(t, called, "verb should not have been called")
+		})
+	}
+}
+
+// contextWithTestConfig returns a context with empty, writable config and
+// secrets managers.
+func contextWithTestConfig(t *testing.T) context.Context {
+	t.Helper()
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	config := filepath.Join(t.TempDir(), "ftl-project.toml")
+	err := os.WriteFile(config, nil, 0600)
+	assert.NoError(t, err)
+	cm, err := cf.New(ctx, cf.ProjectConfigResolver[cf.Configuration]{Config: []string{config}}, []cf.Provider[cf.Configuration]{cf.InlineProvider[cf.Configuration]{Inline: true}})
+	assert.NoError(t, err)
+	sm, err := cf.New(ctx, cf.ProjectConfigResolver[cf.Secrets]{Config: []string{config}}, []cf.Provider[cf.Secrets]{cf.InlineProvider[cf.Secrets]{Inline: true}})
+	assert.NoError(t, err)
+	return cf.ContextWithSecrets(cf.ContextWithConfig(ctx, cm), sm)
+}
+
+func signJWT(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]any) string {
+	t.Helper()
+	header := map[string]string{"alg": alg, "typ": "JWT"}
+	if kid != "" {
+		header["kid"] = kid
+	}
+	headerJSON, err := json.Marshal(header)
+	assert.NoError(t, err)
+	claimsJSON, err := json.Marshal(claims)
+	assert.NoError(t, err)
+	signed := b64(headerJSON) + "." + b64(claimsJSON)
+
+	var signature []byte
+	switch key := key.(type) {
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, []byte(signed))
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signed))
+		if alg == "PS256" {
+			signature, err = rsa.SignPSS(rand.Reader, key, crypto.SHA256, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		}
+		assert.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signed))
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		assert.NoError(t, err)
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	default:
+		t.Fatalf("unsupported key type %T", key)
+	}
+	return signed + "." + b64(signature)
+}
+
+func b64(data []byte) string { return base64.RawURLEncoding.EncodeToString(data) }
@@ -0,0 +1,231 @@
+package ingress
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// ResponseCache is an in-memory cache of the responses of GET HTTP ingress
+// verbs with "+cache" metadata.
+//
+// Responses are keyed by verb, path and query. Responses with a "Vary" header,
+// or that the verb marks as "no-store" or "private", are not cached. The least
+// recently used responses are evicted when the total size of cached responses
+// exceeds the size of the cache.
+type ResponseCache struct {
+	clock   clock.Clock
+	maxSize int
+
+	lock    sync.Mutex
+	size    int
+	lru     *list.List // Of *cacheEntry, most recently used first.
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key     string
+	module  string
+	header  http.Header
+	body    []byte
+	etag    string
+	created time.Time
+	expires time.Time
+}
+
+func (e *cacheEntry) size() int { return len(e.key) + len(e.body) }
+
+// NewResponseCache creates a cache holding up to [maxSize] bytes of responses.
+func NewResponseCache(maxSize int) *ResponseCache {
+	return newResponseCache(maxSize, clock.New())
+}
+
+func newResponseCache(maxSize int, clock clock.Clock) *ResponseCache {
+	return &ResponseCache{
+		clock:   clock,
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+// InvalidateModule removes all cached responses of a module's verbs.
+func (c *ResponseCache) InvalidateModule(module string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, el := range c.entries {
+		if el.Value.(*cacheEntry).module == module { //nolint:forcetypeassert
+			c.remove(el)
+		}
+	}
+}
+
+// serve writes the cached response to a request if there is a fresh one.
+// Otherwise it calls [handle] and caches the response if it was successful.
+func (c *ResponseCache) serve(w http.ResponseWriter, r *http.Request, route *dal.IngressRoute, ttl time.Duration, handle func(w http.ResponseWriter)) {
+	key := fmt.Sprintf("%s.%s %s?%s", route.Module, route.Verb, r.URL.Path, r.URL.Query().Encode())
+	entry, ok := c.get(key)
+	if !ok {
+		response := &bufferedResponse{header: http.Header{}}
+		handle(response)
+		if !response.cacheable() {
+			response.writeTo(w)
+			return
+		}
+		now := c.clock.Now()
+		entry = &cacheEntry{
+			key:     key,
+			module:  route.Module,
+			header:  response.header,
+			body:    response.body.Bytes(),
+			etag:    response.header.Get("ETag"),
+			created: now,
+			expires: now.Add(ttl),
+		}
+		if entry.etag == "" {
+			entry.etag = strconv.Quote(sha256.Sum(entry.body).String())
+		}
+		c.put(entry)
+	}
+	c.write(w, r, entry)
+}
+
+// write writes a cached response, or 304 Not Modified if the client has it.
+func (c *ResponseCache) write(w http.ResponseWriter, r *http.Request, entry *cacheEntry) {
+	now := c.clock.Now()
+	for k, v := range entry.header {
+		w.Header()[k] = v
+	}
+	w.Header().Set("ETag", entry.etag)
+	if entry.header.Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(entry.expires.Sub(now).Seconds())))
+	}
+	w.Header().Set("Age", strconv.Itoa(int(now.Sub(entry.created).Seconds())))
+	if etagMatches(r.Header.Get("If-None-Match"), entry.etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(entry.body) //nolint:errcheck
+}
+
+func (c *ResponseCache) get(key string) (*cacheEntry, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*cacheEntry) //nolint:forcetypeassert
+	if !c.clock.Now().Before(entry.expires) {
+		c.remove(el)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return entry, true
+}
+
+func (c *ResponseCache) put(entry *cacheEntry) {
+	if entry.size() > c.maxSize {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if el, ok := c.entries[entry.key]; ok {
+		c.remove(el)
+	}
+	for c.size+entry.size() > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	c.size += entry.size()
+}
+
+// remove must be called with the lock held.
+func (c *ResponseCache) remove(el *list.Element) {
+	entry := c.lru.Remove(el).(*cacheEntry) //nolint:forcetypeassert
+	delete(c.entries, entry.key)
+	c.size -= entry.size()
+}
+
+// etagMatches returns true if an If-None-Match header matches an ETag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		// If-None-Match uses the weak comparison.
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponse holds a response so it can be cached before being written.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+var _ http.ResponseWriter = (*bufferedResponse)(nil)
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if b.status == 0 {
+		b.status = status
+	}
+}
+
+func (b *bufferedResponse) Write(data []byte) (int, error) {
+	b.WriteHeader(http.StatusOK)
+	return b.body.Write(data)
+}
+
+// cacheable returns true if the response can be shared between clients.
+func (b *bufferedResponse) cacheable() bool {
+	if b.status != http.StatusOK {
+		return false
+	}
+	// Responses setting cookies are specific to the client.
+	if b.header.Get("Set-Cookie") != "" {
+		return false
+	}
+	// Responses are keyed by path and query only, so responses that vary by
+	// request header can't be told apart.
+	if len(b.header.Values("Vary")) > 0 {
+		return false
+	}
+	for _, value := range b.header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			directive, _, _ = strings.Cut(strings.TrimSpace(directive), "=")
+			if strings.EqualFold(directive, "no-store") || strings.EqualFold(directive, "private") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (b *bufferedResponse) writeTo(w http.ResponseWriter) {
+	for k, v := range b.header {
+		w.Header()[k] = v
+	}
+	if b.status != 0 {
+		w.WriteHeader(b.status)
+	}
+	_, _ = w.Write(b.body.Bytes()) //nolint:errcheck
+}
@@ -0,0 +1,135 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+	"github.com/benbjohnson/clock"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestHandleCache(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data Item {
+				id Int
+			}
+
+			export verb item(HttpRequest<test.Item>) HttpResponse<String, String>
+				+ingress http GET /items/{id}
+				+cache 5m
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/items/{id}", Module: "test", Verb: "item"}}
+	ctx := contextWithTestConfig(t)
+	clk := clock.NewMock()
+	cache := newResponseCache(1024, clk)
+
+	calls := 0
+	status := http.StatusOK
+	responseHeaders := `{}`
+	get := func(path string, headers ...string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil).WithContext(ctx)
+		for i := 0; i < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		Handler{Routes: routes, Authenticator: NewAuthenticator(), Cache: cache, Call: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+			calls++
+			body := fmt.Sprintf(`{"status":%d,"headers":%s,"body":"response %d"}`, status, responseHeaders, calls)
+			return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(body)}}), nil
+		}}.Handle(sch, model.NewRequestKey(model.OriginIngress, "test"), rec, req)
+		return rec
+	}
+
+	rec := get("/items/1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "response 1", rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	assert.NotZero(t, etag)
+	assert.Equal(t, "public, max-age=300", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "0", rec.Header().Get("Age"))
+
+	clk.Add(time.Minute)
+	rec = get("/items/1")
+	assert.Equal(t, "response 1", rec.Body.String())
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+	assert.Equal(t, "public, max-age=240", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "60", rec.Header().Get("Age"))
+	assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, 1, calls)
+
+	rec = get("/items/1", "If-None-Match", `"other", W/`+etag)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Equal(t, "", rec.Body.String())
+	assert.Equal(t, 1, calls)
+
+	rec = get("/items/1?lang=en")
+	assert.Equal(t, "response 2", rec.Body.String())
+	rec = get("/items/2")
+	assert.Equal(t, "response 3", rec.Body.String())
+
+	clk.Add(5 * time.Minute)
+	rec = get("/items/1")
+	assert.Equal(t, "response 4", rec.Body.String())
+
+	cache.InvalidateModule("test")
+	rec = get("/items/1")
+	assert.Equal(t, "response 5", rec.Body.String())
+	assert.Equal(t, 5, calls)
+
+	status = http.StatusNotFound
+	rec = get("/items/3")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "", rec.Header().Get("ETag"))
+	rec = get("/items/3")
+	assert.Equal(t, 7, calls, "errors should not be cached")
+
+	status = http.StatusOK
+	for _, h := range []string{
+		`{"Cache-Control":["no-store"]}`,
+		`{"Cache-Control":["private, max-age=60"]}`,
+		`{"Vary":["Accept-Language"]}`,
+	} {
+		responseHeaders = h
+		before := calls
+		get("/items/4")
+		get("/items/4")
+		assert.Equal(t, before+2, calls, "%s should not be cached", h)
+	}
+}
+
+func TestResponseCacheEviction(t *testing.T) {
+	clk := clock.NewMock()
+	cache := newResponseCache(100, clk)
+	entry := func(key string, size int) *cacheEntry {
+		return &cacheEntry{key: key, module: "test", body: make([]byte, size-len(key)), expires: clk.Now().Add(time.Minute)}
+	}
+	cache.put(entry("a", 40))
+	cache.put(entry("b", 40))
+	_, ok := cache.get("a")
+	assert.True(t, ok)
+	cache.put(entry("c", 40))
+	_, ok = cache.get("b")
+	assert.False(t, ok, "least recently used entry should have been evicted")
+	_, ok = cache.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 80, cache.size)
+
+	cache.put(entry("d", 101))
+	_, ok = cache.get("d")
+	assert.False(t, ok, "entries larger than the cache should not be cached")
+}
@@ -0,0 +1,210 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// CORSConfig is the name of the module config holding the CORS policy of the
+// module's HTTP ingress verbs, as a JSON encoded [CORSPolicy]. Verbs with a
+// "+cors" policy of their own ignore it.
+const CORSConfig = "ingressCORS"
+
+// defaultCORSHeaders are the request headers allowed by policies that do not
+// list their own.
+var defaultCORSHeaders = []string{"Accept", "Accept-Language", "Content-Language", "Content-Type", "X-Requested-With"}
+
+// CORSPolicy is the CORS policy of an ingress route.
+//
+// It mirrors [schema.MetadataCORS], see there for the defaults of each field.
+type CORSPolicy struct {
+	AllowOrigins     []string `json:"allowOrigins"`
+	AllowMethods     []string `json:"allowMethods,omitempty"`
+	AllowHeaders     []string `json:"allowHeaders,omitempty"`
+	AllowCredentials bool     `json:"allowCredentials,omitempty"`
+	MaxAge           int      `json:"maxAge,omitempty"`
+}
+
+// PreflightMethod returns the method requested by a CORS preflight request,
+// or false if [r] is not a preflight request.
+//
+// Preflight requests are answered from the routes of the requested method.
+func PreflightMethod(r *http.Request) (string, bool) {
+	method := r.Header.Get("Access-Control-Request-Method")
+	if r.Method != http.MethodOptions || method == "" || r.Header.Get("Origin") == "" {
+		return "", false
+	}
+	return method, true
+}
+
+// corsPolicy returns the CORS policy of a verb.
+//
+// This is the verb's "+cors" metadata if present, otherwise the module's
+// [CORSConfig], otherwise [allowOrigins] if not empty.
+func corsPolicy(ctx context.Context, module string, verb *schema.Verb, allowOrigins []string) optional.Option[CORSPolicy] {
+	if md, ok := verb.GetMetadataCORS().Get(); ok {
+		return optional.Some(CORSPolicy{
+			AllowOrigins:     md.AllowOrigins,
+			AllowMethods:     md.AllowMethods,
+			AllowHeaders:     md.AllowHeaders,
+			AllowCredentials: md.AllowCredentials,
+			MaxAge:           md.MaxAge,
+		})
+	}
+	var policy CORSPolicy
+	err := getModuleConfig(ctx, module, CORSConfig, &policy)
+	if err == nil {
+		err = policy.validate()
+	}
+	if err == nil {
+		return optional.Some(policy)
+	} else if !errors.Is(err, cf.ErrNotFound) {
+		log.FromContext(ctx).Warnf("Could not load %s.%s config, ignoring: %s", module, CORSConfig, err)
+	}
+	if len(allowOrigins) > 0 {
+		return optional.Some(CORSPolicy{AllowOrigins: allowOrigins})
+	}
+	return optional.None[CORSPolicy]()
+}
+
+// validate checks a policy loaded from [CORSConfig], applying the same rules
+// as "+cors".
+func (p CORSPolicy) validate() error {
+	if p.AllowCredentials && slices.Contains(p.AllowOrigins, "*") {
+		return errors.New(`CORS credentials can not be allowed for "*"`)
+	}
+	return nil
+}
+
+// allowOrigin returns the value of the Access-Control-Allow-Origin header for
+// a request from [origin], or false if the origin is not allowed.
+//
+// "*" never matches if credentials are allowed, as that would allow any site
+// to make credentialed requests.
+func (p CORSPolicy) allowOrigin(origin string) (string, bool) {
+	for _, allowed := range p.AllowOrigins {
+		switch {
+		case allowed == "*":
+			if p.AllowCredentials {
+				continue
+			}
+			return "*", true
+
+		case strings.EqualFold(allowed, origin):
+			return origin, true
+
+		case strings.Contains(allowed, "://*."):
+			prefix, suffix, _ := strings.Cut(allowed, "*")
+			if len(origin) > len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(strings.ToLower(origin), strings.ToLower(suffix)) {
+				return origin, true
+			}
+		}
+	}
+	return "", false
+}
+
+// allowHeaders returns the request headers of a preflight request that are
+// allowed, or false if any of them are not.
+func (p CORSPolicy) allowHeaders(requested []string) ([]string, bool) {
+	allowed := p.AllowHeaders
+	if len(allowed) == 0 {
+		allowed = defaultCORSHeaders
+	}
+	for _, header := range requested {
+		if !containsFold(allowed, header) && !containsFold(allowed, "*") {
+			return nil, false
+		}
+	}
+	return requested, true
+}
+
+// writeCORSHeaders adds the CORS headers for a request to a verb with the
+// given policy to the response.
+func writeCORSHeaders(w http.ResponseWriter, r *http.Request, policy CORSPolicy) {
+	w.Header().Add("Vary", "Origin")
+	allowOrigin, ok := policy.allowOrigin(r.Header.Get("Origin"))
+	if !ok {
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", allowOrigin)
+	if policy.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+// writePreflight answers a CORS preflight request for a route.
+//
+// Requests that are not allowed by the policy are answered without CORS
+// headers, which the browser treats as a rejection.
+func writePreflight(w http.ResponseWriter, r *http.Request, route string, policy optional.Option[CORSPolicy]) {
+	logger := log.FromContext(r.Context())
+	header := w.Header()
+	header.Add("Vary", "Origin")
+	header.Add("Vary", "Access-Control-Request-Method")
+	header.Add("Vary", "Access-Control-Request-Headers")
+	defer w.WriteHeader(http.StatusNoContent)
+
+	p, ok := policy.Get()
+	if !ok {
+		logger.Debugf("Rejected preflight request to %s: no CORS policy", route)
+		return
+	}
+	allowOrigin, ok := p.allowOrigin(r.Header.Get("Origin"))
+	if !ok {
+		logger.Debugf("Rejected preflight request to %s: origin %q is not allowed", route, r.Header.Get("Origin"))
+		return
+	}
+	method := r.Header.Get("Access-Control-Request-Method")
+	methods := p.AllowMethods
+	if len(methods) == 0 {
+		methods = []string{method}
+	}
+	if !containsFold(methods, method) {
+		logger.Debugf("Rejected preflight request to %s: method %s is not allowed", route, method)
+		return
+	}
+	var requested []string
+	for _, value := range r.Header.Values("Access-Control-Request-Headers") {
+		for _, h := range strings.Split(value, ",") {
+			if h = strings.TrimSpace(h); h != "" {
+				requested = append(requested, http.CanonicalHeaderKey(h))
+			}
+		}
+	}
+	headers, ok := p.allowHeaders(requested)
+	if !ok {
+		logger.Debugf("Rejected preflight request to %s: headers %s are not allowed", route, strings.Join(requested, ", "))
+		return
+	}
+
+	header.Set("Access-Control-Allow-Origin", allowOrigin)
+	header.Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+	if len(headers) > 0 {
+		header.Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+	}
+	if p.AllowCredentials {
+		header.Set("Access-Control-Allow-Credentials", "true")
+	}
+	if p.MaxAge > 0 {
+		header.Set("Access-Control-Max-Age", strconv.Itoa(p.MaxAge))
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,219 @@
+package ingress
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestHandleCORS(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			export verb admin(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+				+ingress http POST /admin
+				+cors "https://admin.example.com", "https://*.internal.example.com" methods POST, PUT headers "Authorization" credentials maxAge 600
+
+			export verb public(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+				+ingress http GET /public
+		}
+
+		module legacy {
+			export verb legacy(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+				+ingress http GET /legacy
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{
+		{Path: "/admin", Module: "test", Verb: "admin"},
+		{Path: "/public", Module: "test", Verb: "public"},
+		{Path: "/legacy", Module: "legacy", Verb: "legacy"},
+	}
+	ctx := contextWithTestConfig(t)
+	err = cf.ConfigFromContext(ctx).Set(ctx, cf.NewRef("test", CORSConfig), CORSPolicy{AllowOrigins: []string{"*"}})
+	assert.NoError(t, err)
+
+	for _, test := range []struct {
+		name           string
+		method         string
+		path           string
+		headers        map[string]string
+		statusCode     int
+		expected       map[string]string
+		expectedCalled bool
+	}{
+		{name: "Preflight",
+			method: http.MethodOptions,
+			path:   "/admin",
+			headers: map[string]string{
+				"Origin":                         "https://admin.example.com",
+				"Access-Control-Request-Method":  "POST",
+				"Access-Control-Request-Headers": "authorization",
+			},
+			statusCode: http.StatusNoContent,
+			expected: map[string]string{
+				"Access-Control-Allow-Origin":      "https://admin.example.com",
+				"Access-Control-Allow-Methods":     "POST, PUT",
+				"Access-Control-Allow-Headers":     "Authorization",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Max-Age":           "600",
+			}},
+		{name: "PreflightWildcardOrigin",
+			method: http.MethodOptions,
+			path:   "/admin",
+			headers: map[string]string{
+				"Origin":                        "https://ops.internal.example.com",
+				"Access-Control-Request-Method": "POST",
+			},
+			statusCode: http.StatusNoContent,
+			expected: map[string]string{
+				"Access-Control-Allow-Origin": "https://ops.internal.example.com",
+			}},
+		{name: "PreflightOriginNotAllowed",
+			method: http.MethodOptions,
+			path:   "/admin",
+			headers: map[string]string{
+				"Origin":                        "https://example.com",
+				"Access-Control-Request-Method": "POST",
+			},
+			statusCode: http.StatusNoContent,
+			expected:   map[string]string{"Access-Control-Allow-Origin": ""}},
+		{name: "PreflightHeaderNotAllowed",
+			method: http.MethodOptions,
+			path:   "/admin",
+			headers: map[string]string{
+				"Origin":                         "https://admin.example.com",
+				"Access-Control-Request-Method":  "POST",
+				"Access-Control-Request-Headers": "X-Custom",
+			},
+			statusCode: http.StatusNoContent,
+			expected:   map[string]string{"Access-Control-Allow-Origin": ""}},
+		{name: "PreflightModuleConfig",
+			method: http.MethodOptions,
+			path:   "/public",
+			headers: map[string]string{
+				"Origin":                         "https://example.com",
+				"Access-Control-Request-Method":  "GET",
+				"Access-Control-Request-Headers": "Content-Type",
+			},
+			statusCode: http.StatusNoContent,
+			expected: map[string]string{
+				"Access-Control-Allow-Origin":      "*",
+				"Access-Control-Allow-Methods":     "GET",
+				"Access-Control-Allow-Headers":     "Content-Type",
+				"Access-Control-Allow-Credentials": "",
+			}},
+		{name: "PreflightNoRoute",
+			method: http.MethodOptions,
+			path:   "/missing",
+			headers: map[string]string{
+				"Origin":                        "https://example.com",
+				"Access-Control-Request-Method": "GET",
+			},
+			statusCode: http.StatusNotFound},
+		{name: "Request",
+			method:         http.MethodPost,
+			path:           "/admin",
+			headers:        map[string]string{"Origin": "https://admin.example.com"},
+			statusCode:     http.StatusOK,
+			expectedCalled: true,
+			expected: map[string]string{
+				"Access-Control-Allow-Origin":      "https://admin.example.com",
+				"Access-Control-Allow-Credentials": "true",
+				"Vary":                             "Origin",
+			}},
+		{name: "RequestOriginNotAllowed",
+			method:         http.MethodPost,
+			path:           "/admin",
+			headers:        map[string]string{"Origin": "https://example.com"},
+			statusCode:     http.StatusOK,
+			expectedCalled: true,
+			expected:       map[string]string{"Access-Control-Allow-Origin": ""}},
+		{name: "RequestDefaultOrigins",
+			method:         http.MethodGet,
+			path:           "/legacy",
+			headers:        map[string]string{"Origin": "http://localhost:8080"},
+			statusCode:     http.StatusOK,
+			expectedCalled: true,
+			expected:       map[string]string{"Access-Control-Allow-Origin": "http://localhost:8080"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(`{}`)).WithContext(ctx)
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+			called := false
+			Handler{Routes: routes, AllowOrigins: []string{"http://localhost:8080"}, Authenticator: NewAuthenticator(), Call: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+				called = true
+				return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{"body":{}}`)}}), nil
+			}}.Handle(sch, model.NewRequestKey(model.OriginIngress, "test"), rec, req)
+			assert.Equal(t, test.statusCode, rec.Code, "%s", rec.Body.Bytes())
+			assert.Equal(t, test.expectedCalled, called)
+			for k, v := range test.expected {
+				assert.Equal(t, v, rec.Header().Get(k), "%s", k)
+			}
+		})
+	}
+}
+
+func TestHandleCORSWithoutConfigManager(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			export verb public(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+				+ingress http GET /public
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/public", Module: "test", Verb: "public"}}
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/public", nil).WithContext(ctx)
+	req.Header.Set("Origin", "https://example.com")
+	Handler{Routes: routes, Authenticator: NewAuthenticator(), Call: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{"body":{}}`)}}), nil
+	}}.Handle(sch, model.NewRequestKey(model.OriginIngress, "test"), rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code, "%s", rec.Body.Bytes())
+	assert.Equal(t, "", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestHandleCORSWildcardWithCredentials(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			export verb public(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+				+ingress http GET /public
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/public", Module: "test", Verb: "public"}}
+	ctx := contextWithTestConfig(t)
+	err = cf.ConfigFromContext(ctx).Set(ctx, cf.NewRef("test", CORSConfig), CORSPolicy{AllowOrigins: []string{"*"}, AllowCredentials: true})
+	assert.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/public", nil).WithContext(ctx)
+	req.Header.Set("Origin", "https://evil.example.com")
+	Handler{Routes: routes, Authenticator: NewAuthenticator(), Call: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{"body":{}}`)}}), nil
+	}}.Handle(sch, model.NewRequestKey(model.OriginIngress, "test"), rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code, "%s", rec.Body.Bytes())
+	assert.Equal(t, "", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "", rec.Header().Get("Access-Control-Allow-Credentials"))
+
+	// "*" never matches a credentialed policy, however it was built.
+	_, ok := CORSPolicy{AllowOrigins: []string{"*"}, AllowCredentials: true}.allowOrigin("https://evil.example.com")
+	assert.False(t, ok)
+}
@@ -0,0 +1,196 @@
+package ingress
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// multipartMaxMemory is the maximum number of bytes of a multipart body held
+// in memory, the remainder is stored in temporary files while decoding.
+const multipartMaxMemory = 32 << 20
+
+const (
+	formContentType      = "application/x-www-form-urlencoded"
+	multipartContentType = "multipart/form-data"
+)
+
+// isFormRequest returns true if the request body is form-encoded or multipart.
+func isFormRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && (mediaType == formContentType || mediaType == multipartContentType)
+}
+
+// parseFormBody decodes a form-encoded or multipart request body into a map
+// keyed by the fields of [data].
+//
+// Values are matched to fields by their JSON alias or name, and form values
+// that do not correspond to a field are ignored. Scalar values are converted
+// to the type of their field where possible, otherwise they are left as
+// strings to be rejected by validation. File parts may be decoded into Bytes
+// or builtin.File fields, or arrays of either.
+func parseFormBody(r *http.Request, data *schema.Data) (map[string]any, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Content-Type: %w", err)
+	}
+	var files map[string][]*multipart.FileHeader
+	switch mediaType {
+	case formContentType:
+		if err := r.ParseForm(); err != nil {
+			return nil, fmt.Errorf("HTTP request body is not a valid form: %w", err)
+		}
+
+	case multipartContentType:
+		if err := r.ParseMultipartForm(multipartMaxMemory); err != nil {
+			return nil, fmt.Errorf("HTTP request body is not a valid multipart form: %w", err)
+		}
+		defer r.MultipartForm.RemoveAll() //nolint:errcheck
+		files = r.MultipartForm.File
+
+	default:
+		return nil, fmt.Errorf("unsupported Content-Type %q", mediaType)
+	}
+
+	bodyMap := map[string]any{}
+	for key, values := range r.PostForm {
+		field := formField(data, key)
+		if field == nil {
+			continue
+		}
+		value, err := formValue(field, values)
+		if err != nil {
+			return nil, err
+		}
+		bodyMap[key] = value
+	}
+	for key, headers := range files {
+		field := formField(data, key)
+		if field == nil {
+			continue
+		}
+		value, err := formFiles(field, headers)
+		if err != nil {
+			return nil, err
+		}
+		bodyMap[key] = value
+	}
+	return bodyMap, nil
+}
+
+// formField returns the field of [data] with the given JSON alias or name.
+func formField(data *schema.Data, key string) *schema.Field {
+	for _, field := range data.Fields {
+		if alias, ok := field.Alias(schema.AliasKindJSON).Get(); (ok && alias == key) || field.Name == key {
+			return field
+		}
+	}
+	return nil
+}
+
+func formValue(field *schema.Field, values []string) (any, error) {
+	fieldType := unwrapOptional(field.Type)
+	if array, ok := fieldType.(*schema.Array); ok {
+		out := make([]any, len(values))
+		for i, value := range values {
+			out[i] = formScalar(array.Element, value)
+		}
+		return out, nil
+	}
+	if len(values) > 1 {
+		return nil, fmt.Errorf("multiple values for %q are not supported", field.Name)
+	}
+	switch fieldType.(type) {
+	case *schema.Int, *schema.Float, *schema.Bool, *schema.String, *schema.Time, *schema.Bytes, *schema.Any:
+		return formScalar(fieldType, values[0]), nil
+
+	default:
+		return nil, fmt.Errorf("field %q of type %s cannot be decoded from a form value", field.Name, field.Type)
+	}
+}
+
+func formScalar(t schema.Type, value string) any {
+	switch unwrapOptional(t).(type) {
+	case *schema.Int:
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	case *schema.Float:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case *schema.Bool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case *schema.Bytes:
+		return []byte(value)
+	}
+	return value
+}
+
+func formFiles(field *schema.Field, headers []*multipart.FileHeader) (any, error) {
+	fieldType := unwrapOptional(field.Type)
+	if array, ok := fieldType.(*schema.Array); ok {
+		out := make([]any, len(headers))
+		for i, header := range headers {
+			file, err := formFile(field, array.Element, header)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = file
+		}
+		return out, nil
+	}
+	if len(headers) > 1 {
+		return nil, fmt.Errorf("multiple files for %q are not supported", field.Name)
+	}
+	return formFile(field, fieldType, headers[0])
+}
+
+// formFile reads a file part as either Bytes or a builtin.File.
+func formFile(field *schema.Field, t schema.Type, header *multipart.FileHeader) (any, error) {
+	t = unwrapOptional(t)
+	isFile := isBuiltinFile(t)
+	if _, isBytes := t.(*schema.Bytes); !isBytes && !isFile {
+		return nil, fmt.Errorf("field %q of type %s cannot be decoded from a file, expected Bytes or builtin.File", field.Name, field.Type)
+	}
+	f, err := header.Open()
+	if err != nil {
+		return nil, fmt.Errorf("could not open file %q: %w", header.Filename, err)
+	}
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file %q: %w", header.Filename, err)
+	}
+	if !isFile {
+		return content, nil
+	}
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return map[string]any{
+		"filename":    header.Filename,
+		"contentType": contentType,
+		"content":     content,
+	}, nil
+}
+
+func isBuiltinFile(t schema.Type) bool {
+	ref, ok := t.(*schema.Ref)
+	return ok && ref.Module == "builtin" && ref.Name == "File"
+}
+
+func unwrapOptional(t schema.Type) schema.Type {
+	if opt, ok := t.(*schema.Optional); ok {
+		return opt.Type
+	}
+	return t
+}
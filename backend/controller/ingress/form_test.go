@@ -0,0 +1,137 @@
+package ingress
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestBuildRequestBodyForm(t *testing.T) {
+	sch, err := schema.ParseString("test", `
+		module test {
+			data Upload {
+				id String
+				title String
+				count Int
+				public Bool?
+				tags [String]
+				avatar Bytes?
+				attachments [builtin.File]?
+				callbackUrl String? +alias json "callback_url"
+			}
+
+			export verb upload(HttpRequest<test.Upload>) HttpResponse<Empty, Empty>
+				+ingress http POST /upload/{id}
+		}
+	`)
+	assert.NoError(t, err)
+	route := &dal.IngressRoute{Path: "/upload/{id}", Module: "test", Verb: "upload"}
+
+	t.Run("URLEncoded", func(t *testing.T) {
+		form := url.Values{
+			"title":        {"Hello"},
+			"count":        {"3"},
+			"public":       {"true"},
+			"tags":         {"a", "b"},
+			"callback_url": {"http://example.com"},
+			"unknown":      {"ignored"},
+		}
+		r, err := http.NewRequest(http.MethodPost, "http://127.0.0.1/upload/123", strings.NewReader(form.Encode())) //nolint:noctx
+		assert.NoError(t, err)
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		body := buildFormRequestBody(t, route, r, sch)
+		assert.Equal[any](t, map[string]any{
+			"id":          "123",
+			"title":       "Hello",
+			"count":       float64(3),
+			"public":      true,
+			"tags":        []any{"a", "b"},
+			"callbackUrl": "http://example.com",
+		}, body)
+	})
+
+	t.Run("Multipart", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w := multipart.NewWriter(buf)
+		assert.NoError(t, w.WriteField("title", "Hello"))
+		assert.NoError(t, w.WriteField("count", "3"))
+		part, err := w.CreateFormFile("avatar", "avatar.png")
+		assert.NoError(t, err)
+		_, err = part.Write([]byte("avatar"))
+		assert.NoError(t, err)
+		part, err = w.CreatePart(textproto.MIMEHeader{
+			"Content-Disposition": {`form-data; name="attachments"; filename="notes.txt"`},
+			"Content-Type":        {"text/plain"},
+		})
+		assert.NoError(t, err)
+		_, err = part.Write([]byte("notes"))
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+
+		r, err := http.NewRequest(http.MethodPost, "http://127.0.0.1/upload/123", buf) //nolint:noctx
+		assert.NoError(t, err)
+		r.Header.Set("Content-Type", w.FormDataContentType())
+		body := buildFormRequestBody(t, route, r, sch)
+		assert.Equal[any](t, map[string]any{
+			"id":     "123",
+			"title":  "Hello",
+			"count":  float64(3),
+			"avatar": "YXZhdGFy",
+			"attachments": []any{map[string]any{
+				"filename":    "notes.txt",
+				"contentType": "text/plain",
+				"content":     "bm90ZXM=",
+			}},
+		}, body)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		form := url.Values{"count": {"many"}}
+		r, err := http.NewRequest(http.MethodPost, "http://127.0.0.1/upload/123", strings.NewReader(form.Encode())) //nolint:noctx
+		assert.NoError(t, err)
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+		_, err = BuildRequestBody(route, r, sch, optional.None[HTTPAuth]())
+		assert.EqualError(t, err, "builtin.HttpRequest<test.Upload>.body.title is required\n"+
+			"builtin.HttpRequest<test.Upload>.body.count has wrong type, expected Int found string")
+		assert.Equal(t, []ProblemField{
+			{Path: "body.title", Detail: "builtin.HttpRequest<test.Upload>.body.title is required"},
+			{Path: "body.count", Detail: "builtin.HttpRequest<test.Upload>.body.count has wrong type, expected Int found string"},
+		}, invalidFields(err))
+	})
+
+	t.Run("FileForNonBytesField", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w := multipart.NewWriter(buf)
+		part, err := w.CreateFormFile("title", "title.txt")
+		assert.NoError(t, err)
+		_, err = part.Write([]byte("title"))
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+		r, err := http.NewRequest(http.MethodPost, "http://127.0.0.1/upload/123", buf) //nolint:noctx
+		assert.NoError(t, err)
+		r.Header.Set("Content-Type", w.FormDataContentType())
+		_, err = BuildRequestBody(route, r, sch, optional.None[HTTPAuth]())
+		assert.EqualError(t, err, `field "title" of type String cannot be decoded from a file, expected Bytes or builtin.File`)
+	})
+}
+
+func buildFormRequestBody(t *testing.T, route *dal.IngressRoute, r *http.Request, sch *schema.Schema) any {
+	t.Helper()
+	requestBody, err := BuildRequestBody(route, r, sch, optional.None[HTTPAuth]())
+	assert.NoError(t, err)
+	var request map[string]any
+	err = json.Unmarshal(requestBody, &request)
+	assert.NoError(t, err)
+	return request["body"]
+}
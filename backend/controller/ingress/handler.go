@@ -17,30 +17,130 @@ import (
 	"github.com/TBD54566975/ftl/internal/model"
 )
 
-// Handle HTTP ingress routes.
-func Handle(
-	sch *schema.Schema,
-	requestKey model.RequestKey,
-	routes []dal.IngressRoute,
-	w http.ResponseWriter,
-	r *http.Request,
-	call func(context.Context, *connect.Request[ftlv1.CallRequest], optional.Option[model.RequestKey], string) (*connect.Response[ftlv1.CallResponse], error),
-) {
+// CallFunc calls a verb on behalf of an ingress request.
+type CallFunc func(ctx context.Context, req *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error)
+
+// CallStreamFunc calls a streaming verb on behalf of an ingress request,
+// passing each response to [send].
+type CallStreamFunc func(ctx context.Context, req *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string, send func(*ftlv1.CallResponse) error) error
+
+// Handler handles HTTP ingress requests.
+type Handler struct {
+	// Routes of the request's method.
+	//
+	// CORS preflight requests are answered from the routes of the method
+	// returned by [PreflightMethod].
+	Routes []dal.IngressRoute
+	// AllowOrigins are allowed by verbs without a CORS policy of their own or
+	// in their module's config.
+	AllowOrigins []string
+	// Authenticator authenticates requests to verbs with "+auth" metadata.
+	Authenticator *Authenticator
+	// Cache serves the responses of verbs with "+cache" metadata, if not nil.
+	Cache *ResponseCache
+	// Call calls verbs.
+	Call CallFunc
+	// CallStream calls streaming verbs.
+	CallStream CallStreamFunc
+}
+
+// Handle an HTTP ingress request.
+//
+// Errors that occur outside of the verb are returned in the module's
+// configured [ErrorFormat]. Streaming verbs are called with
+// [Handler.CallStream], and their responses are written as they are received.
+func (h Handler) Handle(sch *schema.Schema, requestKey model.RequestKey, w http.ResponseWriter, r *http.Request) {
 	logger := log.FromContext(r.Context())
 	logger.Debugf("%s %s", r.Method, r.URL.Path)
-	route, err := GetIngressRoute(routes, r.Method, r.URL.Path)
+	route, err := GetIngressRoute(h.Routes, r.Method, r.Host, r.URL.Path)
 	if err != nil {
 		if errors.Is(err, dal.ErrNotFound) {
-			http.NotFound(w, r)
+			writeProblem(w, ErrorFormatProblem, newProblem(r, requestKey, http.StatusNotFound, ProblemTypeNotFound, errors.New("no route matches the request")))
 			return
 		}
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeProblem(w, ErrorFormatProblem, newProblem(r, requestKey, http.StatusInternalServerError, ProblemTypeInternal, err))
 		return
 	}
 
-	body, err := BuildRequestBody(route, r, sch)
+	fail := func(status int, problemType string, err error) {
+		writeProblem(w, errorFormat(r.Context(), route.Module), newProblem(r, requestKey, status, problemType, err))
+	}
+
+	verb := &schema.Verb{}
+	err = sch.ResolveRefToType(&schema.Ref{Name: route.Verb, Module: route.Module}, verb)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		fail(http.StatusInternalServerError, ProblemTypeInternal, err)
+		return
+	}
+
+	if _, ok := PreflightMethod(r); ok {
+		writePreflight(w, r, route.Module+"."+route.Verb, corsPolicy(r.Context(), route.Module, verb, h.AllowOrigins))
+		return
+	}
+	if r.Header.Get("Origin") != "" {
+		if policy, ok := corsPolicy(r.Context(), route.Module, verb, h.AllowOrigins).Get(); ok {
+			writeCORSHeaders(w, r, policy)
+		}
+	}
+
+	if md, ok := verb.GetMetadataIngress().Get(); ok && md.Type == "ws" {
+		// WebSocket connections are handled by [Connections.Handle].
+		w.Header().Set("Connection", "Upgrade")
+		w.Header().Set("Upgrade", "websocket")
+		fail(http.StatusUpgradeRequired, ProblemTypeInvalidRequest, errors.New("expected a WebSocket connection"))
+		return
+	}
+
+	var auth optional.Option[HTTPAuth]
+	if md, ok := verb.GetMetadataAuth().Get(); ok {
+		authenticated, err := h.Authenticator.authenticate(r.Context(), r, route.Module, md)
+		if errors.Is(err, errUnauthenticated) {
+			logger.Debugf("Rejected request to %s.%s: %s", route.Module, route.Verb, err)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="ftl"`)
+			fail(http.StatusUnauthorized, ProblemTypeUnauthenticated, errors.New(http.StatusText(http.StatusUnauthorized)))
+			return
+		} else if err != nil {
+			logger.Errorf(err, "Could not authenticate request to %s.%s", route.Module, route.Verb)
+			fail(http.StatusInternalServerError, ProblemTypeInternal, errors.New("could not authenticate request"))
+			return
+		}
+		auth = optional.Some(authenticated)
+	}
+
+	if md, ok := verb.GetMetadataCache().Get(); ok && h.Cache != nil && r.Method == http.MethodGet {
+		ttl, err := md.TTLDuration()
+		if err != nil {
+			fail(http.StatusInternalServerError, ProblemTypeInternal, err)
+			return
+		}
+		h.Cache.serve(w, r, route, ttl, func(w http.ResponseWriter) {
+			handleCall(sch, requestKey, route, verb, auth, w, r, h.Call, h.CallStream)
+		})
+		return
+	}
+	handleCall(sch, requestKey, route, verb, auth, w, r, h.Call, h.CallStream)
+}
+
+// handleCall calls the verb of a route and writes its response.
+func handleCall(
+	sch *schema.Schema,
+	requestKey model.RequestKey,
+	route *dal.IngressRoute,
+	verb *schema.Verb,
+	auth optional.Option[HTTPAuth],
+	w http.ResponseWriter,
+	r *http.Request,
+	call CallFunc,
+	callStream CallStreamFunc,
+) {
+	logger := log.FromContext(r.Context())
+	fail := func(status int, problemType string, err error) {
+		writeProblem(w, errorFormat(r.Context(), route.Module), newProblem(r, requestKey, status, problemType, err))
+	}
+
+	body, err := BuildRequestBody(route, r, sch, auth)
+	if err != nil {
+		fail(http.StatusBadRequest, ProblemTypeInvalidRequest, err)
 		return
 	}
 
@@ -49,37 +149,54 @@ func Handle(
 		Verb:     &schemapb.Ref{Module: route.Module, Name: route.Verb},
 		Body:     body,
 	})
+	// Calls limited by a request header are counted per value of the header.
+	if md, ok := verb.GetMetadataRateLimit().Get(); ok {
+		if header, ok := md.Header(); ok {
+			creq.Header().Set(header, r.Header.Get(header))
+		}
+	}
+
+	if verb.Kind() == schema.VerbKindStream {
+		stream := newStreamWriter(w, r, sch, verb, errorFormat(r.Context(), route.Module))
+		err := callStream(r.Context(), creq, optional.Some(requestKey), r.RemoteAddr, func(msg *ftlv1.CallResponse) error {
+			switch msg := msg.Response.(type) {
+			case *ftlv1.CallResponse_Body:
+				return stream.send(msg.Body)
+			case *ftlv1.CallResponse_Error_:
+				return stream.fail(newProblem(r, requestKey, http.StatusInternalServerError, ProblemTypeVerbError, errors.New(msg.Error.Message)))
+			}
+			return nil
+		})
+		if err != nil {
+			writeCallErrorHeaders(w, err)
+			if err := stream.fail(problemForCallError(r, requestKey, err)); err != nil {
+				logger.Debugf("Could not write error to stream: %s", err)
+			}
+		}
+		return
+	}
 
 	resp, err := call(r.Context(), creq, optional.Some(requestKey), r.RemoteAddr)
 	if err != nil {
-		if connectErr := new(connect.Error); errors.As(err, &connectErr) {
-			http.Error(w, err.Error(), connectCodeToHTTP(connectErr.Code()))
-		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
+		writeCallErrorHeaders(w, err)
+		writeProblem(w, errorFormat(r.Context(), route.Module), problemForCallError(r, requestKey, err))
 		return
 	}
 	switch msg := resp.Msg.Response.(type) {
 	case *ftlv1.CallResponse_Body:
-		verb := &schema.Verb{}
-		err = sch.ResolveRefToType(&schema.Ref{Name: route.Verb, Module: route.Module}, verb)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
 		var responseBody []byte
 
 		if metadata, ok := verb.GetMetadataIngress().Get(); ok && metadata.Type == "http" {
 			var response HTTPResponse
 			if err := json.Unmarshal(msg.Body, &response); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+				fail(http.StatusInternalServerError, ProblemTypeInternal, err)
 				return
 			}
 
 			var responseHeaders http.Header
 			responseBody, responseHeaders, err = ResponseForVerb(sch, verb, response)
 			if err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+				fail(http.StatusInternalServerError, ProblemTypeInternal, err)
 				return
 			}
 
@@ -101,7 +218,7 @@ func Handle(
 		}
 
 	case *ftlv1.CallResponse_Error_:
-		http.Error(w, msg.Error.Message, http.StatusInternalServerError)
+		fail(http.StatusInternalServerError, ProblemTypeVerbError, errors.New(msg.Error.Message))
 	}
 }
 
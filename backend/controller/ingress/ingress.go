@@ -1,10 +1,12 @@
 package ingress
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net"
 	"reflect"
 	"strconv"
 	"strings"
@@ -12,7 +14,7 @@ import (
 
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
-	"github.com/TBD54566975/ftl/internal/slices"
+	cf "github.com/TBD54566975/ftl/common/configuration"
 )
 
 type path []string
@@ -21,10 +23,79 @@ func (p path) String() string {
 	return strings.TrimLeft(strings.Join(p, ""), ".")
 }
 
-func GetIngressRoute(routes []dal.IngressRoute, method string, path string) (*dal.IngressRoute, error) {
-	var matchedRoutes = slices.Filter(routes, func(route dal.IngressRoute) bool {
-		return matchSegments(route.Path, path, func(segment, value string) {})
-	})
+// fieldPath returns the path relative to the root request type, eg.
+// "body.items[0]".
+func (p path) fieldPath() string {
+	if len(p) == 0 {
+		return ""
+	}
+	return p[1:].String()
+}
+
+// fieldError is a validation error for a single field of a request.
+type fieldError struct {
+	path path
+	err  error
+}
+
+func fieldErrorf(p path, format string, args ...any) error {
+	return &fieldError{path: append(path(nil), p...), err: fmt.Errorf(format, args...)}
+}
+
+func (f *fieldError) Error() string { return f.err.Error() }
+func (f *fieldError) Unwrap() error { return f.err }
+
+// getModuleConfig loads the value of a module's config into [value].
+//
+// Returns an error wrapping [cf.ErrNotFound] if there is no configuration
+// manager in the context, as is the case for controllers not started by "ftl
+// serve".
+func getModuleConfig(ctx context.Context, module, name string, value any) error {
+	cm, ok := cf.MaybeConfigFromContext(ctx).Get()
+	if !ok {
+		return fmt.Errorf("no configuration manager: %w", cf.ErrNotFound)
+	}
+	return cm.Get(ctx, cf.NewRef(module, name), value)
+}
+
+// getModuleSecret loads the value of a module's secret into [value].
+//
+// Returns an error wrapping [cf.ErrNotFound] if there is no secrets manager in
+// the context.
+func getModuleSecret(ctx context.Context, module, name string, value any) error {
+	sm, ok := cf.MaybeSecretsFromContext(ctx).Get()
+	if !ok {
+		return fmt.Errorf("no secrets manager: %w", cf.ErrNotFound)
+	}
+	return sm.Get(ctx, cf.NewRef(module, name), value)
+}
+
+// GetIngressRoute returns the route matching a request to [host] and [path]
+// out of [routes], which must be the routes of [method].
+//
+// Trailing slashes are ignored. When several routes match, the most specific
+// one is chosen: routes with a host take precedence over routes without one,
+// and exact hosts over "*." hosts. Then, comparing each path segment in turn,
+// literal segments take precedence over parameters, which take precedence
+// over wildcard parameters. Routes of the same verb are load balanced across
+// runners.
+func GetIngressRoute(routes []dal.IngressRoute, method, host, path string) (*dal.IngressRoute, error) {
+	host = requestHost(host)
+	var matchedRoutes []dal.IngressRoute
+	for _, route := range routes {
+		if !schema.MatchIngressHost(route.Host, host) || !matchSegments(route.Path, path, func(segment, value string) {}) {
+			continue
+		}
+		if len(matchedRoutes) > 0 {
+			cmp := compareRoutes(route, matchedRoutes[0])
+			if cmp < 0 {
+				continue
+			} else if cmp > 0 {
+				matchedRoutes = matchedRoutes[:0]
+			}
+		}
+		matchedRoutes = append(matchedRoutes, route)
+	}
 
 	if len(matchedRoutes) == 0 {
 		return nil, dal.ErrNotFound
@@ -35,14 +106,69 @@ func GetIngressRoute(routes []dal.IngressRoute, method string, path string) (*da
 	return &route, nil
 }
 
+// requestHost returns the lower case host of a request's Host header, without
+// the port.
+func requestHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+// compareRoutes compares the specificity of two routes matching the same
+// request, returning a positive number if [a] is more specific than [b].
+func compareRoutes(a, b dal.IngressRoute) int {
+	if cmp := schema.IngressHostPriority(a.Host) - schema.IngressHostPriority(b.Host); cmp != 0 {
+		return cmp
+	}
+	aSegments := strings.Split(strings.Trim(a.Path, "/"), "/")
+	bSegments := strings.Split(strings.Trim(b.Path, "/"), "/")
+	for i := 0; i < len(aSegments) && i < len(bSegments); i++ {
+		if cmp := segmentPriority(aSegments[i]) - segmentPriority(bSegments[i]); cmp != 0 {
+			return cmp
+		}
+	}
+	// A wildcard matching no segments is less specific than a route ending
+	// before it.
+	return len(bSegments) - len(aSegments)
+}
+
+func segmentPriority(segment string) int {
+	switch {
+	case strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "...}"):
+		return 0
+	case strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// matchSegments returns true if [urlPath] matches a route's [pattern], calling
+// [onMatch] with the value of each path parameter.
+//
+// A wildcard parameter such as "{path...}" matches the rest of the path,
+// including no segments at all.
 func matchSegments(pattern, urlPath string, onMatch func(segment, value string)) bool {
 	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
 	urlSegments := strings.Split(strings.Trim(urlPath, "/"), "/")
 
+	if wildcard := patternSegments[len(patternSegments)-1]; strings.HasPrefix(wildcard, "{") && strings.HasSuffix(wildcard, "...}") {
+		prefix := patternSegments[:len(patternSegments)-1]
+		if len(urlSegments) < len(prefix) || !matchSegmentList(prefix, urlSegments[:len(prefix)], onMatch) {
+			return false
+		}
+		onMatch(strings.TrimSuffix(strings.TrimPrefix(wildcard, "{"), "...}"), strings.Join(urlSegments[len(prefix):], "/"))
+		return true
+	}
+
 	if len(patternSegments) != len(urlSegments) {
 		return false
 	}
+	return matchSegmentList(patternSegments, urlSegments, onMatch)
+}
 
+func matchSegmentList(patternSegments, urlSegments []string, onMatch func(segment, value string)) bool {
 	for i, segment := range patternSegments {
 		if segment == "" && urlSegments[i] == "" {
 			continue // Skip empty segments
@@ -98,18 +224,18 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 		// TODO: Use type assertions consistently in this function rather than reflection.
 		rv := reflect.ValueOf(value)
 		if rv.Kind() != reflect.Map || rv.Len() != 0 {
-			return fmt.Errorf("%s must be an empty map", path)
+			return fieldErrorf(path, "%s must be an empty map", path)
 		}
 		return nil
 
 	case *schema.Time:
 		str, ok := value.(string)
 		if !ok {
-			return fmt.Errorf("time %s must be an RFC3339 formatted string", path)
+			return fieldErrorf(path, "time %s must be an RFC3339 formatted string", path)
 		}
 		_, err := time.Parse(time.RFC3339Nano, str)
 		if err != nil {
-			return fmt.Errorf("time %s must be an RFC3339 formatted string: %w", path, err)
+			return fieldErrorf(path, "time %s must be an RFC3339 formatted string: %w", path, err)
 		}
 		return nil
 
@@ -149,7 +275,7 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 	case *schema.Array:
 		rv := reflect.ValueOf(value)
 		if rv.Kind() != reflect.Slice {
-			return fmt.Errorf("%s is not a slice", path)
+			return fieldErrorf(path, "%s is not a slice", path)
 		}
 		elementType := fieldType.Element
 		for i := range rv.Len() {
@@ -164,7 +290,7 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 	case *schema.Map:
 		rv := reflect.ValueOf(value)
 		if rv.Kind() != reflect.Map {
-			return fmt.Errorf("%s is not a map", path)
+			return fieldErrorf(path, "%s is not a map", path)
 		}
 		keyType := fieldType.Key
 		valueType := fieldType.Value
@@ -218,19 +344,19 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 					if reqVariant, ok := value.(map[string]any); ok {
 						vName, ok := reqVariant["name"]
 						if !ok {
-							return fmt.Errorf(`missing name field in enum type %q: expected structure is `+
+							return fieldErrorf(path, `missing name field in enum type %q: expected structure is `+
 								"{\"name\": \"<variant name>\", \"value\": <variant value>}", value)
 						}
 						vNameStr, ok := vName.(string)
 						if !ok {
-							return fmt.Errorf(`invalid type for enum %q; name field must be a string, was %T`,
+							return fieldErrorf(path, `invalid type for enum %q; name field must be a string, was %T`,
 								fieldType, vName)
 						}
 						inputName = fmt.Sprintf("%q", vNameStr)
 
 						vValue, ok := reqVariant["value"]
 						if !ok {
-							return fmt.Errorf(`missing value field in enum type %q: expected structure is `+
+							return fieldErrorf(path, `missing value field in enum type %q: expected structure is `+
 								"{\"name\": \"<variant name>\", \"value\": <variant value>}", value)
 						}
 
@@ -238,16 +364,16 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 							return validateValue(t.Value, path, vValue, sch)
 						}
 					} else {
-						return fmt.Errorf(`malformed enum type %s: expected structure is `+
+						return fieldErrorf(path, `malformed enum type %s: expected structure is `+
 							"{\"name\": \"<variant name>\", \"value\": <variant value>}", path)
 					}
 				}
 			}
 			if !typeMatches {
-				return fmt.Errorf("%s is not a valid variant of enum %s", inputName, fieldType)
+				return fieldErrorf(path, "%s is not a valid variant of enum %s", inputName, fieldType)
 			}
 
-		case *schema.Config, *schema.Database, *schema.Secret, *schema.Verb, *schema.FSM:
+		case *schema.Config, *schema.Database, *schema.Secret, *schema.Verb, *schema.FSM, *schema.Topic, *schema.Subscription:
 
 		}
 
@@ -256,7 +382,7 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 		if bodyStr, ok := value.(string); ok {
 			_, err := base64.StdEncoding.DecodeString(bodyStr)
 			if err != nil {
-				return fmt.Errorf("%s is not a valid base64 string", path)
+				return fieldErrorf(path, "%s is not a valid base64 string", path)
 			}
 			typeMatches = true
 		}
@@ -270,7 +396,7 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 	}
 
 	if !typeMatches {
-		return fmt.Errorf("%s has wrong type, expected %s found %T", path, fieldType, value)
+		return fieldErrorf(path, "%s has wrong type, expected %s found %T", path, fieldType, value)
 	}
 	return nil
 }
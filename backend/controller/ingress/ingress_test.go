@@ -7,6 +7,7 @@ import (
 
 	"github.com/alecthomas/assert/v2"
 
+	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
 )
 
@@ -28,10 +29,17 @@ func TestMatchAndExtractAllSegments(t *testing.T) {
 		{"/users/{id}", "/users/123", map[string]string{"id": "123"}, true},
 		{"/users/{id}", "/users/123", map[string]string{"id": "123"}, true},
 		{"/users/{id}/posts/{postId}", "/users/123/posts/456", map[string]string{"id": "123", "postId": "456"}, true},
+		{"/users/{id}", "/users/123/", map[string]string{"id": "123"}, true},
+		{"/users/", "/users", map[string]string{}, true},
+		{"/files/{path...}", "/files/a/b/c.txt", map[string]string{"path": "a/b/c.txt"}, true},
+		{"/files/{path...}", "/files", map[string]string{"path": ""}, true},
+		{"/{id}/files/{path...}", "/123/files/a", map[string]string{"id": "123", "path": "a"}, true},
 
 		// invalid patterns
 		{"/", "/users", map[string]string{}, false},
 		{"/users/{id}", "/bogus/123", map[string]string{}, false},
+		{"/users/{id}", "/users/123/posts", map[string]string{}, false},
+		{"/files/{path...}", "/bogus/a/b", map[string]string{}, false},
 	}
 
 	for _, test := range tests {
@@ -44,6 +52,38 @@ func TestMatchAndExtractAllSegments(t *testing.T) {
 	}
 }
 
+func TestGetIngressRoute(t *testing.T) {
+	routes := []dal.IngressRoute{
+		{Path: "/files/{path...}", Verb: "files"},
+		{Path: "/files/{name}", Verb: "file"},
+		{Path: "/files/readme", Verb: "readme"},
+		{Path: "/files/readme", Host: "*.example.com", Verb: "subdomainReadme"},
+		{Path: "/files/readme", Host: "docs.example.com", Verb: "docsReadme"},
+		{Path: "/files/{name}/raw", Verb: "raw"},
+	}
+	for _, test := range []struct {
+		host     string
+		path     string
+		expected string
+	}{
+		{"localhost:8891", "/files/a/b", "files"},
+		{"localhost:8891", "/files", "files"},
+		{"localhost:8891", "/files/a", "file"},
+		{"localhost:8891", "/files/readme/", "readme"},
+		{"localhost:8891", "/files/a/raw", "raw"},
+		{"api.example.com", "/files/readme", "subdomainReadme"},
+		{"DOCS.example.com:443", "/files/readme", "docsReadme"},
+		{"example.com", "/files/readme", "readme"},
+	} {
+		route, err := GetIngressRoute(routes, "GET", test.host, test.path)
+		assert.NoError(t, err, "%s%s", test.host, test.path)
+		assert.Equal(t, test.expected, route.Verb, "%s%s", test.host, test.path)
+	}
+
+	_, err := GetIngressRoute(routes[1:], "GET", "localhost", "/files/a/b")
+	assert.IsError(t, err, dal.ErrNotFound)
+}
+
 func TestValidation(t *testing.T) {
 	tests := []struct {
 		name    string
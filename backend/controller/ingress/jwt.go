@@ -0,0 +1,295 @@
+package ingress
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// jwtLeeway is the allowed clock skew when validating the "exp" and "nbf"
+// claims of a JWT.
+const jwtLeeway = time.Minute
+
+// JWKS is a JSON Web Key Set, as defined in RFC 7517.
+//
+// Only public keys used for signature verification are supported.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK is a public JSON Web Key.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC and OKP
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+func (k JWK) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeJWTBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA modulus: %w", err)
+		}
+		e, err := decodeJWTBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("RSA exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported EC curve %q", k.Crv)
+		}
+		x, err := decodeJWTBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC x coordinate: %w", err)
+		}
+		y, err := decodeJWTBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC y coordinate: %w", err)
+		}
+		if !curve.IsOnCurve(x, y) { //nolint:staticcheck
+			return nil, fmt.Errorf("EC point is not on curve %s", k.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported OKP curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 public key")
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// jwtVerifier verifies JWTs issued by [issuer] for [audience], and signed by
+// one of [keys].
+type jwtVerifier struct {
+	keys     []jwtKey
+	audience string
+	issuer   string
+}
+
+type jwtKey struct {
+	JWK
+	publicKey crypto.PublicKey
+}
+
+func newJWTVerifier(keys JWKS, audience, issuer string) (jwtVerifier, error) {
+	if audience == "" {
+		return jwtVerifier{}, errors.New("audience is required")
+	}
+	if issuer == "" {
+		return jwtVerifier{}, errors.New("issuer is required")
+	}
+	verifier := jwtVerifier{audience: audience, issuer: issuer}
+	for _, key := range keys.Keys {
+		publicKey, err := key.publicKey()
+		if err != nil {
+			return jwtVerifier{}, fmt.Errorf("invalid key %q: %w", key.Kid, err)
+		}
+		verifier.keys = append(verifier.keys, jwtKey{JWK: key, publicKey: publicKey})
+	}
+	return verifier, nil
+}
+
+// verify the signature of a compact serialised JWT, and validate its time
+// based, "iss" and "aud" claims. The "exp" claim is required.
+//
+// Returns the claims of the token.
+func (v jwtVerifier) verify(token string, now time.Time) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed JWT")
+	}
+	var header jwtHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid JWT header: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT signature: %w", err)
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+
+	verified := false
+	for _, key := range v.keys {
+		if (header.Kid != "" && key.Kid != header.Kid) || (key.Alg != "" && key.Alg != header.Alg) || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+		if err := verifyJWTSignature(header.Alg, key.publicKey, signed, signature); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("JWT signature could not be verified")
+	}
+
+	claims := map[string]any{}
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid JWT claims: %w", err)
+	}
+	// Tokens without an expiry would be valid forever, so they are rejected.
+	exp, ok := claims["exp"]
+	if !ok {
+		return nil, errors.New(`JWT has no "exp" claim`)
+	}
+	if exp, ok := exp.(float64); !ok {
+		return nil, errors.New(`invalid JWT "exp" claim`)
+	} else if now.After(time.Unix(int64(exp), 0).Add(jwtLeeway)) {
+		return nil, errors.New("JWT has expired")
+	}
+	if nbf, ok := claims["nbf"]; ok {
+		nbf, ok := nbf.(float64)
+		if !ok {
+			return nil, errors.New(`invalid JWT "nbf" claim`)
+		}
+		if now.Add(jwtLeeway).Before(time.Unix(int64(nbf), 0)) {
+			return nil, errors.New("JWT is not valid yet")
+		}
+	}
+	if iss, _ := claims["iss"].(string); iss != v.issuer {
+		return nil, fmt.Errorf("JWT was not issued by %q", v.issuer)
+	}
+	if !jwtHasAudience(claims["aud"], v.audience) {
+		return nil, fmt.Errorf("JWT was not issued for %q", v.audience)
+	}
+	return claims, nil
+}
+
+// jwtHasAudience returns true if an "aud" claim, which is either a single
+// string or an array of strings, contains [audience].
+func jwtHasAudience(aud any, audience string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == audience
+	case []any:
+		for _, a := range aud {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func verifyJWTSignature(alg string, publicKey crypto.PublicKey, signed, signature []byte) error {
+	if alg == "EdDSA" {
+		key, ok := publicKey.(ed25519.PublicKey)
+		if !ok || !ed25519.Verify(key, signed, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+	if len(alg) != 5 {
+		return fmt.Errorf("unsupported JWT algorithm %q", alg)
+	}
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported JWT algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+	switch alg[:2] {
+	case "RS":
+		key, ok := publicKey.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("key is not an RSA key")
+		}
+		return rsa.VerifyPKCS1v15(key, hash, digest, signature)
+
+	case "PS":
+		key, ok := publicKey.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("key is not an RSA key")
+		}
+		return rsa.VerifyPSS(key, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+
+	case "ES":
+		key, ok := publicKey.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("key is not an EC key")
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported JWT algorithm %q", alg)
+	}
+}
+
+func decodeJWTSegment(segment string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func decodeJWTBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,158 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// ErrorFormatConfig is the name of the module config selecting the format of
+// errors returned by ingress for the module's verbs, either "problem" (the
+// default) or "text".
+const ErrorFormatConfig = "ingressErrorFormat"
+
+// ErrorFormat is the format of error responses returned by ingress.
+type ErrorFormat string
+
+const (
+	// ErrorFormatProblem returns RFC 7807 "application/problem+json" bodies.
+	ErrorFormatProblem ErrorFormat = "problem"
+	// ErrorFormatText returns the error message as a "text/plain" body.
+	ErrorFormatText ErrorFormat = "text"
+)
+
+// ProblemContentType is the content type of RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+// problemTypePrefix is the prefix of the stable type URIs of problems.
+const problemTypePrefix = "urn:ftl:problem:"
+
+// Problem types returned by ingress, in addition to those derived from
+// connect error codes by [problemTypeForCode].
+const (
+	ProblemTypeNotFound        = problemTypePrefix + "not-found"
+	ProblemTypeInvalidRequest  = problemTypePrefix + "invalid-request"
+	ProblemTypeUnauthenticated = problemTypePrefix + "unauthenticated"
+	ProblemTypeVerbError       = problemTypePrefix + "verb-error"
+	ProblemTypeInternal        = problemTypePrefix + "internal"
+)
+
+// Problem mirrors builtin.HttpProblem.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	RequestKey    string         `json:"requestKey,omitempty"`
+	InvalidFields []ProblemField `json:"invalidFields,omitempty"`
+}
+
+// ProblemField mirrors builtin.HttpProblemField.
+type ProblemField struct {
+	Path   string `json:"path"`
+	Detail string `json:"detail"`
+}
+
+// newProblem creates a problem for a request from an error.
+//
+// Field errors within [err] are reported as invalid fields.
+func newProblem(r *http.Request, requestKey model.RequestKey, status int, problemType string, err error) Problem {
+	return Problem{
+		Type:          problemType,
+		Title:         http.StatusText(status),
+		Status:        status,
+		Detail:        err.Error(),
+		Instance:      r.URL.Path,
+		RequestKey:    requestKey.String(),
+		InvalidFields: invalidFields(err),
+	}
+}
+
+// problemForCallError creates a problem for an error returned when calling a
+// verb, using the connect error code if present.
+func problemForCallError(r *http.Request, requestKey model.RequestKey, err error) Problem {
+	if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+		return newProblem(r, requestKey, connectCodeToHTTP(connectErr.Code()), problemTypeForCode(connectErr.Code()), err)
+	}
+	return newProblem(r, requestKey, http.StatusInternalServerError, ProblemTypeInternal, err)
+}
+
+// writeCallErrorHeaders sets the response headers carried by an error returned
+// when calling a verb, such as "Retry-After" for rate limited calls.
+func writeCallErrorHeaders(w http.ResponseWriter, err error) {
+	if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+		if retryAfter := connectErr.Meta().Get("Retry-After"); retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+	}
+}
+
+// problemTypeForCode returns the problem type for a connect error code, eg.
+// "urn:ftl:problem:deadline-exceeded".
+func problemTypeForCode(code connect.Code) string {
+	return problemTypePrefix + strings.ReplaceAll(code.String(), "_", "-")
+}
+
+// invalidFields collects the field errors in an error tree.
+func invalidFields(err error) []ProblemField {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint
+		var out []ProblemField
+		for _, err := range joined.Unwrap() {
+			out = append(out, invalidFields(err)...)
+		}
+		return out
+	}
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		return []ProblemField{{Path: fe.path.fieldPath(), Detail: fe.Error()}}
+	}
+	return nil
+}
+
+// errorFormat returns the error format configured for a module, or
+// [ErrorFormatProblem] if none is.
+func errorFormat(ctx context.Context, module string) ErrorFormat {
+	var format ErrorFormat
+	err := getModuleConfig(ctx, module, ErrorFormatConfig, &format)
+	if errors.Is(err, cf.ErrNotFound) {
+		return ErrorFormatProblem
+	} else if err != nil {
+		log.FromContext(ctx).Warnf("Could not load %s.%s config, using %q: %s", module, ErrorFormatConfig, ErrorFormatProblem, err)
+		return ErrorFormatProblem
+	}
+	switch format {
+	case ErrorFormatProblem, ErrorFormatText:
+		return format
+	default:
+		log.FromContext(ctx).Warnf("Invalid %s.%s config %q, using %q", module, ErrorFormatConfig, format, ErrorFormatProblem)
+		return ErrorFormatProblem
+	}
+}
+
+// writeProblem writes an error response in the given format.
+func writeProblem(w http.ResponseWriter, format ErrorFormat, problem Problem) {
+	if format == ErrorFormatText {
+		http.Error(w, problem.Detail, problem.Status)
+		return
+	}
+	body, err := json.Marshal(problem)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not encode problem: %s", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Del("Content-Length")
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(problem.Status)
+	_, _ = w.Write(body)
+}
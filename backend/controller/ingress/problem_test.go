@@ -0,0 +1,154 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestHandleProblem(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data Item {
+				name String
+			}
+
+			data CreateRequest {
+				name String
+				items [test.Item]
+			}
+
+			export verb create(HttpRequest<test.CreateRequest>) HttpResponse<Empty, String>
+				+ingress http POST /items
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/items", Module: "test", Verb: "create"}}
+	requestKey := model.NewRequestKey(model.OriginIngress, "test")
+	valid := `{"name": "a", "items": []}`
+
+	for _, test := range []struct {
+		name     string
+		path     string
+		body     string
+		format   ErrorFormat
+		noConfig bool
+		response *ftlv1.CallResponse
+		err      error
+		expected Problem
+	}{
+		{name: "NotFound",
+			path: "/missing",
+			expected: Problem{Type: ProblemTypeNotFound, Title: "Not Found", Status: http.StatusNotFound,
+				Detail: "no route matches the request", Instance: "/missing", RequestKey: requestKey.String()}},
+		{name: "InvalidFields",
+			body: `{"items": [{}]}`,
+			expected: Problem{Type: ProblemTypeInvalidRequest, Title: "Bad Request", Status: http.StatusBadRequest,
+				Detail:     "builtin.HttpRequest<test.CreateRequest>.body.name is required\nbuiltin.HttpRequest<test.CreateRequest>.body.items[0].name is required",
+				Instance:   "/items",
+				RequestKey: requestKey.String(),
+				InvalidFields: []ProblemField{
+					{Path: "body.name", Detail: "builtin.HttpRequest<test.CreateRequest>.body.name is required"},
+					{Path: "body.items[0].name", Detail: "builtin.HttpRequest<test.CreateRequest>.body.items[0].name is required"},
+				}}},
+		{name: "VerbError",
+			body:     valid,
+			response: &ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: "boom"}}},
+			expected: Problem{Type: ProblemTypeVerbError, Title: "Internal Server Error", Status: http.StatusInternalServerError,
+				Detail: "boom", Instance: "/items", RequestKey: requestKey.String()}},
+		{name: "ConnectError",
+			body: valid,
+			err:  connect.NewError(connect.CodeDeadlineExceeded, errors.New("too slow")),
+			expected: Problem{Type: "urn:ftl:problem:deadline-exceeded", Title: "Request Timeout", Status: http.StatusRequestTimeout,
+				Detail: "deadline_exceeded: too slow", Instance: "/items", RequestKey: requestKey.String()}},
+		{name: "NoConfigManager",
+			body:     `{"items": []}`,
+			noConfig: true,
+			expected: Problem{Type: ProblemTypeInvalidRequest, Title: "Bad Request", Status: http.StatusBadRequest,
+				Detail: "builtin.HttpRequest<test.CreateRequest>.body.name is required", Instance: "/items", RequestKey: requestKey.String(),
+				InvalidFields: []ProblemField{{Path: "body.name", Detail: "builtin.HttpRequest<test.CreateRequest>.body.name is required"}}}},
+		{name: "TextFormat",
+			body:     `{"items": []}`,
+			format:   ErrorFormatText,
+			expected: Problem{Status: http.StatusBadRequest, Detail: "builtin.HttpRequest<test.CreateRequest>.body.name is required"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := contextWithTestConfig(t)
+			if test.noConfig {
+				ctx = log.ContextWithNewDefaultLogger(context.Background())
+			}
+			if test.format != "" {
+				err := cf.ConfigFromContext(ctx).Set(ctx, cf.NewRef("test", ErrorFormatConfig), test.format)
+				assert.NoError(t, err)
+			}
+			path := test.path
+			if path == "" {
+				path = "/items"
+			}
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(test.body)).WithContext(ctx)
+			Handler{Routes: routes, Authenticator: NewAuthenticator(), Call: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+				if test.err != nil {
+					return nil, test.err
+				}
+				return connect.NewResponse(test.response), nil
+			}}.Handle(sch, requestKey, rec, req)
+			assert.Equal(t, test.expected.Status, rec.Code, "%s", rec.Body.Bytes())
+
+			if test.format == ErrorFormatText {
+				assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+				assert.Equal(t, test.expected.Detail+"\n", rec.Body.String())
+				return
+			}
+			assert.Equal(t, ProblemContentType, rec.Header().Get("Content-Type"))
+			var actual Problem
+			err := json.Unmarshal(rec.Body.Bytes(), &actual)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestHandleRateLimited(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			export verb limited(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+				+ingress http GET /limited
+				+ratelimit 10/1m by=header:X-Api-Key
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/limited", Module: "test", Verb: "limited"}}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/limited", nil).WithContext(contextWithTestConfig(t))
+	req.Header.Set("X-Api-Key", "secret")
+	Handler{Routes: routes, Authenticator: NewAuthenticator(), Call: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+		// The header calls are limited by is passed through to the call.
+		assert.Equal(t, "secret", r.Header().Get("X-Api-Key"))
+		cerr := connect.NewError(connect.CodeResourceExhausted, errors.New("rate limit exceeded"))
+		cerr.Meta().Set("Retry-After", "42")
+		return nil, cerr
+	}}.Handle(sch, model.NewRequestKey(model.OriginIngress, "test"), rec, req)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "42", rec.Header().Get("Retry-After"))
+
+	var problem Problem
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	assert.Equal(t, problemTypePrefix+"resource-exhausted", problem.Type)
+}
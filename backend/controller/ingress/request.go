@@ -10,12 +10,16 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/alecthomas/types/optional"
+
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
 )
 
 // BuildRequestBody extracts the HttpRequest body from an HTTP request.
-func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Schema) ([]byte, error) {
+//
+// [auth] is the authenticated caller, for verbs that require authentication.
+func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Schema, auth optional.Option[HTTPAuth]) ([]byte, error) {
 	verb := &schema.Verb{}
 	err := sch.ResolveRefToType(&schema.Ref{Name: route.Verb, Module: route.Module}, verb)
 	if err != nil {
@@ -70,6 +74,13 @@ func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Sche
 		requestMap["query"] = queryMap
 		requestMap["headers"] = headerMap
 		requestMap["body"] = httpRequestBody
+		if auth, ok := auth.Get(); ok {
+			requestMap["auth"] = map[string]any{
+				"method":  auth.Method,
+				"subject": auth.Subject,
+				"claims":  auth.Claims,
+			}
+		}
 	} else {
 		var err error
 		requestMap, err = buildRequestMap(route, r, request, sch)
@@ -206,9 +217,20 @@ func buildRequestMap(route *dal.IngressRoute, r *http.Request, ref *schema.Ref,
 	switch r.Method {
 	case http.MethodPost, http.MethodPut:
 		var bodyMap map[string]any
-		err := json.NewDecoder(r.Body).Decode(&bodyMap)
-		if err != nil {
-			return nil, fmt.Errorf("HTTP request body is not valid JSON: %w", err)
+		if isFormRequest(r) {
+			data, err := sch.ResolveRefMonomorphised(ref)
+			if err != nil {
+				return nil, err
+			}
+			bodyMap, err = parseFormBody(r, data)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			err := json.NewDecoder(r.Body).Decode(&bodyMap)
+			if err != nil {
+				return nil, fmt.Errorf("HTTP request body is not valid JSON: %w", err)
+			}
 		}
 
 		// Merge bodyMap into params
@@ -246,7 +268,7 @@ func validateRequestMap(ref *schema.Ref, path path, request map[string]any, sch
 
 		value, haveValue := request[field.Name]
 		if !haveValue && !allowMissingField(field) {
-			errs = append(errs, fmt.Errorf("%s is required", fieldPath))
+			errs = append(errs, fieldErrorf(fieldPath, "%s is required", fieldPath))
 			continue
 		}
 
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
 
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
@@ -175,7 +176,7 @@ func TestBuildRequestBody(t *testing.T) {
 				Path:   test.routePath,
 				Module: "test",
 				Verb:   test.verb,
-			}, r, sch)
+			}, r, sch, optional.None[HTTPAuth]())
 			if test.err != "" {
 				assert.EqualError(t, err, test.err)
 				return
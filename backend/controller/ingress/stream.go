@@ -0,0 +1,134 @@
+package ingress
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+const (
+	// SSEContentType is the content type of streaming responses for clients
+	// that accept server-sent events.
+	SSEContentType = "text/event-stream"
+	// JSONLinesContentType is the content type of streaming responses for all
+	// other clients, with one JSON encoded response per line.
+	JSONLinesContentType = "application/x-ndjson"
+)
+
+// streamWriter writes the responses of a streaming verb to an HTTP response,
+// either as server-sent events or as JSON lines, flushing after each.
+//
+// Errors before the first response are written as a regular error response in
+// the module's error format. Errors after the first response end the stream
+// with an "error" event for SSE, or a final line containing the problem
+// details for JSON lines.
+type streamWriter struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	sch     *schema.Schema
+	verb    *schema.Verb
+	format  ErrorFormat
+	sse     bool
+	started bool
+}
+
+func newStreamWriter(w http.ResponseWriter, r *http.Request, sch *schema.Schema, verb *schema.Verb, format ErrorFormat) *streamWriter {
+	return &streamWriter{
+		w:      w,
+		rc:     http.NewResponseController(w), //nolint:bodyclose
+		sch:    sch,
+		verb:   verb,
+		format: format,
+		sse:    strings.Contains(r.Header.Get("Accept"), SSEContentType),
+	}
+}
+
+func (s *streamWriter) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	if s.sse {
+		s.w.Header().Set("Content-Type", SSEContentType)
+		// Disable response buffering in nginx and similar proxies.
+		s.w.Header().Set("X-Accel-Buffering", "no")
+	} else {
+		s.w.Header().Set("Content-Type", JSONLinesContentType)
+	}
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// send writes a JSON encoded response from the verb.
+func (s *streamWriter) send(data []byte) error {
+	body, err := s.body(data)
+	if err != nil {
+		return err
+	}
+	s.start()
+	if s.sse {
+		return s.writeEvent("", body)
+	}
+	return s.writeLine(body)
+}
+
+// fail writes a problem, ending the stream.
+func (s *streamWriter) fail(problem Problem) error {
+	if !s.started {
+		writeProblem(s.w, s.format, problem)
+		return nil
+	}
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return err
+	}
+	if s.sse {
+		return s.writeEvent("error", body)
+	}
+	return s.writeLine(body)
+}
+
+// body converts a JSON encoded response to its representation in the stream.
+//
+// Server-sent events carry data types as JSON and scalars as text, as for
+// non-streaming responses. JSON lines are always JSON.
+func (s *streamWriter) body(data []byte) ([]byte, error) {
+	switch s.verb.Response.(type) {
+	case *schema.Ref, *schema.Array, *schema.Map:
+	default:
+		if !s.sse {
+			return data, nil
+		}
+	}
+	return bodyForType(s.verb.Response, s.sch, data)
+}
+
+func (s *streamWriter) writeEvent(event string, data []byte) error {
+	buf := &bytes.Buffer{}
+	if event != "" {
+		fmt.Fprintf(buf, "event: %s\n", event)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+	return s.write(buf.Bytes())
+}
+
+func (s *streamWriter) writeLine(data []byte) error {
+	return s.write(append(bytes.TrimRight(data, "\n"), '\n'))
+}
+
+func (s *streamWriter) write(data []byte) error {
+	if _, err := s.w.Write(data); err != nil {
+		return fmt.Errorf("could not write streaming response: %w", err)
+	}
+	if err := s.rc.Flush(); err != nil {
+		return fmt.Errorf("could not flush streaming response: %w", err)
+	}
+	return nil
+}
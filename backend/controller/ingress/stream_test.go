@@ -0,0 +1,108 @@
+package ingress
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestHandleStream(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data Progress {
+				percent Int +alias json "pct"
+			}
+
+			export verb progress(HttpRequest<Unit>) test.Progress
+				+ingress http GET /progress
+				+stream
+
+			export verb tokens(HttpRequest<Unit>) String
+				+ingress http GET /tokens
+				+stream
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{
+		{Path: "/progress", Module: "test", Verb: "progress"},
+		{Path: "/tokens", Module: "test", Verb: "tokens"},
+	}
+	requestKey := model.NewRequestKey(model.OriginIngress, "test")
+	body := func(data string) *ftlv1.CallResponse {
+		return &ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(data)}}
+	}
+	verbError := &ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: "boom"}}}
+
+	for _, test := range []struct {
+		name        string
+		path        string
+		accept      string
+		responses   []*ftlv1.CallResponse
+		err         error
+		statusCode  int
+		contentType string
+		expected    string
+	}{
+		{name: "JSONLines",
+			path:        "/progress",
+			responses:   []*ftlv1.CallResponse{body(`{"percent":50}`), body(`{"percent":100}`)},
+			statusCode:  http.StatusOK,
+			contentType: JSONLinesContentType,
+			expected:    "{\"pct\":50}\n{\"pct\":100}\n"},
+		{name: "SSE",
+			path:        "/tokens",
+			accept:      "text/event-stream",
+			responses:   []*ftlv1.CallResponse{body(`"Hello"`), body(`"multi\nline"`)},
+			statusCode:  http.StatusOK,
+			contentType: SSEContentType,
+			expected:    "data: Hello\n\ndata: multi\ndata: line\n\n"},
+		{name: "SSEError",
+			path:        "/progress",
+			accept:      "text/event-stream",
+			responses:   []*ftlv1.CallResponse{body(`{"percent":50}`), verbError},
+			statusCode:  http.StatusOK,
+			contentType: SSEContentType,
+			expected: "data: {\"pct\":50}\n\n" +
+				`event: error` + "\n" +
+				`data: {"type":"urn:ftl:problem:verb-error","title":"Internal Server Error","status":500,"detail":"boom","instance":"/progress","requestKey":"` + requestKey.String() + `"}` + "\n\n"},
+		{name: "ErrorBeforeFirstResponse",
+			path:        "/progress",
+			err:         connect.NewError(connect.CodeUnavailable, nil),
+			statusCode:  http.StatusServiceUnavailable,
+			contentType: ProblemContentType,
+			expected:    `{"type":"urn:ftl:problem:unavailable","title":"Service Unavailable","status":503,"detail":"unavailable","instance":"/progress","requestKey":"` + requestKey.String() + `"}`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := contextWithTestConfig(t)
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, test.path, nil).WithContext(ctx)
+			if test.accept != "" {
+				req.Header.Set("Accept", test.accept)
+			}
+			Handler{Routes: routes, Authenticator: NewAuthenticator(), CallStream: func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string, send func(*ftlv1.CallResponse) error) error {
+				if test.err != nil {
+					return test.err
+				}
+				for _, response := range test.responses {
+					if err := send(response); err != nil {
+						return err
+					}
+				}
+				return nil
+			}}.Handle(sch, requestKey, rec, req)
+			assert.Equal(t, test.statusCode, rec.Code, "%s", rec.Body.Bytes())
+			assert.Equal(t, test.contentType, rec.Header().Get("Content-Type"))
+			assert.Equal(t, test.expected, rec.Body.String())
+		})
+	}
+}
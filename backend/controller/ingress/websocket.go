@@ -0,0 +1,266 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"github.com/gorilla/websocket"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// ConnectionDAL is the subset of the DAL used to track WebSocket connections
+// across controllers.
+type ConnectionDAL interface {
+	CreateIngressConnection(ctx context.Context, key model.ConnectionKey, controller model.ControllerKey, module, verb string) error
+	DeleteIngressConnection(ctx context.Context, key model.ConnectionKey) error
+	QueueIngressConnectionMessage(ctx context.Context, key model.ConnectionKey, msg dal.IngressConnectionMessage) error
+	DequeueIngressConnectionMessages(ctx context.Context, key model.ConnectionKey) ([]dal.IngressConnectionMessage, error)
+}
+
+// Connections handles WebSocket connections to "+ingress ws" verbs.
+//
+// Each message received on a connection calls the verb with a
+// [builtin.WebSocketMessage] identifying the connection. Messages are sent to
+// a connection with [Connections.Send] on any controller: if the connection
+// is held by another controller the message is queued in the database, and
+// that controller delivers it when notified via [Connections.Deliver].
+type Connections struct {
+	controller model.ControllerKey
+	dal        ConnectionDAL
+	call       func(context.Context, *connect.Request[ftlv1.CallRequest], optional.Option[model.RequestKey], string) (*connect.Response[ftlv1.CallResponse], error)
+
+	lock  sync.Mutex
+	conns map[string]*connection
+}
+
+// connection is a WebSocket connection held by this controller.
+type connection struct {
+	// Writes to a WebSocket connection must not be concurrent.
+	lock sync.Mutex
+	conn *websocket.Conn
+}
+
+func (c *connection) write(msg dal.IngressConnectionMessage) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	messageType := websocket.TextMessage
+	if msg.Binary {
+		messageType = websocket.BinaryMessage
+	}
+	return c.conn.WriteMessage(messageType, msg.Body)
+}
+
+// NewConnections creates a handler for the WebSocket connections held by a
+// controller.
+func NewConnections(
+	controller model.ControllerKey,
+	dal ConnectionDAL,
+	call func(context.Context, *connect.Request[ftlv1.CallRequest], optional.Option[model.RequestKey], string) (*connect.Response[ftlv1.CallResponse], error),
+) *Connections {
+	return &Connections{
+		controller: controller,
+		dal:        dal,
+		call:       call,
+		conns:      map[string]*connection{},
+	}
+}
+
+// Handle upgrades a request to a "+ingress ws" route to a WebSocket
+// connection, and calls the route's verb with each message received until the
+// connection is closed.
+//
+// Cross-origin connections are only accepted from origins allowed by the
+// verb's CORS policy, see [Handle].
+func (c *Connections) Handle(sch *schema.Schema, routes []dal.IngressRoute, allowOrigins []string, w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.FromContext(ctx)
+	requestKey := model.NewRequestKey(model.OriginIngress, "WS "+r.URL.Path)
+	route, err := GetIngressRoute(routes, http.MethodGet, r.Host, r.URL.Path)
+	if err != nil {
+		if errors.Is(err, dal.ErrNotFound) {
+			writeProblem(w, ErrorFormatProblem, newProblem(r, requestKey, http.StatusNotFound, ProblemTypeNotFound, errors.New("no route matches the request")))
+			return
+		}
+		writeProblem(w, ErrorFormatProblem, newProblem(r, requestKey, http.StatusInternalServerError, ProblemTypeInternal, err))
+		return
+	}
+	fail := func(status int, problemType string, err error) {
+		writeProblem(w, errorFormat(ctx, route.Module), newProblem(r, requestKey, status, problemType, err))
+	}
+	verb := &schema.Verb{}
+	if err := sch.ResolveRefToType(&schema.Ref{Name: route.Verb, Module: route.Module}, verb); err != nil {
+		fail(http.StatusInternalServerError, ProblemTypeInternal, err)
+		return
+	}
+	if md, ok := verb.GetMetadataIngress().Get(); !ok || md.Type != "ws" {
+		fail(http.StatusBadRequest, ProblemTypeInvalidRequest, fmt.Errorf("%s does not accept WebSocket connections", r.URL.Path))
+		return
+	}
+	request, ok := verb.Request.(*schema.Ref)
+	if !ok {
+		fail(http.StatusInternalServerError, ProblemTypeInternal, fmt.Errorf("verb %s request must be builtin.WebSocketMessage", verb.Name))
+		return
+	}
+	bodyField, err := getBodyField(request, sch)
+	if err != nil {
+		fail(http.StatusInternalServerError, ProblemTypeInternal, err)
+		return
+	}
+
+	policy := corsPolicy(ctx, route.Module, verb, allowOrigins)
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				return true
+			}
+			if p, ok := policy.Get(); ok {
+				if _, ok := p.allowOrigin(origin); ok {
+					return true
+				}
+			}
+			u, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(u.Host, r.Host)
+		},
+	}
+	// Upgrade writes an error response itself if it fails.
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logger.Debugf("Rejected WebSocket connection to %s.%s: %s", route.Module, route.Verb, err)
+		return
+	}
+	defer conn.Close()
+
+	key := model.NewConnectionKey(route.Module)
+	// The connection is held locally before it is recorded in the database, so
+	// that any notification for a message queued by another controller once the
+	// row exists finds it in [Connections.Deliver].
+	c.lock.Lock()
+	c.conns[key.String()] = &connection{conn: conn}
+	c.lock.Unlock()
+	unregister := func() {
+		c.lock.Lock()
+		delete(c.conns, key.String())
+		c.lock.Unlock()
+	}
+	if err := c.dal.CreateIngressConnection(ctx, key, c.controller, route.Module, route.Verb); err != nil {
+		unregister()
+		logger.Errorf(err, "Could not register WebSocket connection to %s.%s", route.Module, route.Verb)
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "")) //nolint:errcheck
+		return
+	}
+	logger.Debugf("Opened WebSocket connection %s to %s.%s", key, route.Module, route.Verb)
+	defer func() {
+		unregister()
+		// The request context is done once the connection is closed.
+		if err := c.dal.DeleteIngressConnection(context.WithoutCancel(ctx), key); err != nil {
+			logger.Warnf("Could not remove WebSocket connection %s: %s", key, err)
+		}
+		logger.Debugf("Closed WebSocket connection %s", key)
+	}()
+
+	pathParameters := map[string]any{}
+	matchSegments(route.Path, r.URL.Path, func(segment, value string) {
+		pathParameters[segment] = value
+	})
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived) {
+				logger.Debugf("WebSocket connection %s failed: %s", key, err)
+			}
+			return
+		}
+		body, err := buildWebSocketMessage(sch, request, bodyField, key, pathParameters, data)
+		if err != nil {
+			logger.Warnf("Ignoring invalid message on WebSocket connection %s: %s", key, err)
+			continue
+		}
+		creq := connect.NewRequest(&ftlv1.CallRequest{
+			Metadata: &ftlv1.Metadata{},
+			Verb:     &schemapb.Ref{Module: route.Module, Name: route.Verb},
+			Body:     body,
+		})
+		messageKey := model.NewRequestKey(model.OriginIngress, "WS "+r.URL.Path)
+		resp, err := c.call(ctx, creq, optional.Some(messageKey), r.RemoteAddr)
+		if err != nil {
+			logger.Errorf(err, "Failed to call %s.%s for WebSocket connection %s", route.Module, route.Verb, key)
+		} else if verr := resp.Msg.GetError(); verr != nil {
+			logger.Warnf("%s.%s failed for WebSocket connection %s: %s", route.Module, route.Verb, key, verr.Message)
+		}
+	}
+}
+
+// buildWebSocketMessage builds the request to a WebSocket verb for a message.
+func buildWebSocketMessage(sch *schema.Schema, request *schema.Ref, bodyField *schema.Field, key model.ConnectionKey, pathParameters map[string]any, data []byte) ([]byte, error) {
+	body, err := valueForData(bodyField.Type, data)
+	if err != nil {
+		return nil, err
+	}
+	requestMap := map[string]any{
+		"connectionId":   key.String(),
+		"pathParameters": pathParameters,
+		"body":           body,
+	}
+	requestMap, err = transformFromAliasedFields(request, sch, requestMap)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRequestMap(request, []string{request.String()}, requestMap, sch); err != nil {
+		return nil, err
+	}
+	return json.Marshal(requestMap)
+}
+
+// Send sends a message to a WebSocket connection.
+//
+// Returns [dal.ErrNotFound] if the connection is not open.
+func (c *Connections) Send(ctx context.Context, key model.ConnectionKey, msg dal.IngressConnectionMessage) error {
+	if conn, ok := c.get(key); ok {
+		if err := conn.write(msg); err != nil {
+			return fmt.Errorf("failed to send message to WebSocket connection %s: %w", key, err)
+		}
+		return nil
+	}
+	return c.dal.QueueIngressConnectionMessage(ctx, key, msg)
+}
+
+// Deliver sends the messages queued by other controllers for a WebSocket
+// connection, if this controller holds it.
+func (c *Connections) Deliver(ctx context.Context, key model.ConnectionKey) error {
+	conn, ok := c.get(key)
+	if !ok {
+		return nil
+	}
+	msgs, err := c.dal.DequeueIngressConnectionMessages(ctx, key)
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		if err := conn.write(msg); err != nil {
+			return fmt.Errorf("failed to send message to WebSocket connection %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (c *Connections) get(key model.ConnectionKey) (*connection, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	conn, ok := c.conns[key.String()]
+	return conn, ok
+}
@@ -0,0 +1,196 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+	"github.com/gorilla/websocket"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+type fakeConnectionDAL struct {
+	lock   sync.Mutex
+	conns  map[string]string
+	queued map[string][]dal.IngressConnectionMessage
+	// onCreate is called once a connection has been recorded.
+	onCreate func(key model.ConnectionKey)
+}
+
+func (f *fakeConnectionDAL) CreateIngressConnection(ctx context.Context, key model.ConnectionKey, controller model.ControllerKey, module, verb string) error {
+	f.lock.Lock()
+	f.conns[key.String()] = module + "." + verb
+	f.lock.Unlock()
+	if f.onCreate != nil {
+		f.onCreate(key)
+	}
+	return nil
+}
+
+func (f *fakeConnectionDAL) DeleteIngressConnection(ctx context.Context, key model.ConnectionKey) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.conns, key.String())
+	delete(f.queued, key.String())
+	return nil
+}
+
+func (f *fakeConnectionDAL) QueueIngressConnectionMessage(ctx context.Context, key model.ConnectionKey, msg dal.IngressConnectionMessage) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.conns[key.String()]; !ok {
+		return dal.ErrNotFound
+	}
+	f.queued[key.String()] = append(f.queued[key.String()], msg)
+	return nil
+}
+
+func (f *fakeConnectionDAL) DequeueIngressConnectionMessages(ctx context.Context, key model.ConnectionKey) ([]dal.IngressConnectionMessage, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	msgs := f.queued[key.String()]
+	delete(f.queued, key.String())
+	return msgs, nil
+}
+
+func TestWebSocketConnections(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data Chat {
+				text String
+			}
+
+			export verb chat(builtin.WebSocketMessage<test.Chat>) Unit
+				+ingress ws /chat/{room}
+
+			export verb echo(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+				+ingress http GET /echo
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{
+		{Path: "/chat/{room}", Module: "test", Verb: "chat"},
+		{Path: "/echo", Module: "test", Verb: "echo"},
+	}
+	ctx := contextWithTestConfig(t)
+	fakeDAL := &fakeConnectionDAL{conns: map[string]string{}, queued: map[string][]dal.IngressConnectionMessage{}}
+
+	received := make(chan map[string]any, 8)
+	connections := NewConnections(model.NewControllerKey("localhost", "8892"), fakeDAL, func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+		var request map[string]any
+		assert.NoError(t, json.Unmarshal(r.Msg.Body, &request))
+		received <- request
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{}`)}}), nil
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections.Handle(sch, routes, nil, w, r.WithContext(ctx))
+	}))
+	t.Cleanup(srv.Close)
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL+"/echo", nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	_, resp, err = websocket.DefaultDialer.Dial(wsURL+"/chat/general", http.Header{"Origin": {"https://evil.example.com"}})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	conn, resp, err := websocket.DefaultDialer.Dial(wsURL+"/chat/general", nil)
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"text":"hello"}`)))
+	var request map[string]any
+	select {
+	case request = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+	assert.Equal(t, any(map[string]any{"text": "hello"}), request["body"])
+	assert.Equal(t, any(map[string]any{"room": "general"}), request["pathParameters"])
+	key, err := model.ParseConnectionKey(request["connectionId"].(string)) //nolint:forcetypeassert
+	assert.NoError(t, err)
+	assert.Equal(t, "test", key.Payload.Module)
+
+	// Invalid messages are not delivered to the verb.
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"text":1}`)))
+
+	err = connections.Send(ctx, key, dal.IngressConnectionMessage{Body: []byte("local")})
+	assert.NoError(t, err)
+	messageType, data, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, websocket.TextMessage, messageType)
+	assert.Equal(t, "local", string(data))
+
+	// Messages queued by other controllers are delivered when notified.
+	err = fakeDAL.QueueIngressConnectionMessage(ctx, key, dal.IngressConnectionMessage{Body: []byte{1, 2}, Binary: true})
+	assert.NoError(t, err)
+	assert.NoError(t, connections.Deliver(ctx, key))
+	messageType, data, err = conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, websocket.BinaryMessage, messageType)
+	assert.Equal(t, []byte{1, 2}, data)
+
+	assert.NoError(t, conn.Close())
+	assert.Equal(t, 0, len(received))
+	for i := 0; i < 50; i++ {
+		if _, ok := connections.get(key); !ok {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	_, ok := connections.get(key)
+	assert.False(t, ok)
+	err = connections.Send(ctx, key, dal.IngressConnectionMessage{Body: []byte("closed")})
+	assert.IsError(t, err, dal.ErrNotFound)
+}
+
+func TestWebSocketMessageQueuedWhileConnecting(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			export verb chat(builtin.WebSocketMessage<String>) Unit
+				+ingress ws /chat
+		}
+	`)
+	assert.NoError(t, err)
+	routes := []dal.IngressRoute{{Path: "/chat", Module: "test", Verb: "chat"}}
+	ctx := contextWithTestConfig(t)
+	fakeDAL := &fakeConnectionDAL{conns: map[string]string{}, queued: map[string][]dal.IngressConnectionMessage{}}
+	connections := NewConnections(model.NewControllerKey("localhost", "8892"), fakeDAL, func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{}`)}}), nil
+	})
+	// Another controller queues a message as soon as the connection is
+	// recorded, and this controller is notified before Handle returns from
+	// registering it.
+	fakeDAL.onCreate = func(key model.ConnectionKey) {
+		assert.NoError(t, fakeDAL.QueueIngressConnectionMessage(ctx, key, dal.IngressConnectionMessage{Body: []byte("early")}))
+		assert.NoError(t, connections.Deliver(ctx, key))
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections.Handle(sch, routes, nil, w, r.WithContext(ctx))
+	}))
+	t.Cleanup(srv.Close)
+
+	conn, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/chat", nil)
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+	t.Cleanup(func() { _ = conn.Close() })
+
+	assert.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, data, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, "early", string(data))
+}
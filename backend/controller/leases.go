@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/leases"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func (s *Service) ListLeases(ctx context.Context, req *connect.Request[ftlv1.ListLeasesRequest]) (*connect.Response[ftlv1.ListLeasesResponse], error) {
+	held, err := s.dal.GetLeases(ctx, optional.Ptr(req.Msg.Module))
+	if err != nil {
+		return nil, fmt.Errorf("could not list leases: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListLeasesResponse{
+		Leases: slices.Map(held, leaseInfoToProto),
+	}), nil
+}
+
+func (s *Service) ReleaseLease(ctx context.Context, req *connect.Request[ftlv1.ReleaseLeaseRequest]) (*connect.Response[ftlv1.ReleaseLeaseResponse], error) {
+	key, err := leases.ParseLeaseKey(req.Msg.Key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	err = s.dal.ForceReleaseLease(ctx, key)
+	if errors.Is(err, dal.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("lease %s is not held", key))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not release lease: %w", err)
+	}
+	log.FromContext(ctx).Infof("Released lease %s", key)
+	return connect.NewResponse(&ftlv1.ReleaseLeaseResponse{}), nil
+}
+
+func leaseInfoToProto(lease dal.LeaseInfo) *ftlv1.Lease {
+	out := &ftlv1.Lease{
+		Key:          lease.Key.String(),
+		CreatedAt:    timestamppb.New(lease.CreatedAt),
+		ExpiresAt:    timestamppb.New(lease.ExpiresAt),
+		FencingToken: lease.FencingToken,
+	}
+	if module, userKey, ok := lease.Key.Module(); ok {
+		out.Module = &module
+		out.UserKey = userKey
+	}
+	return out
+}
@@ -2,6 +2,7 @@ package leases
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/puzpuzpuz/xsync/v3"
@@ -17,21 +18,25 @@ var _ Leaser = (*FakeLeaser)(nil)
 
 // FakeLeaser is a fake implementation of the [Leaser] interface.
 type FakeLeaser struct {
-	leases *xsync.MapOf[string, struct{}]
+	leases        *xsync.MapOf[string, struct{}]
+	fencingTokens atomic.Int64
 }
 
 func (f *FakeLeaser) AcquireLease(ctx context.Context, key Key, ttl time.Duration) (Lease, error) {
 	if _, loaded := f.leases.LoadOrStore(key.String(), struct{}{}); loaded {
 		return nil, ErrConflict
 	}
-	return &FakeLease{leaser: f, key: key}, nil
+	return &FakeLease{leaser: f, key: key, fencingToken: f.fencingTokens.Add(1)}, nil
 }
 
 type FakeLease struct {
-	leaser *FakeLeaser
-	key    Key
+	leaser       *FakeLeaser
+	key          Key
+	fencingToken int64
 }
 
+func (f *FakeLease) FencingToken() int64 { return f.fencingToken }
+
 func (f *FakeLease) Release() error {
 	f.leaser.leases.Delete(f.key.String())
 	return nil
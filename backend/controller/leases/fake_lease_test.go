@@ -22,6 +22,7 @@ func TestFakeLease(t *testing.T) {
 
 	lease2, err := leaser.AcquireLease(context.Background(), SystemKey("test"), time.Second)
 	assert.NoError(t, err)
+	assert.True(t, lease2.FencingToken() > lease1.FencingToken(), "fencing tokens should increase")
 	err = lease2.Release()
 	assert.NoError(t, err)
 }
@@ -29,6 +29,15 @@ func ModuleKey(module string, parts ...string) Key {
 	return append([]string{"module", module}, parts...)
 }
 
+// Module returns the module and user key parts of a key created with
+// ModuleKey.
+func (l Key) Module() (module string, key []string, ok bool) {
+	if len(l) < 2 || l[0] != "module" {
+		return "", nil, false
+	}
+	return l[1], l[2:], true
+}
+
 var _ sql.Scanner = (*Key)(nil)
 var _ driver.Valuer = (*Key)(nil)
 
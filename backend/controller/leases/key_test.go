@@ -0,0 +1,21 @@
+package leases
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestKeyModule(t *testing.T) {
+	key, err := ParseLeaseKey(ModuleKey("echo", "user", "bob/smith").String())
+	assert.NoError(t, err)
+	module, userKey, ok := key.Module()
+	assert.True(t, ok)
+	assert.Equal(t, "echo", module)
+	assert.Equal(t, []string{"user", "bob/smith"}, userKey)
+
+	key, err = ParseLeaseKey(SystemKey("scheduledtask", "reap").String())
+	assert.NoError(t, err)
+	_, _, ok = key.Module()
+	assert.False(t, ok)
+}
@@ -28,5 +28,10 @@ type Leaser interface {
 
 // Lease represents a lease that is held by a controller.
 type Lease interface {
+	// FencingToken returns the token of this acquisition of the lease.
+	//
+	// Tokens increase with every lease acquired, so a holder that has lost
+	// its lease can be told apart from one that acquired it since.
+	FencingToken() int64
 	Release() error
 }
@@ -5,4 +5,5 @@ var NoopLease Lease = noopLease{}
 
 type noopLease struct{}
 
-func (noopLease) Release() error { return nil }
+func (noopLease) FencingToken() int64 { return 0 }
+func (noopLease) Release() error      { return nil }
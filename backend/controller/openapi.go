@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// serveOpenAPI serves an OpenAPI document describing the HTTP ingress routes
+// of all active deployments that serve the request's host.
+func (s *Service) serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	sch, err := s.getActiveSchema(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	// Ingress routes by the host of the request, so the document describes
+	// the routes for the host it was requested from.
+	doc, err := schema.OpenAPI(sch, &url.URL{Scheme: scheme, Host: r.Host, Path: "/ingress"})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(doc); err != nil {
+		log.FromContext(r.Context()).Errorf(err, "Could not write OpenAPI document")
+	}
+}
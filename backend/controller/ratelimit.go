@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// checkRateLimit counts a call against the "+ratelimit" of its verb, if any.
+//
+// Calls are counted in the database so that limits hold across controllers.
+// Calls over the limit are rejected with a ResourceExhausted error carrying a
+// "Retry-After" header with the number of seconds until the window ends.
+func (s *Service) checkRateLimit(ctx context.Context, verbRef *schema.Ref, verb *schema.Verb, header http.Header, callers []*schema.Ref, sourceAddress string) error {
+	md, ok := verb.GetMetadataRateLimit().Get()
+	if !ok {
+		return nil
+	}
+	period, err := md.PeriodDuration()
+	if err != nil {
+		return fmt.Errorf("verb %q: %w", verbRef, err)
+	}
+	now := time.Now()
+	windowStart := now.Truncate(period)
+	windowEnd := windowStart.Add(period)
+	count, err := s.dal.IncrementRateLimit(ctx, rateLimitKey(verbRef, md, header, callers, sourceAddress), windowStart, windowEnd)
+	if err != nil {
+		return err
+	}
+	if count <= int64(md.Limit) {
+		return nil
+	}
+	log.FromContext(ctx).Debugf("Rate limit of %d/%s exceeded for %s", md.Limit, md.Period, verbRef)
+	cerr := connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("verb %q: rate limit of %d/%s exceeded", verbRef, md.Limit, md.Period))
+	cerr.Meta().Set("Retry-After", strconv.Itoa(int(math.Ceil(windowEnd.Sub(now).Seconds()))))
+	return cerr
+}
+
+// rateLimitKey returns the key calls to a rate limited verb are counted under.
+//
+// Calls are counted per value of a request header, per client IP address, or
+// per calling module, with calls from outside any module counted together.
+func rateLimitKey(verbRef *schema.Ref, md *schema.MetadataRateLimit, header http.Header, callers []*schema.Ref, sourceAddress string) string {
+	var subject string
+	switch md.By {
+	case "":
+	case "ip":
+		subject = sourceAddress
+		if host, _, err := net.SplitHostPort(sourceAddress); err == nil {
+			subject = host
+		}
+	case "caller-module":
+		if len(callers) > 0 {
+			subject = callers[len(callers)-1].Module
+		}
+	default:
+		if name, ok := md.Header(); ok {
+			subject = header.Get(name)
+		}
+	}
+	return verbRef.String() + "|" + md.By + "|" + subject
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestRateLimitKey(t *testing.T) {
+	verbRef := &schema.Ref{Module: "echo", Name: "echo"}
+	header := http.Header{"X-Api-Key": {"secret"}}
+	callers := []*schema.Ref{{Module: "alpha", Name: "a"}, {Module: "beta", Name: "b"}}
+	for _, test := range []struct {
+		by       string
+		callers  []*schema.Ref
+		expected string
+	}{
+		{by: "", callers: callers, expected: "echo.echo||"},
+		{by: "ip", callers: callers, expected: "echo.echo|ip|127.0.0.1"},
+		{by: "caller-module", callers: callers, expected: "echo.echo|caller-module|beta"},
+		{by: "caller-module", expected: "echo.echo|caller-module|"},
+		{by: "header:X-Api-Key", callers: callers, expected: "echo.echo|header:X-Api-Key|secret"},
+		{by: "header:X-Other", callers: callers, expected: "echo.echo|header:X-Other|"},
+	} {
+		t.Run(test.by, func(t *testing.T) {
+			md := &schema.MetadataRateLimit{Limit: 10, Period: "s", By: test.by}
+			assert.Equal(t, test.expected, rateLimitKey(verbRef, md, header, test.callers, "127.0.0.1:54321"))
+		})
+	}
+}
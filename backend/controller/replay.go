@@ -0,0 +1,300 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	pbconsole "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+const (
+	// grpcRequestKey is the key of requests made directly to the controller,
+	// eg. by "ftl call".
+	grpcRequestKey = "grpc"
+	// replayRequestKey is the key of requests created by a replay.
+	replayRequestKey = "replay"
+)
+
+// Replay re-issues the root call of a recorded request, and diffs the new
+// response against the recorded one.
+//
+// Requests received through HTTP ingress are re-issued as HTTP requests through
+// the ingress handler, so that routing, authentication and encoding are
+// replayed too, and their HTTP responses are diffed.
+func (c *ConsoleService) Replay(ctx context.Context, req *connect.Request[pbconsole.ReplayRequest]) (*connect.Response[pbconsole.ReplayResponse], error) {
+	requestKey, err := model.ParseRequestKey(req.Msg.RequestKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var deployment optional.Option[model.DeploymentKey]
+	if req.Msg.DeploymentKey != nil {
+		key, err := model.ParseDeploymentKey(*req.Msg.DeploymentKey)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		deployment = optional.Some(key)
+	}
+
+	recordedHTTP, err := c.dal.GetIngressHTTPRequest(ctx, requestKey)
+	if err == nil {
+		return c.replayHTTP(ctx, requestKey, recordedHTTP, deployment, req.Peer().Addr)
+	} else if !errors.Is(err, dal.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get HTTP request for %s: %w", requestKey, err)
+	}
+	if isHTTPIngressRequest(requestKey) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s: the original HTTP request was not recorded", requestKey))
+	}
+
+	original, err := c.rootCallForRequest(ctx, requestKey)
+	if err != nil {
+		return nil, err
+	}
+
+	replayKey, callReq := replayCall(requestKey, original)
+	log.FromContext(ctx).Infof("Replaying %s as %s", requestKey, replayKey)
+	resp, err := c.call(ctx, connect.NewRequest(callReq), optional.Some(replayKey), deployment, req.Peer().Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pbconsole.ReplayResponse{
+		RequestKey: replayKey.String(),
+		Original:   eventDALToProto(original).GetCall(),
+	}
+	var replayed []byte
+	if callErr := resp.Msg.GetError(); callErr != nil {
+		out.Error = &callErr.Message
+		replayed = []byte(callErr.Message)
+	} else {
+		replayed = resp.Msg.GetBody()
+		out.Response = string(replayed)
+	}
+	recorded := original.Response
+	if callErr, ok := original.Error.Get(); ok {
+		recorded = []byte(callErr)
+	}
+	out.Diff = diffResponses(requestKey.String(), replayKey.String(), recorded, replayed)
+	return connect.NewResponse(out), nil
+}
+
+// replayHTTP re-issues a recorded HTTP ingress request through the ingress
+// handler, and diffs the new HTTP response against the recorded one.
+func (c *ConsoleService) replayHTTP(
+	ctx context.Context,
+	requestKey model.RequestKey,
+	recorded dal.IngressHTTPRequest,
+	deployment optional.Option[model.DeploymentKey],
+	remoteAddr string,
+) (*connect.Response[pbconsole.ReplayResponse], error) {
+	original, err := c.rootCallForRequest(ctx, requestKey)
+	if err != nil {
+		return nil, err
+	}
+	r, err := replayHTTPRequest(ctx, recorded)
+	if err != nil {
+		return nil, err
+	}
+	r.RemoteAddr = remoteAddr
+
+	replayKey := model.NewRequestKey(model.OriginIngress, replayRequestKey)
+	log.FromContext(ctx).Infof("Replaying %s %s as %s", recorded.Method, recorded.Path, replayKey)
+	w := httptest.NewRecorder()
+	c.ingress(w, r, replayKey, deployment)
+	replayed := dal.IngressHTTPResponse{Status: w.Code, Header: w.Header(), Body: w.Body.Bytes()}
+
+	return connect.NewResponse(&pbconsole.ReplayResponse{
+		RequestKey: replayKey.String(),
+		Original:   eventDALToProto(original).GetCall(),
+		Response:   string(formatHTTPResponse(replayed)),
+		Diff:       diffHTTPResponses(requestKey.String(), replayKey.String(), recorded.Response, replayed),
+	}), nil
+}
+
+// isHTTPIngressRequest returns true if a request was received through HTTP
+// ingress, rather than being a direct call to the controller.
+func isHTTPIngressRequest(requestKey model.RequestKey) bool {
+	if requestKey.Payload.Origin != model.OriginIngress {
+		return false
+	}
+	return requestKey.Payload.Key != grpcRequestKey && requestKey.Payload.Key != replayRequestKey
+}
+
+// replayCall returns the key and verb call for a replay of a recorded root call.
+//
+// The replay keeps the origin of the recorded request.
+func replayCall(requestKey model.RequestKey, original *dal.CallEvent) (model.RequestKey, *ftlv1.CallRequest) {
+	return model.NewRequestKey(requestKey.Payload.Origin, replayRequestKey), &ftlv1.CallRequest{
+		Verb: original.DestVerb.ToProto().(*schemapb.Ref), //nolint:forcetypeassert
+		Body: original.Request,
+	}
+}
+
+// rootCallForRequest returns the call that initiated a request, ie. the first
+// call made without a calling verb.
+func (c *ConsoleService) rootCallForRequest(ctx context.Context, requestKey model.RequestKey) (*dal.CallEvent, error) {
+	events, err := c.dal.QueryEvents(ctx, 1000, dal.FilterRequests(requestKey), dal.FilterTypes(dal.EventTypeCall))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query calls for request %s: %w", requestKey, err)
+	}
+	var first *dal.CallEvent
+	for _, event := range events {
+		call, ok := event.(*dal.CallEvent)
+		if !ok {
+			continue
+		}
+		if !call.SourceVerb.Ok() {
+			return call, nil
+		}
+		if first == nil {
+			first = call
+		}
+	}
+	if first == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no calls recorded for request "+requestKey.String()))
+	}
+	return first, nil
+}
+
+// diffResponses returns a unified diff between two responses, or an empty
+// string if they are identical.
+//
+// JSON responses are normalised before diffing.
+func diffResponses(fromName, toName string, from, to []byte) string {
+	fromText := normaliseResponse(from)
+	toText := normaliseResponse(to)
+	if fromText == toText {
+		return ""
+	}
+	edits := myers.ComputeEdits(span.URIFromPath(fromName), fromText, toText)
+	return fmt.Sprint(gotextdiff.ToUnified(fromName, toName, fromText, edits))
+}
+
+// diffHTTPResponses returns a unified diff between two HTTP responses,
+// including their status and headers, or an empty string if they are
+// identical.
+func diffHTTPResponses(fromName, toName string, from, to dal.IngressHTTPResponse) string {
+	return diffResponses(fromName, toName, formatHTTPResponse(from), formatHTTPResponse(to))
+}
+
+// formatHTTPResponse formats an HTTP response as its status line, sorted
+// headers and normalised body.
+func formatHTTPResponse(resp dal.IngressHTTPResponse) []byte {
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "%d %s\n", resp.Status, http.StatusText(resp.Status))
+	keys := maps.Keys(resp.Header)
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range resp.Header[key] {
+			fmt.Fprintf(out, "%s: %s\n", key, value)
+		}
+	}
+	out.WriteString("\n")
+	out.WriteString(normaliseResponse(resp.Body))
+	return out.Bytes()
+}
+
+// recordHTTPRequest captures an HTTP ingress request so that it can be
+// replayed.
+//
+// The request body is read in full and replaced, so the request can still be
+// handled.
+func recordHTTPRequest(r *http.Request) (dal.IngressHTTPRequest, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return dal.IngressHTTPRequest{}, fmt.Errorf("failed to read request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return dal.IngressHTTPRequest{
+		Method: r.Method,
+		Host:   r.Host,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Header: r.Header.Clone(),
+		Body:   body,
+	}, nil
+}
+
+// replayHTTPRequest rebuilds a recorded HTTP ingress request.
+func replayHTTPRequest(ctx context.Context, recorded dal.IngressHTTPRequest) (*http.Request, error) {
+	u := &url.URL{Path: recorded.Path, RawQuery: recorded.Query}
+	r, err := http.NewRequestWithContext(ctx, recorded.Method, u.String(), bytes.NewReader(recorded.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild HTTP request: %w", err)
+	}
+	r.Host = recorded.Host
+	if recorded.Header != nil {
+		r.Header = recorded.Header.Clone()
+	}
+	return r, nil
+}
+
+// recordingResponseWriter records the response written to an
+// [http.ResponseWriter].
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	status int
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (w *recordingResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+		w.header = w.ResponseWriter.Header().Clone()
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap allows [http.ResponseController] to reach the underlying writer.
+func (w *recordingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// response returns the recorded response.
+func (w *recordingResponseWriter) response() dal.IngressHTTPResponse {
+	status, header := w.status, w.header
+	if status == 0 {
+		status, header = http.StatusOK, w.ResponseWriter.Header().Clone()
+	}
+	return dal.IngressHTTPResponse{Status: status, Header: header, Body: w.body.Bytes()}
+}
+
+func normaliseResponse(response []byte) string {
+	var value any
+	if err := json.Unmarshal(response, &value); err != nil {
+		return string(bytes.TrimSpace(response)) + "\n"
+	}
+	out, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return string(response) + "\n"
+	}
+	return string(out) + "\n"
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestDiffResponses(t *testing.T) {
+	// Formatting differences in JSON are ignored.
+	assert.Equal(t, "", diffResponses("a", "b", []byte(`{"name":"Alice","age":30}`), []byte(`{"age": 30, "name": "Alice"}`)))
+
+	assert.Equal(t, `--- a
++++ b
+@@ -1,4 +1,4 @@
+ {
+-  "age": 30,
++  "age": 31,
+   "name": "Alice"
+ }
+`, diffResponses("a", "b", []byte(`{"name":"Alice","age":30}`), []byte(`{"name":"Alice","age":31}`)))
+
+	assert.Equal(t, `--- a
++++ b
+@@ -1 +1 @@
+-{}
++verb failed
+`, diffResponses("a", "b", []byte(`{}`), []byte(`verb failed`)))
+}
+
+func TestReplayCall(t *testing.T) {
+	requestKey := model.NewRequestKey(model.OriginIngress, grpcRequestKey)
+	request := []byte(`{"id":"1"}`)
+	original := &dal.CallEvent{
+		RequestKey: optional.Some(requestKey),
+		DestVerb:   schema.Ref{Module: "users", Name: "get"},
+		Request:    request,
+	}
+
+	replayKey, req := replayCall(requestKey, original)
+	assert.Equal(t, model.OriginIngress, replayKey.Payload.Origin)
+	assert.NotEqual(t, requestKey, replayKey)
+	assert.Equal(t, "users", req.Verb.Module)
+	assert.Equal(t, "get", req.Verb.Name)
+	assert.Equal(t, request, req.Body)
+}
+
+func TestIsHTTPIngressRequest(t *testing.T) {
+	assert.True(t, isHTTPIngressRequest(model.NewRequestKey(model.OriginIngress, "GET /users/1")))
+	assert.True(t, isHTTPIngressRequest(model.NewRequestKey(model.OriginIngress, "WS /chat")))
+	// Direct calls and their replays are replayable.
+	assert.False(t, isHTTPIngressRequest(model.NewRequestKey(model.OriginIngress, grpcRequestKey)))
+	assert.False(t, isHTTPIngressRequest(model.NewRequestKey(model.OriginIngress, replayRequestKey)))
+	assert.False(t, isHTTPIngressRequest(model.NewRequestKey(model.OriginCron, "echo-cron")))
+}
+
+func TestReplayHTTPIngressRequest(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data Greeting {
+				name String
+			}
+
+			export verb greet(HttpRequest<test.Greeting>) HttpResponse<test.Greeting, String>
+				+ingress http POST /greet
+		}
+	`)
+	assert.NoError(t, err)
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+
+	// handle serves a request through the ingress handler, recording the verb
+	// requests it makes and responding with the given greeting.
+	var verbRequests [][]byte
+	handle := func(w http.ResponseWriter, r *http.Request, greeting string) {
+		ingress.Handler{
+			Routes:        []dal.IngressRoute{{Path: "/greet", Module: "test", Verb: "greet"}},
+			Authenticator: ingress.NewAuthenticator(),
+			Call: func(ctx context.Context, req *connect.Request[ftlv1.CallRequest], requestKey optional.Option[model.RequestKey], requestSource string) (*connect.Response[ftlv1.CallResponse], error) {
+				verbRequests = append(verbRequests, req.Msg.Body)
+				body, err := encoding.Marshal(ingress.HTTPResponse{Body: []byte(`{"name":"` + greeting + `"}`)})
+				assert.NoError(t, err)
+				return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: body}}), nil
+			},
+		}.Handle(sch, model.NewRequestKey(model.OriginIngress, "test"), w, r)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/greet?lang=en", strings.NewReader(`{"name":"Alice"}`)).WithContext(ctx)
+	r.Header.Set("Content-Type", "application/json")
+	recorded, err := recordHTTPRequest(r)
+	assert.NoError(t, err)
+	w := &recordingResponseWriter{ResponseWriter: httptest.NewRecorder()}
+	handle(w, r, "Alice")
+	recorded.Response = w.response()
+	assert.Equal(t, http.StatusOK, recorded.Response.Status)
+	assert.Equal(t, `{"name":"Alice"}`, string(recorded.Response.Body))
+
+	replay, err := replayHTTPRequest(ctx, recorded)
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPost, replay.Method)
+	assert.Equal(t, "/greet", replay.URL.Path)
+	assert.Equal(t, "lang=en", replay.URL.RawQuery)
+	assert.Equal(t, "application/json", replay.Header.Get("Content-Type"))
+
+	// The replay makes the same verb request, but gets a different response.
+	rec := httptest.NewRecorder()
+	handle(rec, replay, "Bob")
+	assert.Equal(t, 2, len(verbRequests))
+	assert.Equal(t, verbRequests[0], verbRequests[1])
+
+	replayed := dal.IngressHTTPResponse{Status: rec.Code, Header: rec.Header(), Body: rec.Body.Bytes()}
+	assert.Equal(t, "", diffHTTPResponses("a", "b", recorded.Response, recorded.Response))
+	assert.Equal(t, `--- a
++++ b
+@@ -2,5 +2,5 @@
+ Content-Type: application/json; charset=utf-8
+ 
+ {
+-  "name": "Alice"
++  "name": "Bob"
+ }
+`, diffHTTPResponses("a", "b", recorded.Response, replayed))
+}
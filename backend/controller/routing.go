@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// pickRoute selects a route to a runner for a module.
+//
+// If the module has a canary deployment, the canary receives its weighted
+// percentage of calls and the primary deployment the remainder. A runner is
+// then selected at random from the chosen deployment.
+//
+// [intn] is the source of randomness, eg. [math/rand.Intn].
+func pickRoute(routes []dal.Route, intn func(n int) int) dal.Route {
+	var primary, canary []dal.Route
+	weight := 0
+	for _, route := range routes {
+		if w, ok := route.CanaryWeight.Get(); ok {
+			canary = append(canary, route)
+			weight = w
+		} else {
+			primary = append(primary, route)
+		}
+	}
+	candidates := primary
+	if len(primary) == 0 || (len(canary) > 0 && intn(100) < weight) {
+		candidates = canary
+	}
+	return candidates[intn(len(candidates))]
+}
+
+// schemaForRoute returns the schema of the active deployments as seen by a
+// call routed to [route].
+//
+// This is the active schema, except that a call routed to a canary sees the
+// canary's module in place of the primary deployment's.
+func schemaForRoute(deployments []dal.Deployment, route dal.Route) (*schema.Schema, error) {
+	deployments = slices.Filter(deployments, func(d dal.Deployment) bool {
+		if d.Key.String() == route.Deployment.String() {
+			return true
+		}
+		if d.Module == route.Module && route.CanaryWeight.Ok() {
+			return false
+		}
+		return !d.CanaryWeight.Ok()
+	})
+	return schema.ValidateSchema(&schema.Schema{
+		Modules: slices.Map(deployments, func(d dal.Deployment) *schema.Module {
+			return d.Schema
+		}),
+	})
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestPickRoute(t *testing.T) {
+	primary := model.NewDeploymentKey("echo")
+	canary := model.NewDeploymentKey("echo")
+	routes := []dal.Route{
+		{Module: "echo", Deployment: primary, Runner: model.NewRunnerKey("localhost", "8892"), Endpoint: "http://localhost:8892"},
+		{Module: "echo", Deployment: primary, Runner: model.NewRunnerKey("localhost", "8893"), Endpoint: "http://localhost:8893"},
+		{Module: "echo", Deployment: canary, Runner: model.NewRunnerKey("localhost", "8894"), Endpoint: "http://localhost:8894", CanaryWeight: optional.Some(10)},
+	}
+	rnd := rand.New(rand.NewSource(0)) //nolint:gosec
+
+	const calls = 10000
+	canaryCalls := 0
+	for range calls {
+		if pickRoute(routes, rnd.Intn).Deployment.String() == canary.String() {
+			canaryCalls++
+		}
+	}
+	// Expect ~10% of calls to be routed to the canary, regardless of the
+	// number of runners in each deployment.
+	assert.True(t, canaryCalls > calls*8/100 && canaryCalls < calls*12/100, "%d of %d calls routed to canary", canaryCalls, calls)
+
+	// With no primary, all calls go to the canary.
+	for range 100 {
+		assert.Equal(t, canary.String(), pickRoute(routes[2:], rnd.Intn).Deployment.String())
+	}
+	// With no canary, all calls go to the primary.
+	for range 100 {
+		assert.Equal(t, primary.String(), pickRoute(routes[:2], rnd.Intn).Deployment.String())
+	}
+}
+
+func TestSchemaForRoute(t *testing.T) {
+	primary := model.NewDeploymentKey("echo")
+	canary := model.NewDeploymentKey("echo")
+	verb := func(request schema.Type) *schema.Module {
+		return &schema.Module{Name: "echo", Decls: []schema.Decl{
+			&schema.Verb{Name: "echo", Request: request, Response: &schema.Unit{}},
+		}}
+	}
+	deployments := []dal.Deployment{
+		{Key: primary, Module: "echo", Schema: verb(&schema.String{})},
+		{Key: canary, Module: "echo", Schema: verb(&schema.Int{}), CanaryWeight: optional.Some(10)},
+	}
+	requestType := func(route dal.Route) schema.Type {
+		sch, err := schemaForRoute(deployments, route)
+		assert.NoError(t, err)
+		v := &schema.Verb{}
+		err = sch.ResolveRefToType(&schema.Ref{Module: "echo", Name: "echo"}, v)
+		assert.NoError(t, err)
+		return v.Request
+	}
+
+	assert.Equal(t, schema.Type(&schema.String{}), requestType(dal.Route{Module: "echo", Deployment: primary}), assert.Exclude[schema.Position]())
+	assert.Equal(t, schema.Type(&schema.Int{}), requestType(dal.Route{Module: "echo", Deployment: canary, CanaryWeight: optional.Some(10)}), assert.Exclude[schema.Position]())
+}
@@ -2,19 +2,220 @@ package scaling
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
 )
 
+const (
+	runnerPort        = 8893
+	managedByLabel    = "app.kubernetes.io/managed-by"
+	managedByLabelVal = "ftl-controller"
+)
+
+// K8sConfig configures the Kubernetes runner scaling.
+type K8sConfig struct {
+	Namespace          string   `help:"Kubernetes namespace to create runner pods in." default:"default" env:"FTL_K8S_NAMESPACE"`
+	RunnerImage        string   `help:"Container image for runner pods." default:"ghcr.io/tbd54566975/ftl-runner" env:"FTL_K8S_RUNNER_IMAGE"`
+	ControllerEndpoint *url.URL `help:"Controller endpoint for runners to connect to." default:"http://ftl-controller" env:"FTL_K8S_CONTROLLER_ENDPOINT"`
+	CPURequest         string   `help:"CPU request for runner pods." default:"" env:"FTL_K8S_RUNNER_CPU_REQUEST"`
+	MemoryRequest      string   `help:"Memory request for runner pods." default:"" env:"FTL_K8S_RUNNER_MEMORY_REQUEST"`
+	CPULimit           string   `help:"CPU limit for runner pods." default:"" env:"FTL_K8S_RUNNER_CPU_LIMIT"`
+	MemoryLimit        string   `help:"Memory limit for runner pods." default:"" env:"FTL_K8S_RUNNER_MEMORY_LIMIT"`
+}
+
+func (c K8sConfig) resources() (corev1.ResourceRequirements, error) {
+	requirements := corev1.ResourceRequirements{}
+	for _, r := range []struct {
+		list     *corev1.ResourceList
+		name     corev1.ResourceName
+		quantity string
+	}{
+		{&requirements.Requests, corev1.ResourceCPU, c.CPURequest},
+		{&requirements.Requests, corev1.ResourceMemory, c.MemoryRequest},
+		{&requirements.Limits, corev1.ResourceCPU, c.CPULimit},
+		{&requirements.Limits, corev1.ResourceMemory, c.MemoryLimit},
+	} {
+		if r.quantity == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(r.quantity)
+		if err != nil {
+			return corev1.ResourceRequirements{}, fmt.Errorf("invalid %s quantity %q: %w", r.name, r.quantity, err)
+		}
+		if *r.list == nil {
+			*r.list = corev1.ResourceList{}
+		}
+		(*r.list)[r.name] = quantity
+	}
+	return requirements, nil
+}
+
 var _ RunnerScaling = (*K8sScaling)(nil)
 
+// K8sScaling manages runners as a set of pods in a Kubernetes namespace.
+//
+// Each runner is a standalone pod labelled as managed by the controller.
+// When scaling down only idle runners are terminated, matched to their pod by
+// IP address.
 type K8sScaling struct {
+	client    kubernetes.Interface
+	config    K8sConfig
+	resources corev1.ResourceRequirements
 }
 
-func NewK8sScaling() *K8sScaling {
-	return &K8sScaling{}
+// NewK8sScaling creates a new K8sScaling using the in-cluster Kubernetes
+// configuration.
+func NewK8sScaling(config K8sConfig) (*K8sScaling, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get in-cluster Kubernetes config: %w", err)
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	return newK8sScaling(client, config)
+}
+
+func newK8sScaling(client kubernetes.Interface, config K8sConfig) (*K8sScaling, error) {
+	resources, err := config.resources()
+	if err != nil {
+		return nil, err
+	}
+	return &K8sScaling{client: client, config: config, resources: resources}, nil
 }
 
 func (k *K8sScaling) SetReplicas(ctx context.Context, replicas int, idleRunners []model.RunnerKey) error {
+	logger := log.FromContext(ctx)
+	pods, err := k.runnerPods(ctx)
+	if err != nil {
+		return err
+	}
+
+	replicasToAdd := replicas - len(pods)
+	if replicasToAdd > 0 {
+		logger.Debugf("Adding %d runner pods", replicasToAdd)
+		for range replicasToAdd {
+			pod, err := k.client.CoreV1().Pods(k.config.Namespace).Create(ctx, k.runnerPod(), metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to create runner pod: %w", err)
+			}
+			logger.Debugf("Created runner pod: %s", pod.Name)
+		}
+		return nil
+	}
+
+	replicasToRemove := -replicasToAdd
+	if replicasToRemove == 0 {
+		return nil
+	}
+	podsByIP := map[string]corev1.Pod{}
+	for _, pod := range pods {
+		if pod.Status.PodIP != "" {
+			podsByIP[pod.Status.PodIP] = pod
+		}
+	}
+	for _, runner := range idleRunners {
+		if replicasToRemove == 0 {
+			break
+		}
+		hostname, ok := runner.Payload.Hostname.Get()
+		if !ok {
+			continue
+		}
+		pod, ok := podsByIP[hostname]
+		if !ok {
+			// Not a runner managed by us.
+			continue
+		}
+		logger.Debugf("Removing runner pod %s for idle runner %s", pod.Name, runner)
+		err := k.client.CoreV1().Pods(k.config.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to delete runner pod %s: %w", pod.Name, err)
+		}
+		delete(podsByIP, hostname)
+		replicasToRemove--
+	}
 	return nil
 }
+
+// runnerPods returns the live runner pods managed by the controller.
+//
+// Runner pods are never restarted, so pods whose runner has exited are deleted
+// here rather than left behind in the namespace.
+func (k *K8sScaling) runnerPods(ctx context.Context) ([]corev1.Pod, error) {
+	logger := log.FromContext(ctx)
+	list, err := k.client.CoreV1().Pods(k.config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{managedByLabel: managedByLabelVal}).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runner pods: %w", err)
+	}
+	out := make([]corev1.Pod, 0, len(list.Items))
+	for _, pod := range list.Items {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			logger.Debugf("Removing %s runner pod %s", strings.ToLower(string(pod.Status.Phase)), pod.Name)
+			err := k.client.CoreV1().Pods(k.config.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to delete runner pod %s: %w", pod.Name, err)
+			}
+			continue
+		}
+		out = append(out, pod)
+	}
+	return out, nil
+}
+
+func (k *K8sScaling) runnerPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "ftl-runner-",
+			Namespace:    k.config.Namespace,
+			Labels: map[string]string{
+				"app":          "ftl-runner",
+				managedByLabel: managedByLabelVal,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:  "app",
+				Image: k.config.RunnerImage,
+				Env: []corev1.EnvVar{
+					{Name: "MY_POD_IP", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+					{Name: "FTL_ENDPOINT", Value: k.config.ControllerEndpoint.String()},
+					{Name: "FTL_RUNNER_BIND", Value: fmt.Sprintf("http://$(MY_POD_IP):%d", runnerPort)},
+					{Name: "FTL_RUNNER_ADVERTISE", Value: ""},
+				},
+				Ports:     []corev1.ContainerPort{{ContainerPort: runnerPort}},
+				Resources: k.resources,
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(runnerPort)},
+					},
+					InitialDelaySeconds: 1,
+					PeriodSeconds:       2,
+					TimeoutSeconds:      2,
+					SuccessThreshold:    1,
+					FailureThreshold:    15,
+				},
+			}},
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestK8sScaling(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	client := newFakeClientset()
+	scaling, err := newK8sScaling(client, K8sConfig{
+		Namespace:          "ftl",
+		RunnerImage:        "ftl-runner:test",
+		ControllerEndpoint: must(url.Parse("http://ftl-controller")),
+		CPURequest:         "100m",
+		MemoryLimit:        "512Mi",
+	})
+	assert.NoError(t, err)
+
+	err = scaling.SetReplicas(ctx, 3, nil)
+	assert.NoError(t, err)
+	pods := listPods(t, client)
+	assert.Equal(t, 3, len(pods))
+	container := pods[0].Spec.Containers[0]
+	assert.Equal(t, "ftl-runner:test", container.Image)
+	assert.Equal(t, resource.MustParse("100m"), container.Resources.Requests[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("512Mi"), container.Resources.Limits[corev1.ResourceMemory])
+
+	// Scaling up again is idempotent.
+	err = scaling.SetReplicas(ctx, 3, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(listPods(t, client)))
+
+	// Only idle runners are terminated, even if more replicas should be removed.
+	for i, pod := range pods {
+		pod.Status.PodIP = fmt.Sprintf("10.0.0.%d", i+1)
+		_, err := client.CoreV1().Pods("ftl").UpdateStatus(ctx, &pod, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+	}
+	err = scaling.SetReplicas(ctx, 0, []model.RunnerKey{
+		model.NewRunnerKey("10.0.0.2", "8893"),
+		model.NewRunnerKey("10.9.9.9", "8893"), // Not managed by the scaler.
+	})
+	assert.NoError(t, err)
+	remaining := listPods(t, client)
+	assert.Equal(t, 2, len(remaining))
+	for _, pod := range remaining {
+		assert.NotEqual(t, "10.0.0.2", pod.Status.PodIP)
+	}
+
+	// Idle runners are preferred, in order, up to the number to remove.
+	err = scaling.SetReplicas(ctx, 1, []model.RunnerKey{
+		model.NewRunnerKey("10.0.0.3", "8893"),
+		model.NewRunnerKey("10.0.0.1", "8893"),
+	})
+	assert.NoError(t, err)
+	remaining = listPods(t, client)
+	assert.Equal(t, 1, len(remaining))
+	assert.Equal(t, "10.0.0.1", remaining[0].Status.PodIP)
+}
+
+func TestK8sScalingIgnoresUnmanagedPods(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	client := newFakeClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ftl", Labels: map[string]string{"app": "ftl-runner"}},
+	})
+	scaling, err := newK8sScaling(client, K8sConfig{Namespace: "ftl", ControllerEndpoint: must(url.Parse("http://ftl-controller"))})
+	assert.NoError(t, err)
+
+	err = scaling.SetReplicas(ctx, 1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(listPods(t, client)))
+}
+
+func TestK8sScalingRemovesExitedPods(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	client := newFakeClientset()
+	scaling, err := newK8sScaling(client, K8sConfig{Namespace: "ftl", ControllerEndpoint: must(url.Parse("http://ftl-controller"))})
+	assert.NoError(t, err)
+
+	err = scaling.SetReplicas(ctx, 3, nil)
+	assert.NoError(t, err)
+	pods := listPods(t, client)
+	for i, phase := range []corev1.PodPhase{corev1.PodSucceeded, corev1.PodFailed} {
+		pods[i].Status.Phase = phase
+		_, err := client.CoreV1().Pods("ftl").UpdateStatus(ctx, &pods[i], metav1.UpdateOptions{})
+		assert.NoError(t, err)
+	}
+
+	// Exited pods are deleted and replaced.
+	err = scaling.SetReplicas(ctx, 3, nil)
+	assert.NoError(t, err)
+	remaining := listPods(t, client)
+	assert.Equal(t, 3, len(remaining))
+	for _, pod := range remaining {
+		assert.NotEqual(t, pods[0].Name, pod.Name)
+		assert.NotEqual(t, pods[1].Name, pod.Name)
+	}
+}
+
+func TestK8sScalingInvalidResources(t *testing.T) {
+	_, err := newK8sScaling(newFakeClientset(), K8sConfig{CPULimit: "lots"})
+	assert.Error(t, err)
+}
+
+// newFakeClientset returns a fake clientset that supports GenerateName, which
+// the default object tracker does not.
+func newFakeClientset(objects ...runtime.Object) *fake.Clientset {
+	client := fake.NewSimpleClientset(objects...)
+	count := 0
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod) //nolint:forcetypeassert
+		if pod.Name == "" && pod.GenerateName != "" {
+			count++
+			pod.Name = fmt.Sprintf("%s%d", pod.GenerateName, count)
+		}
+		return false, nil, nil
+	})
+	return client
+}
+
+func listPods(t *testing.T, client *fake.Clientset) []corev1.Pod {
+	t.Helper()
+	pods, err := client.CoreV1().Pods("ftl").List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	return pods.Items
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -67,6 +67,7 @@ const (
 	AsyncCallStateExecuting AsyncCallState = "executing"
 	AsyncCallStateSuccess   AsyncCallState = "success"
 	AsyncCallStateError     AsyncCallState = "error"
+	AsyncCallStateDiscarded AsyncCallState = "discarded"
 )
 
 func (e *AsyncCallState) Scan(src interface{}) error {
@@ -146,6 +147,49 @@ func (ns NullControllerState) Value() (driver.Value, error) {
 	return string(ns.ControllerState), nil
 }
 
+type CronJobConcurrency string
+
+const (
+	CronJobConcurrencyForbid  CronJobConcurrency = "forbid"
+	CronJobConcurrencyAllow   CronJobConcurrency = "allow"
+	CronJobConcurrencyReplace CronJobConcurrency = "replace"
+)
+
+func (e *CronJobConcurrency) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CronJobConcurrency(s)
+	case string:
+		*e = CronJobConcurrency(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CronJobConcurrency: %T", src)
+	}
+	return nil
+}
+
+type NullCronJobConcurrency struct {
+	CronJobConcurrency CronJobConcurrency
+	Valid              bool // Valid is true if CronJobConcurrency is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCronJobConcurrency) Scan(value interface{}) error {
+	if value == nil {
+		ns.CronJobConcurrency, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CronJobConcurrency.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCronJobConcurrency) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CronJobConcurrency), nil
+}
+
 type CronJobState string
 
 const (
@@ -281,6 +325,7 @@ const (
 	OriginIngress Origin = "ingress"
 	OriginCron    Origin = "cron"
 	OriginPubsub  Origin = "pubsub"
+	OriginFsm     Origin = "fsm"
 )
 
 func (e *Origin) Scan(src interface{}) error {
@@ -362,24 +407,72 @@ func (ns NullRunnerState) Value() (driver.Value, error) {
 	return string(ns.RunnerState), nil
 }
 
+type TopicSubscriptionState string
+
+const (
+	TopicSubscriptionStateIdle      TopicSubscriptionState = "idle"
+	TopicSubscriptionStateExecuting TopicSubscriptionState = "executing"
+)
+
+func (e *TopicSubscriptionState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TopicSubscriptionState(s)
+	case string:
+		*e = TopicSubscriptionState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TopicSubscriptionState: %T", src)
+	}
+	return nil
+}
+
+type NullTopicSubscriptionState struct {
+	TopicSubscriptionState TopicSubscriptionState
+	Valid                  bool // Valid is true if TopicSubscriptionState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTopicSubscriptionState) Scan(value interface{}) error {
+	if value == nil {
+		ns.TopicSubscriptionState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TopicSubscriptionState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTopicSubscriptionState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TopicSubscriptionState), nil
+}
+
 type Artefact struct {
 	ID        int64
 	CreatedAt time.Time
 	Digest    []byte
-	Content   []byte
+}
+
+type ArtefactContent struct {
+	Digest  []byte
+	Content []byte
 }
 
 type AsyncCall struct {
-	ID        int64
-	CreatedAt time.Time
-	LeaseID   optional.Option[int64]
-	Verb      schema.Ref
-	State     AsyncCallState
-	Origin    AsyncCallOrigin
-	OriginKey string
-	Request   []byte
-	Response  []byte
-	Error     optional.Option[string]
+	ID           int64
+	CreatedAt    time.Time
+	LeaseID      optional.Option[int64]
+	Verb         schema.Ref
+	State        AsyncCallState
+	Origin       AsyncCallOrigin
+	OriginKey    string
+	Request      []byte
+	Response     []byte
+	Error        optional.Option[string]
+	ScheduledAt  time.Time
+	RetryAttempt int32
 }
 
 type Controller struct {
@@ -400,17 +493,22 @@ type CronJob struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
+	Paused        bool
+	Concurrency   model.CronJobConcurrency
+	Jitter        time.Duration
+	CatchupLimit  int32
 	ModuleName    string
 }
 
 type Deployment struct {
-	ID          int64
-	CreatedAt   time.Time
-	ModuleID    int64
-	Key         model.DeploymentKey
-	Schema      *schema.Module
-	Labels      []byte
-	MinReplicas int32
+	ID           int64
+	CreatedAt    time.Time
+	ModuleID     int64
+	Key          model.DeploymentKey
+	Schema       *schema.Module
+	Labels       []byte
+	MinReplicas  int32
+	CanaryWeight optional.Option[int32]
 }
 
 type DeploymentArtefact struct {
@@ -435,23 +533,60 @@ type Event struct {
 }
 
 type FsmExecution struct {
+	ID               int64
+	CreatedAt        time.Time
+	Fsm              schema.Ref
+	Key              string
+	Status           FsmStatus
+	CurrentState     NullSchemaRef
+	DestinationState NullSchemaRef
+	AsyncCallID      optional.Option[int64]
+}
+
+type FsmTransition struct {
+	ID               int64
+	CreatedAt        time.Time
+	FsmExecutionsID  int64
+	SourceState      NullSchemaRef
+	DestinationState schema.Ref
+	AsyncCallsID     int64
+}
+
+type IngressConnection struct {
+	ID           int64
+	Key          model.ConnectionKey
+	CreatedAt    time.Time
+	ControllerID int64
+	Module       string
+	Verb         string
+}
+
+type IngressConnectionMessage struct {
 	ID        int64
 	CreatedAt time.Time
-	Key       string
-	Name      string
-	Status    FsmStatus
-	State     string
+	Key       model.ConnectionKey
+	Body      []byte
+	IsBinary  bool
 }
 
-type FsmTransition struct {
+type IngressHttpRequest struct {
 	ID              int64
-	FsmExecutionsID int64
-	AsyncCallsID    int64
+	RequestID       int64
+	Method          string
+	Host            string
+	Path            string
+	Query           string
+	Headers         []byte
+	Body            []byte
+	ResponseStatus  int32
+	ResponseHeaders []byte
+	ResponseBody    []byte
 }
 
 type IngressRoute struct {
 	Method       string
 	Path         string
+	Host         string
 	DeploymentID int64
 	Module       string
 	Verb         string
@@ -463,6 +598,7 @@ type Lease struct {
 	Key            leases.Key
 	CreatedAt      time.Time
 	ExpiresAt      time.Time
+	FencingToken   int64
 }
 
 type Module struct {
@@ -471,6 +607,13 @@ type Module struct {
 	Name     string
 }
 
+type RateLimit struct {
+	Key         string
+	WindowStart time.Time
+	ExpiresAt   time.Time
+	Count       int64
+}
+
 type Request struct {
 	ID         int64
 	Origin     Origin
@@ -493,7 +636,7 @@ type Runner struct {
 
 type Topic struct {
 	ID        int64
-	Key       interface{}
+	Key       model.TopicKey
 	CreatedAt time.Time
 	ModuleID  int64
 	Name      string
@@ -502,6 +645,7 @@ type Topic struct {
 
 type TopicEvent struct {
 	ID        int64
+	Key       model.TopicEventKey
 	CreatedAt time.Time
 	TopicID   int64
 	Payload   []byte
@@ -509,7 +653,7 @@ type TopicEvent struct {
 
 type TopicSubscriber struct {
 	ID                   int64
-	Key                  interface{}
+	Key                  model.SubscriberKey
 	CreatedAt            time.Time
 	TopicSubscriptionsID int64
 	DeploymentID         int64
@@ -517,10 +661,14 @@ type TopicSubscriber struct {
 }
 
 type TopicSubscription struct {
-	ID        int64
-	Key       interface{}
-	CreatedAt time.Time
-	TopicID   int64
-	Name      string
-	Cursor    int64
+	ID                int64
+	Key               model.SubscriptionKey
+	CreatedAt         time.Time
+	TopicID           int64
+	ModuleID          int64
+	Name              string
+	Cursor            optional.Option[int64]
+	State             TopicSubscriptionState
+	AsyncCallID       optional.Option[int64]
+	DeliveringEventID optional.Option[int64]
 }
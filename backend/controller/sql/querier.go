@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/TBD54566975/ftl/backend/controller/leases"
+	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/alecthomas/types/optional"
 	"github.com/google/uuid"
@@ -18,43 +19,84 @@ type Querier interface {
 	// Reserve a pending async call for execution, returning the associated lease
 	// reservation key.
 	AcquireAsyncCall(ctx context.Context, ttl time.Duration) (AcquireAsyncCallRow, error)
-	AddAsyncCall(ctx context.Context, arg AddAsyncCallParams) (bool, error)
+	AddAsyncCall(ctx context.Context, arg AddAsyncCallParams) (int64, error)
 	AssociateArtefactWithDeployment(ctx context.Context, arg AssociateArtefactWithDeploymentParams) error
-	CompleteAsyncCall(ctx context.Context, response []byte, error optional.Option[string], iD int64) (bool, error)
-	// Create a new artefact and return the artefact ID.
-	CreateArtefact(ctx context.Context, digest []byte, content []byte) (int64, error)
+	BeginConsumingTopicEvent(ctx context.Context, event int64, asyncCallID int64, subscription model.SubscriptionKey) error
+	// Move the cursor past the event delivered by a successful async call.
+	CompleteEventForSubscription(ctx context.Context, subscription model.SubscriptionKey, asyncCallID int64) error
+	// Create a new artefact, or lock an existing artefact and restart its grace
+	// period for garbage collection.
+	CreateArtefact(ctx context.Context, digest []byte) error
 	CreateCronJob(ctx context.Context, arg CreateCronJobParams) error
 	CreateDeployment(ctx context.Context, moduleName string, schema []byte, key model.DeploymentKey) error
+	CreateIngressConnection(ctx context.Context, arg CreateIngressConnectionParams) error
 	CreateIngressRoute(ctx context.Context, arg CreateIngressRouteParams) error
 	CreateRequest(ctx context.Context, origin Origin, key model.RequestKey, sourceAddr string) error
+	DeleteArtefactContent(ctx context.Context, digest []byte) error
+	DeleteEvents(ctx context.Context, ids []int64) (int64, error)
+	DeleteExpiredRateLimits(ctx context.Context, now time.Time) (int64, error)
+	DeleteIngressConnection(ctx context.Context, key model.ConnectionKey) error
+	// Delete the connections of controllers that are dead.
+	DeleteStaleIngressConnections(ctx context.Context) (int64, error)
+	// Delete an artefact if it is older than the grace period and is still not
+	// referenced by any deployment.
+	DeleteUnreferencedArtefact(ctx context.Context, digest []byte, gracePeriod time.Duration) (int64, error)
+	// Remove and return the messages queued for a connection.
+	DequeueIngressConnectionMessages(ctx context.Context, key model.ConnectionKey) ([]DequeueIngressConnectionMessagesRow, error)
 	DeregisterRunner(ctx context.Context, key model.RunnerKey) (int64, error)
+	// Move the cursor of a subscription blocked on a discarded async call past the
+	// event the call was delivering.
+	DiscardEventForAsyncCall(ctx context.Context, asyncCallID int64) error
+	DiscardFailedAsyncCall(ctx context.Context, id int64) (AsyncCallOrigin, error)
 	EndCronJob(ctx context.Context, nextExecution time.Time, key model.CronJobKey, startTime time.Time) (EndCronJobRow, error)
 	ExpireLeases(ctx context.Context) (int64, error)
 	ExpireRunnerReservations(ctx context.Context) (int64, error)
+	FailAsyncCall(ctx context.Context, error string, iD int64) (bool, error)
+	// Fails the execution, abandoning the current transition.
+	FailFSMExecution(ctx context.Context, fsm schema.Ref, key string) (bool, error)
+	// Completes the current transition, moving the execution to the destination state.
+	FinishFSMTransition(ctx context.Context, fsm schema.Ref, key string) (bool, error)
+	// Release a lease regardless of who holds it.
+	ForceReleaseLease(ctx context.Context, key leases.Key) (bool, error)
 	GetActiveControllers(ctx context.Context) ([]Controller, error)
+	// Canary deployments are excluded, as they are not part of the active schema.
 	GetActiveDeploymentSchemas(ctx context.Context) ([]GetActiveDeploymentSchemasRow, error)
 	GetActiveDeployments(ctx context.Context) ([]GetActiveDeploymentsRow, error)
 	GetActiveIngressRoutes(ctx context.Context) ([]GetActiveIngressRoutesRow, error)
 	GetActiveRunners(ctx context.Context) ([]GetActiveRunnersRow, error)
-	GetArtefactContentRange(ctx context.Context, start int32, count int32, iD int64) ([]byte, error)
-	// Return the digests that exist in the database.
-	GetArtefactDigests(ctx context.Context, digests [][]byte) ([]GetArtefactDigestsRow, error)
+	GetArtefactContentRange(ctx context.Context, start int32, count int32, digest []byte) ([]byte, error)
 	GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error)
 	GetDeployment(ctx context.Context, key model.DeploymentKey) (GetDeploymentRow, error)
 	// Get all artefacts matching the given digests.
 	GetDeploymentArtefacts(ctx context.Context, deploymentID int64) ([]GetDeploymentArtefactsRow, error)
+	// Get the changes to min_replicas of all deployments of a module, oldest first.
+	GetDeploymentReplicaHistoryForModule(ctx context.Context, moduleName string) ([]GetDeploymentReplicaHistoryForModuleRow, error)
 	GetDeploymentsByID(ctx context.Context, ids []int64) ([]Deployment, error)
+	// Get all deployments of a module, active or not, most recent first.
+	GetDeploymentsForModule(ctx context.Context, moduleName string) ([]GetDeploymentsForModuleRow, error)
 	// Get deployments that have a mismatch between the number of assigned and required replicas.
 	GetDeploymentsNeedingReconciliation(ctx context.Context) ([]GetDeploymentsNeedingReconciliationRow, error)
 	// Get all deployments that have artefacts matching the given digests.
 	GetDeploymentsWithArtefacts(ctx context.Context, digests [][]byte, schema []byte, count int64) ([]GetDeploymentsWithArtefactsRow, error)
 	GetDeploymentsWithMinReplicas(ctx context.Context) ([]GetDeploymentsWithMinReplicasRow, error)
 	GetExistingDeploymentForModule(ctx context.Context, name string) (GetExistingDeploymentForModuleRow, error)
+	// Get a batch of events of the given type created before the given time,
+	// optionally restricted to a single module, and excluding the given modules.
+	GetExpiredEvents(ctx context.Context, arg GetExpiredEventsParams) ([]GetExpiredEventsRow, error)
+	GetFSMExecution(ctx context.Context, fsm schema.Ref, key string) (FsmExecution, error)
+	GetFSMExecutionForAsyncCall(ctx context.Context, asyncCallID int64) (FsmExecution, error)
+	GetFailedAsyncCalls(ctx context.Context, origin NullAsyncCallOrigin) ([]AsyncCall, error)
 	GetIdleRunners(ctx context.Context, labels []byte, limit int64) ([]Runner, error)
+	GetIngressHTTPRequest(ctx context.Context, key model.RequestKey) (GetIngressHTTPRequestRow, error)
 	// Get the runner endpoints corresponding to the given ingress route.
 	GetIngressRoutes(ctx context.Context, method string) ([]GetIngressRoutesRow, error)
+	// Get all unexpired leases, optionally only those under a "/" terminated key
+	// prefix.
+	GetLeases(ctx context.Context, prefix optional.Option[string]) ([]GetLeasesRow, error)
 	GetModulesByID(ctx context.Context, ids []int64) ([]Module, error)
+	GetNextEventForSubscription(ctx context.Context, key model.SubscriptionKey) (GetNextEventForSubscriptionRow, error)
 	GetProcessList(ctx context.Context) ([]GetProcessListRow, error)
+	GetRandomSubscriber(ctx context.Context, key model.SubscriptionKey) (GetRandomSubscriberRow, error)
 	// Retrieve routing information for a runner.
 	GetRouteForRunner(ctx context.Context, key model.RunnerKey) (GetRouteForRunnerRow, error)
 	GetRoutingTable(ctx context.Context, modules []string) ([]GetRoutingTableRow, error)
@@ -62,27 +104,77 @@ type Querier interface {
 	GetRunnerState(ctx context.Context, key model.RunnerKey) (RunnerState, error)
 	GetRunnersForDeployment(ctx context.Context, key model.DeploymentKey) ([]GetRunnersForDeploymentRow, error)
 	GetStaleCronJobs(ctx context.Context, dollar_1 time.Duration) ([]GetStaleCronJobsRow, error)
+	// Returns idle subscriptions that have unconsumed events and at least one
+	// subscriber in an active deployment.
+	GetSubscriptionsNeedingUpdate(ctx context.Context) ([]GetSubscriptionsNeedingUpdateRow, error)
+	// Get the digests of artefacts older than the grace period that are not
+	// referenced by any deployment.
+	GetUnreferencedArtefacts(ctx context.Context, gracePeriod time.Duration, maxArtefacts int32) ([][]byte, error)
+	// Insert an archived event with its original ID, unless it already exists.
+	ImportEvent(ctx context.Context, arg ImportEventParams) (int64, error)
+	// Count a call against a rate limit window, starting a new window if the
+	// current one has ended, and return the number of calls in the window.
+	//
+	// Windows only move forward, so that calls from controllers with clocks that
+	// lag slightly behind count against the current window.
+	IncrementRateLimit(ctx context.Context, key string, windowStart time.Time, expiresAt time.Time) (int64, error)
 	InsertCallEvent(ctx context.Context, arg InsertCallEventParams) error
 	InsertDeploymentCreatedEvent(ctx context.Context, arg InsertDeploymentCreatedEventParams) error
 	InsertDeploymentUpdatedEvent(ctx context.Context, arg InsertDeploymentUpdatedEventParams) error
 	InsertEvent(ctx context.Context, arg InsertEventParams) error
+	// Record the HTTP request and response of an ingress request. Nothing is
+	// recorded if the request does not exist.
+	InsertIngressHTTPRequest(ctx context.Context, arg InsertIngressHTTPRequestParams) error
 	InsertLogEvent(ctx context.Context, arg InsertLogEventParams) error
+	InsertSubscriber(ctx context.Context, arg InsertSubscriberParams) error
+	// Whether the execution of a cron job that started at start_time is still
+	// running, ie. it has neither ended nor been replaced by a later execution.
+	IsCronJobExecuting(ctx context.Context, key model.CronJobKey, startTime time.Time) (bool, error)
 	// Mark any controller entries that haven't been updated recently as dead.
 	KillStaleControllers(ctx context.Context, timeout time.Duration) (int64, error)
 	KillStaleRunners(ctx context.Context, timeout time.Duration) (int64, error)
 	LoadAsyncCall(ctx context.Context, id int64) (AsyncCall, error)
-	NewLease(ctx context.Context, key leases.Key, ttl time.Duration) (uuid.UUID, error)
+	NewLease(ctx context.Context, key leases.Key, ttl time.Duration) (NewLeaseRow, error)
+	PublishEventForTopic(ctx context.Context, arg PublishEventForTopicParams) error
+	// Queue a message for a connection held by a live controller, returning the
+	// number of messages queued.
+	QueueIngressConnectionMessage(ctx context.Context, key model.ConnectionKey, body []byte, isBinary bool) (int64, error)
 	ReleaseLease(ctx context.Context, idempotencyKey uuid.UUID, key leases.Key) (bool, error)
 	RenewLease(ctx context.Context, ttl time.Duration, idempotencyKey uuid.UUID, key leases.Key) (bool, error)
 	ReplaceDeployment(ctx context.Context, oldDeployment model.DeploymentKey, newDeployment model.DeploymentKey, minReplicas int32) (int64, error)
+	// Move a failed async call back to pending so that it will be executed
+	// again, resetting its retry attempts.
+	RequeueFailedAsyncCall(ctx context.Context, id int64) (AsyncCallOrigin, error)
 	// Find an idle runner and reserve it for the given deployment.
 	ReserveRunner(ctx context.Context, reservationTimeout time.Time, deploymentKey model.DeploymentKey, labels []byte) (Runner, error)
-	// Creates a new FSM execution, including initial async call and transition.
-	//
-	// "key" is the unique identifier for the FSM execution.
-	SendFSMEvent(ctx context.Context, arg SendFSMEventParams) (int64, error)
+	// Resume a failed FSM execution whose transition is being retried, restoring
+	// the transition from the fsm_transitions record of the async call.
+	ResumeFSMExecutionForAsyncCall(ctx context.Context, asyncCallID int64) error
+	// Re-arm a subscription blocked on a failed async call that is being retried.
+	ResumeSubscriptionForAsyncCall(ctx context.Context, asyncCallID int64) error
+	// Reschedule a failed async call to be executed again after a backoff.
+	RetryAsyncCall(ctx context.Context, error string, backoff time.Duration, iD int64) (bool, error)
+	// Set the next execution of a cron job that is still executing, so that
+	// executions can overlap.
+	ScheduleCronJob(ctx context.Context, nextExecution time.Time, key model.CronJobKey, startTime time.Time) (ScheduleCronJobRow, error)
+	SetCronJobPaused(ctx context.Context, paused bool, nextExecution time.Time, key model.CronJobKey) (int64, error)
+	SetDeploymentCanaryWeight(ctx context.Context, canaryWeight optional.Option[int32], key model.DeploymentKey) error
 	SetDeploymentDesiredReplicas(ctx context.Context, key model.DeploymentKey, minReplicas int32) error
+	StartCanaryDeployment(ctx context.Context, minReplicas int32, canaryWeight int32, key model.DeploymentKey) error
 	StartCronJobs(ctx context.Context, keys []string) ([]StartCronJobsRow, error)
+	// Starts a new FSM transition, populating the destination state and async call ID.
+	//
+	// "key" is the unique identifier for the FSM execution.
+	StartFSMTransition(ctx context.Context, arg StartFSMTransitionParams) (int64, error)
+	SucceedAsyncCall(ctx context.Context, response []byte, iD int64) (bool, error)
+	SucceedFSMExecution(ctx context.Context, fsm schema.Ref, key string) (bool, error)
+	// Return the digests that exist in the database, locking them and restarting
+	// their grace period for garbage collection.
+	TouchArtefacts(ctx context.Context, digests [][]byte) ([]TouchArtefactsRow, error)
+	// Start executing an idle cron job immediately, whether or not it is due or
+	// paused.
+	TriggerCronJob(ctx context.Context, key model.CronJobKey) (TriggerCronJobRow, error)
+	UpsertArtefactContent(ctx context.Context, digest []byte, content []byte) error
 	UpsertController(ctx context.Context, key model.ControllerKey, endpoint string) (int64, error)
 	UpsertModule(ctx context.Context, language string, name string) (int64, error)
 	// Upsert a runner and return the deployment ID that it is assigned to, if any.
@@ -91,6 +183,9 @@ type Querier interface {
 	// there is no corresponding deployment, then the deployment ID is -1
 	// and the parent statement will fail due to a foreign key constraint.
 	UpsertRunner(ctx context.Context, arg UpsertRunnerParams) (optional.Option[int64], error)
+	// A new subscription starts consuming from the most recent event in the topic.
+	UpsertSubscription(ctx context.Context, arg UpsertSubscriptionParams) error
+	UpsertTopic(ctx context.Context, arg UpsertTopicParams) error
 }
 
 var _ Querier = (*Queries)(nil)
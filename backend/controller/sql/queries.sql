@@ -18,11 +18,13 @@ WHERE id = ANY (@ids::BIGINT[]);
 INSERT INTO deployments (module_id, "schema", "key")
 VALUES ((SELECT id FROM modules WHERE name = @module_name::TEXT LIMIT 1), @schema::BYTEA, @key::deployment_key);
 
--- name: GetArtefactDigests :many
--- Return the digests that exist in the database.
-SELECT id, digest
-FROM artefacts
-WHERE digest = ANY (@digests::bytea[]);
+-- name: TouchArtefacts :many
+-- Return the digests that exist in the database, locking them and restarting
+-- their grace period for garbage collection.
+UPDATE artefacts
+SET created_at = NOW() AT TIME ZONE 'utc'
+WHERE digest = ANY (@digests::bytea[])
+RETURNING id, digest;
 
 -- name: GetDeploymentArtefacts :many
 -- Get all artefacts matching the given digests.
@@ -31,11 +33,39 @@ FROM deployment_artefacts da
          INNER JOIN artefacts ON artefacts.id = da.artefact_id
 WHERE deployment_id = $1;
 
--- name: CreateArtefact :one
--- Create a new artefact and return the artefact ID.
-INSERT INTO artefacts (digest, content)
+-- name: CreateArtefact :exec
+-- Create a new artefact, or lock an existing artefact and restart its grace
+-- period for garbage collection.
+INSERT INTO artefacts (digest)
+VALUES ($1)
+ON CONFLICT (digest) DO UPDATE SET created_at = NOW() AT TIME ZONE 'utc';
+
+-- name: GetUnreferencedArtefacts :many
+-- Get the digests of artefacts older than the grace period that are not
+-- referenced by any deployment.
+SELECT a.digest
+FROM artefacts a
+WHERE a.created_at < (NOW() AT TIME ZONE 'utc') - sqlc.arg('grace_period')::interval
+  AND NOT EXISTS (SELECT 1 FROM deployment_artefacts da WHERE da.artefact_id = a.id)
+ORDER BY a.created_at
+LIMIT sqlc.arg('max_artefacts')::INT;
+
+-- name: DeleteUnreferencedArtefact :one
+-- Delete an artefact if it is older than the grace period and is still not
+-- referenced by any deployment.
+DELETE FROM artefacts a
+WHERE a.digest = sqlc.arg('digest')::BYTEA
+  AND a.created_at < (NOW() AT TIME ZONE 'utc') - sqlc.arg('grace_period')::interval
+  AND NOT EXISTS (SELECT 1 FROM deployment_artefacts da WHERE da.artefact_id = a.id)
+RETURNING a.id;
+
+-- name: UpsertArtefactContent :exec
+INSERT INTO artefact_contents (digest, content)
 VALUES ($1, $2)
-RETURNING id;
+ON CONFLICT (digest) DO NOTHING;
+
+-- name: DeleteArtefactContent :exec
+DELETE FROM artefact_contents WHERE digest = $1;
 
 -- name: AssociateArtefactWithDeployment :exec
 INSERT INTO deployment_artefacts (deployment_id, artefact_id, executable, path)
@@ -53,6 +83,17 @@ WITH update_container AS (
 SELECT COUNT(*)
 FROM update_container;
 
+-- name: StartCanaryDeployment :exec
+UPDATE deployments
+SET min_replicas = sqlc.arg('min_replicas')::INT,
+    canary_weight = sqlc.arg('canary_weight')::INT
+WHERE key = sqlc.arg('key')::deployment_key;
+
+-- name: SetDeploymentCanaryWeight :exec
+UPDATE deployments
+SET canary_weight = sqlc.narg('canary_weight')::INT
+WHERE key = sqlc.arg('key')::deployment_key;
+
 -- name: GetDeployment :one
 SELECT sqlc.embed(d), m.language, m.name AS module_name, d.min_replicas
 FROM deployments d
@@ -74,9 +115,9 @@ WHERE EXISTS (SELECT 1
 );
 
 -- name: GetArtefactContentRange :one
-SELECT SUBSTRING(a.content FROM @start FOR @count)::BYTEA AS content
-FROM artefacts a
-WHERE a.id = @id;
+SELECT SUBSTRING(c.content FROM @start FOR @count)::BYTEA AS content
+FROM artefact_contents c
+WHERE c.digest = @digest;
 
 -- name: UpsertRunner :one
 -- Upsert a runner and return the deployment ID that it is assigned to, if any.
@@ -158,8 +199,27 @@ FROM deployments d
 WHERE min_replicas > 0
 ORDER BY d.key;
 
+-- name: GetDeploymentsForModule :many
+-- Get all deployments of a module, active or not, most recent first.
+SELECT sqlc.embed(d), m.name AS module_name, m.language
+FROM deployments d
+  JOIN modules m ON d.module_id = m.id
+WHERE m.name = @module_name::TEXT
+ORDER BY d.created_at DESC, d.id DESC;
+
+-- name: GetDeploymentReplicaHistoryForModule :many
+-- Get the changes to min_replicas of all deployments of a module, oldest first.
+SELECT d.key AS deployment_key, e.time_stamp, (e.payload->>'min_replicas')::INT AS min_replicas
+FROM events e
+  JOIN deployments d ON e.deployment_id = d.id
+  JOIN modules m ON d.module_id = m.id
+WHERE m.name = @module_name::TEXT
+  AND e.type IN ('deployment_created', 'deployment_updated')
+ORDER BY e.time_stamp, e.id;
+
 -- name: GetActiveDeploymentSchemas :many
-SELECT key, schema FROM deployments WHERE min_replicas > 0;
+-- Canary deployments are excluded, as they are not part of the active schema.
+SELECT key, schema FROM deployments WHERE min_replicas > 0 AND canary_weight IS NULL;
 
 -- name: GetProcessList :many
 SELECT d.min_replicas,
@@ -192,6 +252,7 @@ FROM deployments d
          INNER JOIN modules m on d.module_id = m.id
 WHERE m.name = $1
   AND min_replicas > 0
+  AND canary_weight IS NULL
 LIMIT 1;
 
 -- name: GetDeploymentsNeedingReconciliation :many
@@ -246,7 +307,7 @@ FROM runners r
 WHERE r.key = sqlc.arg('key')::runner_key;
 
 -- name: GetRoutingTable :many
-SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key
+SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key, d.canary_weight
 FROM runners r
          LEFT JOIN deployments d on r.deployment_id = d.id
 WHERE state = 'assigned'
@@ -280,13 +341,15 @@ SELECT COUNT(*)
 FROM rows;
 
 -- name: GetCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
-WHERE d.min_replicas > 0;
+WHERE d.min_replicas > 0
+  -- Cron jobs are only executed by primary deployments, not canaries.
+  AND d.canary_weight IS NULL;
 
 -- name: CreateCronJob :exec
-INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_time, next_execution)
+INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_time, next_execution, concurrency, jitter, catchup_limit, paused)
   VALUES (
     sqlc.arg('key')::cron_job_key,
     (SELECT id FROM deployments WHERE key = sqlc.arg('deployment_key')::deployment_key LIMIT 1),
@@ -294,7 +357,17 @@ INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_ti
     sqlc.arg('verb')::TEXT,
     sqlc.arg('schedule')::TEXT,
     sqlc.arg('start_time')::TIMESTAMPTZ,
-    sqlc.arg('next_execution')::TIMESTAMPTZ);
+    sqlc.arg('next_execution')::TIMESTAMPTZ,
+    sqlc.arg('concurrency')::cron_job_concurrency,
+    sqlc.arg('jitter')::INTERVAL,
+    sqlc.arg('catchup_limit')::INT,
+    -- Inherit the paused state of the job being replaced.
+    COALESCE((SELECT paused
+              FROM cron_jobs
+              WHERE module_name = sqlc.arg('module_name')::TEXT
+                AND verb = sqlc.arg('verb')::TEXT
+              ORDER BY id DESC
+              LIMIT 1), FALSE));
 
 -- name: StartCronJobs :many
 WITH updates AS (
@@ -302,14 +375,17 @@ WITH updates AS (
   SET state = 'executing',
     start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
   WHERE key = ANY (sqlc.arg('keys'))
-    AND state = 'idle'
-    AND start_time < next_execution
+    -- Executions may only overlap if the job's concurrency policy allows it,
+    -- and the next execution has been scheduled since the job last started.
+    AND (state = 'idle' OR (concurrency <> 'forbid' AND start_time < next_execution))
+    AND NOT paused
     AND (next_execution AT TIME ZONE 'utc') < (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
   RETURNING id, key, state, start_time, next_execution)
 SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule,
   COALESCE(u.start_time, j.start_time) as start_time,
   COALESCE(u.next_execution, j.next_execution) as next_execution,
   COALESCE(u.state, j.state) as state,
+  j.paused, j.concurrency, j.jitter, j.catchup_limit,
   d.min_replicas > 0 as has_min_replicas,
   CASE WHEN u.key IS NULL THEN FALSE ELSE TRUE END as updated
 FROM cron_jobs j
@@ -327,13 +403,67 @@ UPDATE cron_jobs
     AND start_time = sqlc.arg('start_time')::TIMESTAMPTZ
   RETURNING *
 )
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
+  FROM j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LIMIT 1;
+
+-- name: ScheduleCronJob :one
+-- Set the next execution of a cron job that is still executing, so that
+-- executions can overlap.
+WITH j AS (
+UPDATE cron_jobs
+  SET next_execution = sqlc.arg('next_execution')::TIMESTAMPTZ
+  WHERE key = sqlc.arg('key')::cron_job_key
+    AND state = 'executing'
+    AND start_time = sqlc.arg('start_time')::TIMESTAMPTZ
+  RETURNING *
+)
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
+  FROM j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LIMIT 1;
+
+-- name: IsCronJobExecuting :one
+-- Whether the execution of a cron job that started at start_time is still
+-- running, ie. it has neither ended nor been replaced by a later execution.
+SELECT EXISTS (
+  SELECT 1
+  FROM cron_jobs
+  WHERE key = sqlc.arg('key')::cron_job_key
+    AND state = 'executing'
+    AND start_time = sqlc.arg('start_time')::TIMESTAMPTZ
+);
+
+-- name: SetCronJobPaused :one
+WITH updated AS (
+  UPDATE cron_jobs
+  SET paused = sqlc.arg('paused')::BOOLEAN,
+    next_execution = sqlc.arg('next_execution')::TIMESTAMPTZ
+  WHERE key = sqlc.arg('key')::cron_job_key
+  RETURNING 1
+)
+SELECT COUNT(*)
+FROM updated;
+
+-- name: TriggerCronJob :one
+-- Start executing an idle cron job immediately, whether or not it is due or
+-- paused.
+WITH j AS (
+UPDATE cron_jobs
+  SET state = 'executing',
+    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+  WHERE key = sqlc.arg('key')::cron_job_key
+    AND state = 'idle'
+  RETURNING *
+)
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
   FROM j
   INNER JOIN deployments d on j.deployment_id = d.id
   LIMIT 1;
 
 -- name: GetStaleCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE state = 'executing'
@@ -382,6 +512,53 @@ VALUES ((SELECT id
                 'min_replicas', sqlc.arg('min_replicas')::INT
             ));
 
+-- name: GetExpiredEvents :many
+-- Get a batch of events of the given type created before the given time,
+-- optionally restricted to a single module, and excluding the given modules.
+SELECT e.id,
+       e.time_stamp,
+       e.type,
+       d.key AS deployment_key,
+       m.name AS module_name,
+       ir.key AS request_key,
+       e.custom_key_1,
+       e.custom_key_2,
+       e.custom_key_3,
+       e.custom_key_4,
+       e.payload
+FROM events e
+  INNER JOIN deployments d ON e.deployment_id = d.id
+  INNER JOIN modules m ON d.module_id = m.id
+  LEFT JOIN requests ir ON e.request_id = ir.id
+WHERE e.type = sqlc.arg('type')::event_type
+  AND e.time_stamp < sqlc.arg('created_before')::TIMESTAMPTZ
+  AND (sqlc.narg('module')::TEXT IS NULL OR m.name = sqlc.narg('module')::TEXT)
+  AND NOT (m.name = ANY (sqlc.arg('exclude_modules')::TEXT[]))
+ORDER BY e.id
+LIMIT sqlc.arg('max_events')::INT;
+
+-- name: DeleteEvents :execrows
+DELETE FROM events WHERE id = ANY (sqlc.arg('ids')::BIGINT[]);
+
+-- name: ImportEvent :execrows
+-- Insert an archived event with its original ID, unless it already exists.
+INSERT INTO events (id, time_stamp, deployment_id, request_id, type,
+                    custom_key_1, custom_key_2, custom_key_3, custom_key_4, payload)
+VALUES (sqlc.arg('id')::BIGINT,
+        sqlc.arg('time_stamp')::TIMESTAMPTZ,
+        (SELECT id FROM deployments WHERE deployments.key = sqlc.arg('deployment_key')::deployment_key),
+        (CASE
+             WHEN sqlc.narg('request_key')::TEXT IS NULL THEN NULL
+             ELSE (SELECT id FROM requests ir WHERE ir.key = sqlc.narg('request_key')::TEXT)
+            END),
+        sqlc.arg('type')::event_type,
+        sqlc.narg('custom_key_1')::TEXT,
+        sqlc.narg('custom_key_2')::TEXT,
+        sqlc.narg('custom_key_3')::TEXT,
+        sqlc.narg('custom_key_4')::TEXT,
+        sqlc.arg('payload')::JSONB)
+ON CONFLICT (id) DO NOTHING;
+
 -- name: InsertCallEvent :exec
 INSERT INTO events (deployment_id, request_id, time_stamp, type,
                     custom_key_1, custom_key_2, custom_key_3, custom_key_4, payload)
@@ -408,6 +585,31 @@ VALUES ((SELECT id FROM deployments WHERE deployments.key = sqlc.arg('deployment
 INSERT INTO requests (origin, "key", source_addr)
 VALUES ($1, $2, $3);
 
+-- name: InsertIngressHTTPRequest :exec
+-- Record the HTTP request and response of an ingress request. Nothing is
+-- recorded if the request does not exist.
+INSERT INTO ingress_http_requests (request_id, method, host, path, query, headers, body,
+                                   response_status, response_headers, response_body)
+SELECT r.id,
+       sqlc.arg('method')::TEXT,
+       sqlc.arg('host')::TEXT,
+       sqlc.arg('path')::TEXT,
+       sqlc.arg('query')::TEXT,
+       sqlc.arg('headers')::JSONB,
+       sqlc.arg('body')::BYTEA,
+       sqlc.arg('response_status')::INT,
+       sqlc.arg('response_headers')::JSONB,
+       sqlc.arg('response_body')::BYTEA
+FROM requests r
+WHERE r.key = sqlc.arg('key')::request_key;
+
+-- name: GetIngressHTTPRequest :one
+SELECT ihr.method, ihr.host, ihr.path, ihr.query, ihr.headers, ihr.body,
+       ihr.response_status, ihr.response_headers, ihr.response_body
+FROM ingress_http_requests ihr
+INNER JOIN requests r ON ihr.request_id = r.id
+WHERE r.key = sqlc.arg('key')::request_key;
+
 -- name: UpsertController :one
 INSERT INTO controller (key, endpoint)
 VALUES ($1, $2)
@@ -426,6 +628,15 @@ WITH matches AS (
 SELECT COUNT(*)
 FROM matches;
 
+-- name: DeleteStaleIngressConnections :one
+-- Delete the connections of controllers that are dead.
+WITH matches AS (
+    DELETE FROM ingress_connections
+        WHERE controller_id IN (SELECT id FROM controller WHERE state = 'dead')
+        RETURNING 1)
+SELECT COUNT(*)
+FROM matches;
+
 -- name: GetActiveControllers :many
 SELECT *
 FROM controller c
@@ -433,12 +644,12 @@ WHERE c.state <> 'dead'
 ORDER BY c.key;
 
 -- name: CreateIngressRoute :exec
-INSERT INTO ingress_routes (deployment_id, module, verb, method, path)
-VALUES ((SELECT id FROM deployments WHERE key = sqlc.arg('key')::deployment_key LIMIT 1), $2, $3, $4, $5);
+INSERT INTO ingress_routes (deployment_id, module, verb, method, path, host)
+VALUES ((SELECT id FROM deployments WHERE key = sqlc.arg('key')::deployment_key LIMIT 1), $2, $3, $4, $5, $6);
 
 -- name: GetIngressRoutes :many
 -- Get the runner endpoints corresponding to the given ingress route.
-SELECT r.key AS runner_key, d.key AS deployment_key, endpoint, ir.path, ir.module, ir.verb
+SELECT r.key AS runner_key, d.key AS deployment_key, endpoint, ir.path, ir.host, ir.module, ir.verb
 FROM ingress_routes ir
          INNER JOIN runners r ON ir.deployment_id = r.deployment_id
          INNER JOIN deployments d ON ir.deployment_id = d.id
@@ -446,10 +657,12 @@ WHERE r.state = 'assigned'
   AND ir.method = $1;
 
 -- name: GetActiveIngressRoutes :many
-SELECT d.key AS deployment_key, ir.module, ir.verb, ir.method, ir.path
+SELECT d.key AS deployment_key, ir.module, ir.verb, ir.method, ir.path, ir.host
 FROM ingress_routes ir
          INNER JOIN deployments d ON ir.deployment_id = d.id
-WHERE d.min_replicas > 0;
+WHERE d.min_replicas > 0
+  -- Ingress routes are only served by primary deployments, not canaries.
+  AND d.canary_weight IS NULL;
 
 
 -- name: InsertEvent :exec
@@ -462,7 +675,7 @@ RETURNING id;
 -- name: NewLease :one
 INSERT INTO leases (idempotency_key, key, expires_at)
 VALUES (gen_random_uuid(), @key::lease_key, (NOW() AT TIME ZONE 'utc') + @ttl::interval)
-RETURNING idempotency_key;
+RETURNING idempotency_key, fencing_token;
 
 -- name: RenewLease :one
 UPDATE leases
@@ -475,6 +688,22 @@ DELETE FROM leases
 WHERE idempotency_key = @idempotency_key AND key = @key::lease_key
 RETURNING true;
 
+-- name: GetLeases :many
+-- Get all unexpired leases, optionally only those under a "/" terminated key
+-- prefix.
+SELECT key, created_at, expires_at, fencing_token
+FROM leases
+WHERE expires_at >= NOW() AT TIME ZONE 'utc'
+  AND (sqlc.narg('prefix')::TEXT IS NULL
+    OR starts_with(key || '/', sqlc.narg('prefix')::TEXT))
+ORDER BY key;
+
+-- name: ForceReleaseLease :one
+-- Release a lease regardless of who holds it.
+DELETE FROM leases
+WHERE key = @key::lease_key
+RETURNING true;
+
 -- name: ExpireLeases :one
 WITH expired AS (
     DELETE FROM leases
@@ -487,7 +716,7 @@ FROM expired;
 -- name: AddAsyncCall :one
 INSERT INTO async_calls (verb, origin, origin_key, request)
 VALUES (@verb, @origin, @origin_key, @request)
-RETURNING true;
+RETURNING id;
 
 -- name: AcquireAsyncCall :one
 -- Reserve a pending async call for execution, returning the associated lease
@@ -495,7 +724,8 @@ RETURNING true;
 WITH async_call AS (
   SELECT id
   FROM async_calls
-  WHERE state = 'pending'
+  WHERE state = 'pending' AND scheduled_at <= (NOW() AT TIME ZONE 'utc')
+  ORDER BY scheduled_at
   LIMIT 1
   FOR UPDATE SKIP LOCKED
 ), lease AS (
@@ -510,16 +740,36 @@ RETURNING
   id AS async_call_id,
   (SELECT idempotency_key FROM lease) AS lease_idempotency_key,
   (SELECT key FROM lease) AS lease_key,
+  (SELECT fencing_token FROM lease) AS lease_fencing_token,
   origin,
   origin_key,
   verb,
-  request;
+  request,
+  retry_attempt;
 
--- name: CompleteAsyncCall :one
+-- name: SucceedAsyncCall :one
 UPDATE async_calls
 SET state = 'success',
     response = @response,
-    error = @error
+    error = NULL
+WHERE id = @id
+RETURNING true;
+
+-- name: FailAsyncCall :one
+UPDATE async_calls
+SET state = 'error',
+    error = @error::TEXT
+WHERE id = @id
+RETURNING true;
+
+-- name: RetryAsyncCall :one
+-- Reschedule a failed async call to be executed again after a backoff.
+UPDATE async_calls
+SET state = 'pending',
+    lease_id = NULL,
+    error = @error::TEXT,
+    scheduled_at = (NOW() AT TIME ZONE 'utc') + @backoff::interval,
+    retry_attempt = retry_attempt + 1
 WHERE id = @id
 RETURNING true;
 
@@ -528,20 +778,317 @@ SELECT *
 FROM async_calls
 WHERE id = @id;
 
--- name: SendFSMEvent :one
--- Creates a new FSM execution, including initial async call and transition.
+-- name: GetFailedAsyncCalls :many
+SELECT *
+FROM async_calls
+WHERE state = 'error'
+  AND (sqlc.narg('origin')::async_call_origin IS NULL OR origin = sqlc.narg('origin')::async_call_origin)
+ORDER BY id;
+
+-- name: RequeueFailedAsyncCall :one
+-- Move a failed async call back to pending so that it will be executed
+-- again, resetting its retry attempts.
+UPDATE async_calls
+SET state = 'pending',
+    lease_id = NULL,
+    error = NULL,
+    scheduled_at = (NOW() AT TIME ZONE 'utc'),
+    retry_attempt = 0
+WHERE id = @id AND state = 'error'
+RETURNING origin;
+
+-- name: DiscardFailedAsyncCall :one
+UPDATE async_calls
+SET state = 'discarded'
+WHERE id = @id AND state = 'error'
+RETURNING origin;
+
+-- name: ResumeFSMExecutionForAsyncCall :exec
+-- Resume a failed FSM execution whose transition is being retried, restoring
+-- the transition from the fsm_transitions record of the async call.
+UPDATE fsm_executions
+SET status = 'running'::fsm_status,
+    destination_state = transitions.destination_state,
+    async_call_id = transitions.async_calls_id
+FROM fsm_transitions transitions
+WHERE transitions.async_calls_id = @async_call_id::BIGINT
+  AND fsm_executions.id = transitions.fsm_executions_id
+  AND fsm_executions.status = 'failed'::fsm_status;
+
+-- name: ResumeSubscriptionForAsyncCall :exec
+-- Re-arm a subscription blocked on a failed async call that is being retried.
+UPDATE topic_subscriptions
+SET state = 'executing'
+WHERE async_call_id = @async_call_id::BIGINT;
+
+-- name: DiscardEventForAsyncCall :exec
+-- Move the cursor of a subscription blocked on a discarded async call past the
+-- event the call was delivering.
+UPDATE topic_subscriptions
+SET state = 'idle',
+    cursor = COALESCE(delivering_event_id, cursor),
+    async_call_id = NULL,
+    delivering_event_id = NULL
+WHERE async_call_id = @async_call_id::BIGINT;
+
+-- name: StartFSMTransition :one
+-- Starts a new FSM transition, populating the destination state and async call ID.
 --
 -- "key" is the unique identifier for the FSM execution.
-WITH execution AS (
-  INSERT INTO fsm_executions (key, name, state)
-  VALUES (@key, @name, @state)
-  ON CONFLICT(key) DO UPDATE SET state = @state
-  RETURNING id
-), call AS (
+WITH call AS (
   INSERT INTO async_calls (verb, request, origin, origin_key)
-  VALUES (@verb, @request, 'fsm', @key)
+  VALUES (@destination_state, @request, 'fsm', @origin_key)
   RETURNING id
+), execution AS (
+  INSERT INTO fsm_executions AS executions (fsm, key, destination_state, async_call_id)
+  VALUES (@fsm, @key, @destination_state, (SELECT id FROM call))
+  ON CONFLICT (fsm, key) DO
+  UPDATE SET destination_state = EXCLUDED.destination_state,
+             async_call_id = EXCLUDED.async_call_id
+  -- Only one transition can be in progress at a time.
+  WHERE executions.destination_state IS NULL AND executions.status = 'running'
+  RETURNING id, current_state
+)
+INSERT INTO fsm_transitions (fsm_executions_id, source_state, destination_state, async_calls_id)
+VALUES (
+  (SELECT id FROM execution),
+  (SELECT current_state FROM execution),
+  @destination_state,
+  (SELECT id FROM call)
 )
-INSERT INTO fsm_transitions (fsm_executions_id, async_calls_id)
-VALUES ((SELECT id FROM execution), (SELECT id FROM call))
-RETURNING id;
\ No newline at end of file
+RETURNING id;
+
+-- name: GetFSMExecution :one
+SELECT *
+FROM fsm_executions
+WHERE fsm = @fsm::schema_ref AND key = @key::TEXT;
+
+-- name: GetFSMExecutionForAsyncCall :one
+SELECT *
+FROM fsm_executions
+WHERE async_call_id = @async_call_id::BIGINT;
+
+-- name: FinishFSMTransition :one
+-- Completes the current transition, moving the execution to the destination state.
+UPDATE fsm_executions
+SET current_state = destination_state,
+    destination_state = NULL,
+    async_call_id = NULL
+WHERE fsm = @fsm::schema_ref AND key = @key::TEXT
+RETURNING true;
+
+-- name: SucceedFSMExecution :one
+UPDATE fsm_executions
+SET status = 'completed'::fsm_status
+WHERE fsm = @fsm::schema_ref AND key = @key::TEXT
+RETURNING true;
+
+-- name: FailFSMExecution :one
+-- Fails the execution, abandoning the current transition.
+UPDATE fsm_executions
+SET status = 'failed'::fsm_status,
+    destination_state = NULL,
+    async_call_id = NULL
+WHERE fsm = @fsm::schema_ref AND key = @key::TEXT
+RETURNING true;
+
+-- name: UpsertTopic :exec
+INSERT INTO topics (key, module_id, name, type)
+VALUES (
+  sqlc.arg('topic')::topic_key,
+  (SELECT id FROM modules WHERE name = sqlc.arg('module')::TEXT LIMIT 1),
+  sqlc.arg('name')::TEXT,
+  sqlc.arg('event_type')::TEXT
+)
+ON CONFLICT (module_id, name) DO
+UPDATE SET type = sqlc.arg('event_type')::TEXT;
+
+-- name: UpsertSubscription :exec
+-- A new subscription starts consuming from the most recent event in the topic.
+INSERT INTO topic_subscriptions (key, topic_id, module_id, name, cursor)
+VALUES (
+  sqlc.arg('key')::subscription_key,
+  (
+    SELECT topics.id
+    FROM topics
+    INNER JOIN modules ON topics.module_id = modules.id
+    WHERE modules.name = sqlc.arg('topic_module')::TEXT
+      AND topics.name = sqlc.arg('topic_name')::TEXT
+  ),
+  (SELECT id FROM modules WHERE name = sqlc.arg('module')::TEXT),
+  sqlc.arg('name')::TEXT,
+  (
+    SELECT MAX(topic_events.id)
+    FROM topic_events
+    INNER JOIN topics ON topic_events.topic_id = topics.id
+    INNER JOIN modules ON topics.module_id = modules.id
+    WHERE modules.name = sqlc.arg('topic_module')::TEXT
+      AND topics.name = sqlc.arg('topic_name')::TEXT
+  )
+)
+ON CONFLICT (module_id, name) DO NOTHING;
+
+-- name: InsertSubscriber :exec
+INSERT INTO topic_subscribers (key, topic_subscriptions_id, deployment_id, verb)
+VALUES (
+  sqlc.arg('key')::subscriber_key,
+  (
+    SELECT topic_subscriptions.id
+    FROM topic_subscriptions
+    INNER JOIN modules ON topic_subscriptions.module_id = modules.id
+    WHERE modules.name = sqlc.arg('module')::TEXT
+      AND topic_subscriptions.name = sqlc.arg('subscription_name')::TEXT
+  ),
+  (SELECT id FROM deployments WHERE key = sqlc.arg('deployment')::deployment_key),
+  sqlc.arg('verb')::TEXT
+);
+
+-- name: PublishEventForTopic :exec
+INSERT INTO topic_events (key, topic_id, payload)
+VALUES (
+  sqlc.arg('key')::topic_event_key,
+  (
+    SELECT topics.id
+    FROM topics
+    INNER JOIN modules ON topics.module_id = modules.id
+    WHERE modules.name = sqlc.arg('module')::TEXT
+      AND topics.name = sqlc.arg('topic')::TEXT
+  ),
+  sqlc.arg('payload')
+);
+
+-- name: GetSubscriptionsNeedingUpdate :many
+-- Returns idle subscriptions that have unconsumed events and at least one
+-- subscriber in an active deployment.
+SELECT subs.key::subscription_key AS key, modules.name AS module_name, subs.name
+FROM topic_subscriptions subs
+INNER JOIN modules ON subs.module_id = modules.id
+WHERE subs.state = 'idle'
+  AND EXISTS (
+    SELECT 1
+    FROM topic_events
+    WHERE topic_events.topic_id = subs.topic_id
+      AND topic_events.id > COALESCE(subs.cursor, 0)
+  )
+  AND EXISTS (
+    SELECT 1
+    FROM topic_subscribers
+    INNER JOIN deployments ON topic_subscribers.deployment_id = deployments.id
+    WHERE topic_subscribers.topic_subscriptions_id = subs.id
+      AND deployments.min_replicas > 0
+  )
+ORDER BY subs.created_at
+FOR UPDATE OF subs SKIP LOCKED;
+
+-- name: GetRandomSubscriber :one
+SELECT subscribers.verb AS sink, modules.name AS module_name, deployments.key AS deployment_key
+FROM topic_subscribers AS subscribers
+INNER JOIN deployments ON subscribers.deployment_id = deployments.id
+INNER JOIN modules ON deployments.module_id = modules.id
+WHERE subscribers.topic_subscriptions_id = (
+    SELECT id
+    FROM topic_subscriptions
+    WHERE key = sqlc.arg('key')::subscription_key
+  )
+  AND deployments.min_replicas > 0
+ORDER BY RANDOM()
+LIMIT 1;
+
+-- name: GetNextEventForSubscription :one
+SELECT topic_events.id, topic_events.key, topic_events.payload
+FROM topic_events
+INNER JOIN topic_subscriptions subs ON topic_events.topic_id = subs.topic_id
+WHERE subs.key = sqlc.arg('key')::subscription_key
+  AND topic_events.id > COALESCE(subs.cursor, 0)
+ORDER BY topic_events.id
+LIMIT 1;
+
+-- name: BeginConsumingTopicEvent :exec
+UPDATE topic_subscriptions
+SET state = 'executing',
+    delivering_event_id = sqlc.arg('event')::BIGINT,
+    async_call_id = sqlc.arg('async_call_id')::BIGINT
+WHERE key = sqlc.arg('subscription')::subscription_key;
+
+-- name: CompleteEventForSubscription :exec
+-- Move the cursor past the event delivered by a successful async call.
+UPDATE topic_subscriptions
+SET state = 'idle',
+    cursor = COALESCE(delivering_event_id, cursor),
+    async_call_id = NULL,
+    delivering_event_id = NULL
+WHERE key = sqlc.arg('subscription')::subscription_key
+  AND async_call_id = sqlc.arg('async_call_id')::BIGINT;
+
+-- name: CreateIngressConnection :exec
+INSERT INTO ingress_connections ("key", controller_id, module, verb)
+VALUES (
+  sqlc.arg('key')::connection_key,
+  (SELECT id FROM controller WHERE controller.key = sqlc.arg('controller')::controller_key),
+  sqlc.arg('module')::TEXT,
+  sqlc.arg('verb')::TEXT
+);
+
+-- name: DeleteIngressConnection :exec
+DELETE FROM ingress_connections
+WHERE "key" = sqlc.arg('key')::connection_key;
+
+-- name: QueueIngressConnectionMessage :one
+-- Queue a message for a connection held by a live controller, returning the
+-- number of messages queued.
+WITH connection AS (
+  SELECT ic."key"
+  FROM ingress_connections ic
+  INNER JOIN controller c ON ic.controller_id = c.id
+  WHERE ic."key" = sqlc.arg('key')::connection_key
+    AND c.state <> 'dead'
+), queued AS (
+  INSERT INTO ingress_connection_messages ("key", body, is_binary)
+  SELECT connection."key", sqlc.arg('body')::BYTEA, sqlc.arg('is_binary')::BOOLEAN
+  FROM connection
+  RETURNING 1
+)
+SELECT COUNT(*)
+FROM queued;
+
+-- name: DequeueIngressConnectionMessages :many
+-- Remove and return the messages queued for a connection.
+DELETE FROM ingress_connection_messages
+WHERE id IN (
+  SELECT id
+  FROM ingress_connection_messages
+  WHERE "key" = sqlc.arg('key')::connection_key
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, body, is_binary;
+
+-- name: IncrementRateLimit :one
+-- Count a call against a rate limit window, starting a new window if the
+-- current one has ended, and return the number of calls in the window.
+--
+-- Windows only move forward, so that calls from controllers with clocks that
+-- lag slightly behind count against the current window.
+INSERT INTO rate_limits ("key", window_start, expires_at, count)
+VALUES (
+  sqlc.arg('key')::TEXT,
+  sqlc.arg('window_start')::TIMESTAMPTZ,
+  sqlc.arg('expires_at')::TIMESTAMPTZ,
+  1
+)
+ON CONFLICT ("key") DO UPDATE
+SET count = CASE
+    WHEN rate_limits.window_start >= EXCLUDED.window_start THEN rate_limits.count + 1
+    ELSE 1
+  END,
+  window_start = GREATEST(rate_limits.window_start, EXCLUDED.window_start),
+  expires_at = GREATEST(rate_limits.expires_at, EXCLUDED.expires_at)
+RETURNING count;
+
+-- name: DeleteExpiredRateLimits :one
+WITH deleted AS (
+  DELETE FROM rate_limits
+  WHERE expires_at < sqlc.arg('now')::TIMESTAMPTZ
+  RETURNING 1
+)
+SELECT COUNT(*)
+FROM deleted;
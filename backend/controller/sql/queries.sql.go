@@ -21,13 +21,14 @@ const acquireAsyncCall = `-- name: AcquireAsyncCall :one
 WITH async_call AS (
   SELECT id
   FROM async_calls
-  WHERE state = 'pending'
+  WHERE state = 'pending' AND scheduled_at <= (NOW() AT TIME ZONE 'utc')
+  ORDER BY scheduled_at
   LIMIT 1
   FOR UPDATE SKIP LOCKED
 ), lease AS (
   INSERT INTO leases (idempotency_key, key, expires_at)
   VALUES (gen_random_uuid(), '/system/async_call/' || (SELECT id FROM async_call), (NOW() AT TIME ZONE 'utc') + $1::interval)
-  RETURNING id, idempotency_key, key, created_at, expires_at
+  RETURNING id, idempotency_key, key, created_at, expires_at, fencing_token
 )
 UPDATE async_calls
 SET state = 'executing', lease_id = (SELECT id FROM lease)
@@ -36,20 +37,24 @@ RETURNING
   id AS async_call_id,
   (SELECT idempotency_key FROM lease) AS lease_idempotency_key,
   (SELECT key FROM lease) AS lease_key,
+  (SELECT fencing_token FROM lease) AS lease_fencing_token,
   origin,
   origin_key,
   verb,
-  request
+  request,
+  retry_attempt
 `
 
 type AcquireAsyncCallRow struct {
 	AsyncCallID         int64
 	LeaseIdempotencyKey uuid.UUID
 	LeaseKey            leases.Key
+	LeaseFencingToken   int64
 	Origin              AsyncCallOrigin
 	OriginKey           string
 	Verb                schema.Ref
 	Request             []byte
+	RetryAttempt        int32
 }
 
 // Reserve a pending async call for execution, returning the associated lease
@@ -61,10 +66,12 @@ func (q *Queries) AcquireAsyncCall(ctx context.Context, ttl time.Duration) (Acqu
 		&i.AsyncCallID,
 		&i.LeaseIdempotencyKey,
 		&i.LeaseKey,
+		&i.LeaseFencingToken,
 		&i.Origin,
 		&i.OriginKey,
 		&i.Verb,
 		&i.Request,
+		&i.RetryAttempt,
 	)
 	return i, err
 }
@@ -72,7 +79,7 @@ func (q *Queries) AcquireAsyncCall(ctx context.Context, ttl time.Duration) (Acqu
 const addAsyncCall = `-- name: AddAsyncCall :one
 INSERT INTO async_calls (verb, origin, origin_key, request)
 VALUES ($1, $2, $3, $4)
-RETURNING true
+RETURNING id
 `
 
 type AddAsyncCallParams struct {
@@ -82,16 +89,16 @@ type AddAsyncCallParams struct {
 	Request   []byte
 }
 
-func (q *Queries) AddAsyncCall(ctx context.Context, arg AddAsyncCallParams) (bool, error) {
+func (q *Queries) AddAsyncCall(ctx context.Context, arg AddAsyncCallParams) (int64, error) {
 	row := q.db.QueryRow(ctx, addAsyncCall,
 		arg.Verb,
 		arg.Origin,
 		arg.OriginKey,
 		arg.Request,
 	)
-	var column_1 bool
-	err := row.Scan(&column_1)
-	return column_1, err
+	var id int64
+	err := row.Scan(&id)
+	return id, err
 }
 
 const associateArtefactWithDeployment = `-- name: AssociateArtefactWithDeployment :exec
@@ -116,38 +123,50 @@ func (q *Queries) AssociateArtefactWithDeployment(ctx context.Context, arg Assoc
 	return err
 }
 
-const completeAsyncCall = `-- name: CompleteAsyncCall :one
-UPDATE async_calls
-SET state = 'success',
-    response = $1,
-    error = $2
-WHERE id = $3
-RETURNING true
+const beginConsumingTopicEvent = `-- name: BeginConsumingTopicEvent :exec
+UPDATE topic_subscriptions
+SET state = 'executing',
+    delivering_event_id = $1::BIGINT,
+    async_call_id = $2::BIGINT
+WHERE key = $3::subscription_key
 `
 
-func (q *Queries) CompleteAsyncCall(ctx context.Context, response []byte, error optional.Option[string], iD int64) (bool, error) {
-	row := q.db.QueryRow(ctx, completeAsyncCall, response, error, iD)
-	var column_1 bool
-	err := row.Scan(&column_1)
-	return column_1, err
+func (q *Queries) BeginConsumingTopicEvent(ctx context.Context, event int64, asyncCallID int64, subscription model.SubscriptionKey) error {
+	_, err := q.db.Exec(ctx, beginConsumingTopicEvent, event, asyncCallID, subscription)
+	return err
 }
 
-const createArtefact = `-- name: CreateArtefact :one
-INSERT INTO artefacts (digest, content)
-VALUES ($1, $2)
-RETURNING id
+const completeEventForSubscription = `-- name: CompleteEventForSubscription :exec
+UPDATE topic_subscriptions
+SET state = 'idle',
+    cursor = COALESCE(delivering_event_id, cursor),
+    async_call_id = NULL,
+    delivering_event_id = NULL
+WHERE key = $1::subscription_key
+  AND async_call_id = $2::BIGINT
 `
 
-// Create a new artefact and return the artefact ID.
-func (q *Queries) CreateArtefact(ctx context.Context, digest []byte, content []byte) (int64, error) {
-	row := q.db.QueryRow(ctx, createArtefact, digest, content)
-	var id int64
-	err := row.Scan(&id)
-	return id, err
+// Move the cursor past the event delivered by a successful async call.
+func (q *Queries) CompleteEventForSubscription(ctx context.Context, subscription model.SubscriptionKey, asyncCallID int64) error {
+	_, err := q.db.Exec(ctx, completeEventForSubscription, subscription, asyncCallID)
+	return err
+}
+
+const createArtefact = `-- name: CreateArtefact :exec
+INSERT INTO artefacts (digest)
+VALUES ($1)
+ON CONFLICT (digest) DO UPDATE SET created_at = NOW() AT TIME ZONE 'utc'
+`
+
+// Create a new artefact, or lock an existing artefact and restart its grace
+// period for garbage collection.
+func (q *Queries) CreateArtefact(ctx context.Context, digest []byte) error {
+	_, err := q.db.Exec(ctx, createArtefact, digest)
+	return err
 }
 
 const createCronJob = `-- name: CreateCronJob :exec
-INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_time, next_execution)
+INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_time, next_execution, concurrency, jitter, catchup_limit, paused)
   VALUES (
     $1::cron_job_key,
     (SELECT id FROM deployments WHERE key = $2::deployment_key LIMIT 1),
@@ -155,7 +174,17 @@ INSERT INTO cron_jobs (key, deployment_id, module_name, verb, schedule, start_ti
     $4::TEXT,
     $5::TEXT,
     $6::TIMESTAMPTZ,
-    $7::TIMESTAMPTZ)
+    $7::TIMESTAMPTZ,
+    $8::cron_job_concurrency,
+    $9::INTERVAL,
+    $10::INT,
+    -- Inherit the paused state of the job being replaced.
+    COALESCE((SELECT paused
+              FROM cron_jobs
+              WHERE module_name = $3::TEXT
+                AND verb = $4::TEXT
+              ORDER BY id DESC
+              LIMIT 1), FALSE))
 `
 
 type CreateCronJobParams struct {
@@ -166,6 +195,9 @@ type CreateCronJobParams struct {
 	Schedule      string
 	StartTime     time.Time
 	NextExecution time.Time
+	Concurrency   model.CronJobConcurrency
+	Jitter        time.Duration
+	CatchupLimit  int32
 }
 
 func (q *Queries) CreateCronJob(ctx context.Context, arg CreateCronJobParams) error {
@@ -177,6 +209,9 @@ func (q *Queries) CreateCronJob(ctx context.Context, arg CreateCronJobParams) er
 		arg.Schedule,
 		arg.StartTime,
 		arg.NextExecution,
+		arg.Concurrency,
+		arg.Jitter,
+		arg.CatchupLimit,
 	)
 	return err
 }
@@ -191,9 +226,36 @@ func (q *Queries) CreateDeployment(ctx context.Context, moduleName string, schem
 	return err
 }
 
+const createIngressConnection = `-- name: CreateIngressConnection :exec
+INSERT INTO ingress_connections ("key", controller_id, module, verb)
+VALUES (
+  $1::connection_key,
+  (SELECT id FROM controller WHERE controller.key = $2::controller_key),
+  $3::TEXT,
+  $4::TEXT
+)
+`
+
+type CreateIngressConnectionParams struct {
+	Key        model.ConnectionKey
+	Controller model.ControllerKey
+	Module     string
+	Verb       string
+}
+
+func (q *Queries) CreateIngressConnection(ctx context.Context, arg CreateIngressConnectionParams) error {
+	_, err := q.db.Exec(ctx, createIngressConnection,
+		arg.Key,
+		arg.Controller,
+		arg.Module,
+		arg.Verb,
+	)
+	return err
+}
+
 const createIngressRoute = `-- name: CreateIngressRoute :exec
-INSERT INTO ingress_routes (deployment_id, module, verb, method, path)
-VALUES ((SELECT id FROM deployments WHERE key = $1::deployment_key LIMIT 1), $2, $3, $4, $5)
+INSERT INTO ingress_routes (deployment_id, module, verb, method, path, host)
+VALUES ((SELECT id FROM deployments WHERE key = $1::deployment_key LIMIT 1), $2, $3, $4, $5, $6)
 `
 
 type CreateIngressRouteParams struct {
@@ -202,6 +264,7 @@ type CreateIngressRouteParams struct {
 	Verb   string
 	Method string
 	Path   string
+	Host   string
 }
 
 func (q *Queries) CreateIngressRoute(ctx context.Context, arg CreateIngressRouteParams) error {
@@ -211,6 +274,7 @@ func (q *Queries) CreateIngressRoute(ctx context.Context, arg CreateIngressRoute
 		arg.Verb,
 		arg.Method,
 		arg.Path,
+		arg.Host,
 	)
 	return err
 }
@@ -225,6 +289,126 @@ func (q *Queries) CreateRequest(ctx context.Context, origin Origin, key model.Re
 	return err
 }
 
+const deleteArtefactContent = `-- name: DeleteArtefactContent :exec
+DELETE FROM artefact_contents WHERE digest = $1
+`
+
+func (q *Queries) DeleteArtefactContent(ctx context.Context, digest []byte) error {
+	_, err := q.db.Exec(ctx, deleteArtefactContent, digest)
+	return err
+}
+
+const deleteEvents = `-- name: DeleteEvents :execrows
+DELETE FROM events WHERE id = ANY ($1::BIGINT[])
+`
+
+func (q *Queries) DeleteEvents(ctx context.Context, ids []int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEvents, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpiredRateLimits = `-- name: DeleteExpiredRateLimits :one
+WITH deleted AS (
+  DELETE FROM rate_limits
+  WHERE expires_at < $1::TIMESTAMPTZ
+  RETURNING 1
+)
+SELECT COUNT(*)
+FROM deleted
+`
+
+func (q *Queries) DeleteExpiredRateLimits(ctx context.Context, now time.Time) (int64, error) {
+	row := q.db.QueryRow(ctx, deleteExpiredRateLimits, now)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteIngressConnection = `-- name: DeleteIngressConnection :exec
+DELETE FROM ingress_connections
+WHERE "key" = $1::connection_key
+`
+
+func (q *Queries) DeleteIngressConnection(ctx context.Context, key model.ConnectionKey) error {
+	_, err := q.db.Exec(ctx, deleteIngressConnection, key)
+	return err
+}
+
+const deleteStaleIngressConnections = `-- name: DeleteStaleIngressConnections :one
+WITH matches AS (
+    DELETE FROM ingress_connections
+        WHERE controller_id IN (SELECT id FROM controller WHERE state = 'dead')
+        RETURNING 1)
+SELECT COUNT(*)
+FROM matches
+`
+
+// Delete the connections of controllers that are dead.
+func (q *Queries) DeleteStaleIngressConnections(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, deleteStaleIngressConnections)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteUnreferencedArtefact = `-- name: DeleteUnreferencedArtefact :one
+DELETE FROM artefacts a
+WHERE a.digest = $1::BYTEA
+  AND a.created_at < (NOW() AT TIME ZONE 'utc') - $2::interval
+  AND NOT EXISTS (SELECT 1 FROM deployment_artefacts da WHERE da.artefact_id = a.id)
+RETURNING a.id
+`
+
+// Delete an artefact if it is older than the grace period and is still not
+// referenced by any deployment.
+func (q *Queries) DeleteUnreferencedArtefact(ctx context.Context, digest []byte, gracePeriod time.Duration) (int64, error) {
+	row := q.db.QueryRow(ctx, deleteUnreferencedArtefact, digest, gracePeriod)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const dequeueIngressConnectionMessages = `-- name: DequeueIngressConnectionMessages :many
+DELETE FROM ingress_connection_messages
+WHERE id IN (
+  SELECT id
+  FROM ingress_connection_messages
+  WHERE "key" = $1::connection_key
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, body, is_binary
+`
+
+type DequeueIngressConnectionMessagesRow struct {
+	ID       int64
+	Body     []byte
+	IsBinary bool
+}
+
+// Remove and return the messages queued for a connection.
+func (q *Queries) DequeueIngressConnectionMessages(ctx context.Context, key model.ConnectionKey) ([]DequeueIngressConnectionMessagesRow, error) {
+	rows, err := q.db.Query(ctx, dequeueIngressConnectionMessages, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DequeueIngressConnectionMessagesRow
+	for rows.Next() {
+		var i DequeueIngressConnectionMessagesRow
+		if err := rows.Scan(&i.ID, &i.Body, &i.IsBinary); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deregisterRunner = `-- name: DeregisterRunner :one
 WITH matches AS (
     UPDATE runners
@@ -243,6 +427,36 @@ func (q *Queries) DeregisterRunner(ctx context.Context, key model.RunnerKey) (in
 	return count, err
 }
 
+const discardEventForAsyncCall = `-- name: DiscardEventForAsyncCall :exec
+UPDATE topic_subscriptions
+SET state = 'idle',
+    cursor = COALESCE(delivering_event_id, cursor),
+    async_call_id = NULL,
+    delivering_event_id = NULL
+WHERE async_call_id = $1::BIGINT
+`
+
+// Move the cursor of a subscription blocked on a discarded async call past the
+// event the call was delivering.
+func (q *Queries) DiscardEventForAsyncCall(ctx context.Context, asyncCallID int64) error {
+	_, err := q.db.Exec(ctx, discardEventForAsyncCall, asyncCallID)
+	return err
+}
+
+const discardFailedAsyncCall = `-- name: DiscardFailedAsyncCall :one
+UPDATE async_calls
+SET state = 'discarded'
+WHERE id = $1 AND state = 'error'
+RETURNING origin
+`
+
+func (q *Queries) DiscardFailedAsyncCall(ctx context.Context, id int64) (AsyncCallOrigin, error) {
+	row := q.db.QueryRow(ctx, discardFailedAsyncCall, id)
+	var origin AsyncCallOrigin
+	err := row.Scan(&origin)
+	return origin, err
+}
+
 const endCronJob = `-- name: EndCronJob :one
 WITH j AS (
 UPDATE cron_jobs
@@ -251,9 +465,9 @@ UPDATE cron_jobs
   WHERE key = $2::cron_job_key
     AND state = 'executing'
     AND start_time = $3::TIMESTAMPTZ
-  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name
+  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, paused, concurrency, jitter, catchup_limit, module_name
 )
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
   FROM j
   INNER JOIN deployments d on j.deployment_id = d.id
   LIMIT 1
@@ -268,6 +482,10 @@ type EndCronJobRow struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
+	Paused        bool
+	Concurrency   model.CronJobConcurrency
+	Jitter        time.Duration
+	CatchupLimit  int32
 }
 
 func (q *Queries) EndCronJob(ctx context.Context, nextExecution time.Time, key model.CronJobKey, startTime time.Time) (EndCronJobRow, error) {
@@ -282,6 +500,10 @@ func (q *Queries) EndCronJob(ctx context.Context, nextExecution time.Time, key m
 		&i.StartTime,
 		&i.NextExecution,
 		&i.State,
+		&i.Paused,
+		&i.Concurrency,
+		&i.Jitter,
+		&i.CatchupLimit,
 	)
 	return i, err
 }
@@ -323,6 +545,69 @@ func (q *Queries) ExpireRunnerReservations(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const failAsyncCall = `-- name: FailAsyncCall :one
+UPDATE async_calls
+SET state = 'error',
+    error = $1::TEXT
+WHERE id = $2
+RETURNING true
+`
+
+func (q *Queries) FailAsyncCall(ctx context.Context, error string, iD int64) (bool, error) {
+	row := q.db.QueryRow(ctx, failAsyncCall, error, iD)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const failFSMExecution = `-- name: FailFSMExecution :one
+UPDATE fsm_executions
+SET status = 'failed'::fsm_status,
+    destination_state = NULL,
+    async_call_id = NULL
+WHERE fsm = $1::schema_ref AND key = $2::TEXT
+RETURNING true
+`
+
+// Fails the execution, abandoning the current transition.
+func (q *Queries) FailFSMExecution(ctx context.Context, fsm schema.Ref, key string) (bool, error) {
+	row := q.db.QueryRow(ctx, failFSMExecution, fsm, key)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const finishFSMTransition = `-- name: FinishFSMTransition :one
+UPDATE fsm_executions
+SET current_state = destination_state,
+    destination_state = NULL,
+    async_call_id = NULL
+WHERE fsm = $1::schema_ref AND key = $2::TEXT
+RETURNING true
+`
+
+// Completes the current transition, moving the execution to the destination state.
+func (q *Queries) FinishFSMTransition(ctx context.Context, fsm schema.Ref, key string) (bool, error) {
+	row := q.db.QueryRow(ctx, finishFSMTransition, fsm, key)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const forceReleaseLease = `-- name: ForceReleaseLease :one
+DELETE FROM leases
+WHERE key = $1::lease_key
+RETURNING true
+`
+
+// Release a lease regardless of who holds it.
+func (q *Queries) ForceReleaseLease(ctx context.Context, key leases.Key) (bool, error) {
+	row := q.db.QueryRow(ctx, forceReleaseLease, key)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const getActiveControllers = `-- name: GetActiveControllers :many
 SELECT id, key, created, last_seen, state, endpoint
 FROM controller c
@@ -358,7 +643,7 @@ func (q *Queries) GetActiveControllers(ctx context.Context) ([]Controller, error
 }
 
 const getActiveDeploymentSchemas = `-- name: GetActiveDeploymentSchemas :many
-SELECT key, schema FROM deployments WHERE min_replicas > 0
+SELECT key, schema FROM deployments WHERE min_replicas > 0 AND canary_weight IS NULL
 `
 
 type GetActiveDeploymentSchemasRow struct {
@@ -366,6 +651,7 @@ type GetActiveDeploymentSchemasRow struct {
 	Schema *schema.Module
 }
 
+// Canary deployments are excluded, as they are not part of the active schema.
 func (q *Queries) GetActiveDeploymentSchemas(ctx context.Context) ([]GetActiveDeploymentSchemasRow, error) {
 	rows, err := q.db.Query(ctx, getActiveDeploymentSchemas)
 	if err != nil {
@@ -387,7 +673,7 @@ func (q *Queries) GetActiveDeploymentSchemas(ctx context.Context) ([]GetActiveDe
 }
 
 const getActiveDeployments = `-- name: GetActiveDeployments :many
-SELECT d.id, d.created_at, d.module_id, d.key, d.schema, d.labels, d.min_replicas, m.name AS module_name, m.language, COUNT(r.id) AS replicas
+SELECT d.id, d.created_at, d.module_id, d.key, d.schema, d.labels, d.min_replicas, d.canary_weight, m.name AS module_name, m.language, COUNT(r.id) AS replicas
 FROM deployments d
   JOIN modules m ON d.module_id = m.id
   JOIN runners r ON d.id = r.deployment_id
@@ -420,6 +706,7 @@ func (q *Queries) GetActiveDeployments(ctx context.Context) ([]GetActiveDeployme
 			&i.Deployment.Schema,
 			&i.Deployment.Labels,
 			&i.Deployment.MinReplicas,
+			&i.Deployment.CanaryWeight,
 			&i.ModuleName,
 			&i.Language,
 			&i.Replicas,
@@ -435,10 +722,12 @@ func (q *Queries) GetActiveDeployments(ctx context.Context) ([]GetActiveDeployme
 }
 
 const getActiveIngressRoutes = `-- name: GetActiveIngressRoutes :many
-SELECT d.key AS deployment_key, ir.module, ir.verb, ir.method, ir.path
+SELECT d.key AS deployment_key, ir.module, ir.verb, ir.method, ir.path, ir.host
 FROM ingress_routes ir
          INNER JOIN deployments d ON ir.deployment_id = d.id
 WHERE d.min_replicas > 0
+  -- Ingress routes are only served by primary deployments, not canaries.
+  AND d.canary_weight IS NULL
 `
 
 type GetActiveIngressRoutesRow struct {
@@ -447,6 +736,7 @@ type GetActiveIngressRoutesRow struct {
 	Verb          string
 	Method        string
 	Path          string
+	Host          string
 }
 
 func (q *Queries) GetActiveIngressRoutes(ctx context.Context) ([]GetActiveIngressRoutesRow, error) {
@@ -464,6 +754,7 @@ func (q *Queries) GetActiveIngressRoutes(ctx context.Context) ([]GetActiveIngres
 			&i.Verb,
 			&i.Method,
 			&i.Path,
+			&i.Host,
 		); err != nil {
 			return nil, err
 		}
@@ -530,55 +821,25 @@ func (q *Queries) GetActiveRunners(ctx context.Context) ([]GetActiveRunnersRow,
 }
 
 const getArtefactContentRange = `-- name: GetArtefactContentRange :one
-SELECT SUBSTRING(a.content FROM $1 FOR $2)::BYTEA AS content
-FROM artefacts a
-WHERE a.id = $3
+SELECT SUBSTRING(c.content FROM $1 FOR $2)::BYTEA AS content
+FROM artefact_contents c
+WHERE c.digest = $3
 `
 
-func (q *Queries) GetArtefactContentRange(ctx context.Context, start int32, count int32, iD int64) ([]byte, error) {
-	row := q.db.QueryRow(ctx, getArtefactContentRange, start, count, iD)
+func (q *Queries) GetArtefactContentRange(ctx context.Context, start int32, count int32, digest []byte) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getArtefactContentRange, start, count, digest)
 	var content []byte
 	err := row.Scan(&content)
 	return content, err
 }
 
-const getArtefactDigests = `-- name: GetArtefactDigests :many
-SELECT id, digest
-FROM artefacts
-WHERE digest = ANY ($1::bytea[])
-`
-
-type GetArtefactDigestsRow struct {
-	ID     int64
-	Digest []byte
-}
-
-// Return the digests that exist in the database.
-func (q *Queries) GetArtefactDigests(ctx context.Context, digests [][]byte) ([]GetArtefactDigestsRow, error) {
-	rows, err := q.db.Query(ctx, getArtefactDigests, digests)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetArtefactDigestsRow
-	for rows.Next() {
-		var i GetArtefactDigestsRow
-		if err := rows.Scan(&i.ID, &i.Digest); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const getCronJobs = `-- name: GetCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE d.min_replicas > 0
+  -- Cron jobs are only executed by primary deployments, not canaries.
+  AND d.canary_weight IS NULL
 `
 
 type GetCronJobsRow struct {
@@ -590,6 +851,10 @@ type GetCronJobsRow struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
+	Paused        bool
+	Concurrency   model.CronJobConcurrency
+	Jitter        time.Duration
+	CatchupLimit  int32
 }
 
 func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
@@ -610,6 +875,10 @@ func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
+			&i.Paused,
+			&i.Concurrency,
+			&i.Jitter,
+			&i.CatchupLimit,
 		); err != nil {
 			return nil, err
 		}
@@ -622,7 +891,7 @@ func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
 }
 
 const getDeployment = `-- name: GetDeployment :one
-SELECT d.id, d.created_at, d.module_id, d.key, d.schema, d.labels, d.min_replicas, m.language, m.name AS module_name, d.min_replicas
+SELECT d.id, d.created_at, d.module_id, d.key, d.schema, d.labels, d.min_replicas, d.canary_weight, m.language, m.name AS module_name, d.min_replicas
 FROM deployments d
          INNER JOIN modules m ON m.id = d.module_id
 WHERE d.key = $1::deployment_key
@@ -646,6 +915,7 @@ func (q *Queries) GetDeployment(ctx context.Context, key model.DeploymentKey) (G
 		&i.Deployment.Schema,
 		&i.Deployment.Labels,
 		&i.Deployment.MinReplicas,
+		&i.Deployment.CanaryWeight,
 		&i.Language,
 		&i.ModuleName,
 		&i.MinReplicas,
@@ -697,8 +967,45 @@ func (q *Queries) GetDeploymentArtefacts(ctx context.Context, deploymentID int64
 	return items, nil
 }
 
+const getDeploymentReplicaHistoryForModule = `-- name: GetDeploymentReplicaHistoryForModule :many
+SELECT d.key AS deployment_key, e.time_stamp, (e.payload->>'min_replicas')::INT AS min_replicas
+FROM events e
+  JOIN deployments d ON e.deployment_id = d.id
+  JOIN modules m ON d.module_id = m.id
+WHERE m.name = $1::TEXT
+  AND e.type IN ('deployment_created', 'deployment_updated')
+ORDER BY e.time_stamp, e.id
+`
+
+type GetDeploymentReplicaHistoryForModuleRow struct {
+	DeploymentKey model.DeploymentKey
+	TimeStamp     time.Time
+	MinReplicas   int32
+}
+
+// Get the changes to min_replicas of all deployments of a module, oldest first.
+func (q *Queries) GetDeploymentReplicaHistoryForModule(ctx context.Context, moduleName string) ([]GetDeploymentReplicaHistoryForModuleRow, error) {
+	rows, err := q.db.Query(ctx, getDeploymentReplicaHistoryForModule, moduleName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeploymentReplicaHistoryForModuleRow
+	for rows.Next() {
+		var i GetDeploymentReplicaHistoryForModuleRow
+		if err := rows.Scan(&i.DeploymentKey, &i.TimeStamp, &i.MinReplicas); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getDeploymentsByID = `-- name: GetDeploymentsByID :many
-SELECT id, created_at, module_id, key, schema, labels, min_replicas
+SELECT id, created_at, module_id, key, schema, labels, min_replicas, canary_weight
 FROM deployments
 WHERE id = ANY ($1::BIGINT[])
 `
@@ -720,6 +1027,53 @@ func (q *Queries) GetDeploymentsByID(ctx context.Context, ids []int64) ([]Deploy
 			&i.Schema,
 			&i.Labels,
 			&i.MinReplicas,
+			&i.CanaryWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeploymentsForModule = `-- name: GetDeploymentsForModule :many
+SELECT d.id, d.created_at, d.module_id, d.key, d.schema, d.labels, d.min_replicas, d.canary_weight, m.name AS module_name, m.language
+FROM deployments d
+  JOIN modules m ON d.module_id = m.id
+WHERE m.name = $1::TEXT
+ORDER BY d.created_at DESC, d.id DESC
+`
+
+type GetDeploymentsForModuleRow struct {
+	Deployment Deployment
+	ModuleName string
+	Language   string
+}
+
+// Get all deployments of a module, active or not, most recent first.
+func (q *Queries) GetDeploymentsForModule(ctx context.Context, moduleName string) ([]GetDeploymentsForModuleRow, error) {
+	rows, err := q.db.Query(ctx, getDeploymentsForModule, moduleName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeploymentsForModuleRow
+	for rows.Next() {
+		var i GetDeploymentsForModuleRow
+		if err := rows.Scan(
+			&i.Deployment.ID,
+			&i.Deployment.CreatedAt,
+			&i.Deployment.ModuleID,
+			&i.Deployment.Key,
+			&i.Deployment.Schema,
+			&i.Deployment.Labels,
+			&i.Deployment.MinReplicas,
+			&i.Deployment.CanaryWeight,
+			&i.ModuleName,
+			&i.Language,
 		); err != nil {
 			return nil, err
 		}
@@ -829,7 +1183,7 @@ func (q *Queries) GetDeploymentsWithArtefacts(ctx context.Context, digests [][]b
 }
 
 const getDeploymentsWithMinReplicas = `-- name: GetDeploymentsWithMinReplicas :many
-SELECT d.id, d.created_at, d.module_id, d.key, d.schema, d.labels, d.min_replicas, m.name AS module_name, m.language
+SELECT d.id, d.created_at, d.module_id, d.key, d.schema, d.labels, d.min_replicas, d.canary_weight, m.name AS module_name, m.language
 FROM deployments d
   INNER JOIN modules m on d.module_id = m.id
 WHERE min_replicas > 0
@@ -859,6 +1213,7 @@ func (q *Queries) GetDeploymentsWithMinReplicas(ctx context.Context) ([]GetDeplo
 			&i.Deployment.Schema,
 			&i.Deployment.Labels,
 			&i.Deployment.MinReplicas,
+			&i.Deployment.CanaryWeight,
 			&i.ModuleName,
 			&i.Language,
 		); err != nil {
@@ -873,25 +1228,27 @@ func (q *Queries) GetDeploymentsWithMinReplicas(ctx context.Context) ([]GetDeplo
 }
 
 const getExistingDeploymentForModule = `-- name: GetExistingDeploymentForModule :one
-SELECT d.id, created_at, module_id, key, schema, labels, min_replicas, m.id, language, name
+SELECT d.id, created_at, module_id, key, schema, labels, min_replicas, canary_weight, m.id, language, name
 FROM deployments d
          INNER JOIN modules m on d.module_id = m.id
 WHERE m.name = $1
   AND min_replicas > 0
+  AND canary_weight IS NULL
 LIMIT 1
 `
 
 type GetExistingDeploymentForModuleRow struct {
-	ID          int64
-	CreatedAt   time.Time
-	ModuleID    int64
-	Key         model.DeploymentKey
-	Schema      *schema.Module
-	Labels      []byte
-	MinReplicas int32
-	ID_2        int64
-	Language    string
-	Name        string
+	ID           int64
+	CreatedAt    time.Time
+	ModuleID     int64
+	Key          model.DeploymentKey
+	Schema       *schema.Module
+	Labels       []byte
+	MinReplicas  int32
+	CanaryWeight optional.Option[int32]
+	ID_2         int64
+	Language     string
+	Name         string
 }
 
 func (q *Queries) GetExistingDeploymentForModule(ctx context.Context, name string) (GetExistingDeploymentForModuleRow, error) {
@@ -905,6 +1262,7 @@ func (q *Queries) GetExistingDeploymentForModule(ctx context.Context, name strin
 		&i.Schema,
 		&i.Labels,
 		&i.MinReplicas,
+		&i.CanaryWeight,
 		&i.ID_2,
 		&i.Language,
 		&i.Name,
@@ -912,26 +1270,197 @@ func (q *Queries) GetExistingDeploymentForModule(ctx context.Context, name strin
 	return i, err
 }
 
-const getIdleRunners = `-- name: GetIdleRunners :many
-SELECT id, key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, labels
-FROM runners
-WHERE labels @> $1::jsonb
-  AND state = 'idle'
-LIMIT $2
+const getExpiredEvents = `-- name: GetExpiredEvents :many
+SELECT e.id,
+       e.time_stamp,
+       e.type,
+       d.key AS deployment_key,
+       m.name AS module_name,
+       ir.key AS request_key,
+       e.custom_key_1,
+       e.custom_key_2,
+       e.custom_key_3,
+       e.custom_key_4,
+       e.payload
+FROM events e
+  INNER JOIN deployments d ON e.deployment_id = d.id
+  INNER JOIN modules m ON d.module_id = m.id
+  LEFT JOIN requests ir ON e.request_id = ir.id
+WHERE e.type = $1::event_type
+  AND e.time_stamp < $2::TIMESTAMPTZ
+  AND ($3::TEXT IS NULL OR m.name = $3::TEXT)
+  AND NOT (m.name = ANY ($4::TEXT[]))
+ORDER BY e.id
+LIMIT $5::INT
 `
 
-func (q *Queries) GetIdleRunners(ctx context.Context, labels []byte, limit int64) ([]Runner, error) {
-	rows, err := q.db.Query(ctx, getIdleRunners, labels, limit)
+type GetExpiredEventsParams struct {
+	Type           EventType
+	CreatedBefore  time.Time
+	Module         optional.Option[string]
+	ExcludeModules []string
+	MaxEvents      int32
+}
+
+type GetExpiredEventsRow struct {
+	ID            int64
+	TimeStamp     time.Time
+	Type          EventType
+	DeploymentKey model.DeploymentKey
+	ModuleName    string
+	RequestKey    NullRequestKey
+	CustomKey1    optional.Option[string]
+	CustomKey2    optional.Option[string]
+	CustomKey3    optional.Option[string]
+	CustomKey4    optional.Option[string]
+	Payload       json.RawMessage
+}
+
+// Get a batch of events of the given type created before the given time,
+// optionally restricted to a single module, and excluding the given modules.
+func (q *Queries) GetExpiredEvents(ctx context.Context, arg GetExpiredEventsParams) ([]GetExpiredEventsRow, error) {
+	rows, err := q.db.Query(ctx, getExpiredEvents,
+		arg.Type,
+		arg.CreatedBefore,
+		arg.Module,
+		arg.ExcludeModules,
+		arg.MaxEvents,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Runner
+	var items []GetExpiredEventsRow
 	for rows.Next() {
-		var i Runner
+		var i GetExpiredEventsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Key,
+			&i.TimeStamp,
+			&i.Type,
+			&i.DeploymentKey,
+			&i.ModuleName,
+			&i.RequestKey,
+			&i.CustomKey1,
+			&i.CustomKey2,
+			&i.CustomKey3,
+			&i.CustomKey4,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFSMExecution = `-- name: GetFSMExecution :one
+SELECT id, created_at, fsm, key, status, current_state, destination_state, async_call_id
+FROM fsm_executions
+WHERE fsm = $1::schema_ref AND key = $2::TEXT
+`
+
+func (q *Queries) GetFSMExecution(ctx context.Context, fsm schema.Ref, key string) (FsmExecution, error) {
+	row := q.db.QueryRow(ctx, getFSMExecution, fsm, key)
+	var i FsmExecution
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Fsm,
+		&i.Key,
+		&i.Status,
+		&i.CurrentState,
+		&i.DestinationState,
+		&i.AsyncCallID,
+	)
+	return i, err
+}
+
+const getFSMExecutionForAsyncCall = `-- name: GetFSMExecutionForAsyncCall :one
+SELECT id, created_at, fsm, key, status, current_state, destination_state, async_call_id
+FROM fsm_executions
+WHERE async_call_id = $1::BIGINT
+`
+
+func (q *Queries) GetFSMExecutionForAsyncCall(ctx context.Context, asyncCallID int64) (FsmExecution, error) {
+	row := q.db.QueryRow(ctx, getFSMExecutionForAsyncCall, asyncCallID)
+	var i FsmExecution
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Fsm,
+		&i.Key,
+		&i.Status,
+		&i.CurrentState,
+		&i.DestinationState,
+		&i.AsyncCallID,
+	)
+	return i, err
+}
+
+const getFailedAsyncCalls = `-- name: GetFailedAsyncCalls :many
+SELECT id, created_at, lease_id, verb, state, origin, origin_key, request, response, error, scheduled_at, retry_attempt
+FROM async_calls
+WHERE state = 'error'
+  AND ($1::async_call_origin IS NULL OR origin = $1::async_call_origin)
+ORDER BY id
+`
+
+func (q *Queries) GetFailedAsyncCalls(ctx context.Context, origin NullAsyncCallOrigin) ([]AsyncCall, error) {
+	rows, err := q.db.Query(ctx, getFailedAsyncCalls, origin)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AsyncCall
+	for rows.Next() {
+		var i AsyncCall
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.LeaseID,
+			&i.Verb,
+			&i.State,
+			&i.Origin,
+			&i.OriginKey,
+			&i.Request,
+			&i.Response,
+			&i.Error,
+			&i.ScheduledAt,
+			&i.RetryAttempt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIdleRunners = `-- name: GetIdleRunners :many
+SELECT id, key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, labels
+FROM runners
+WHERE labels @> $1::jsonb
+  AND state = 'idle'
+LIMIT $2
+`
+
+func (q *Queries) GetIdleRunners(ctx context.Context, labels []byte, limit int64) ([]Runner, error) {
+	rows, err := q.db.Query(ctx, getIdleRunners, labels, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Runner
+	for rows.Next() {
+		var i Runner
+		if err := rows.Scan(
+			&i.ID,
+			&i.Key,
 			&i.Created,
 			&i.LastSeen,
 			&i.ReservationTimeout,
@@ -951,8 +1480,45 @@ func (q *Queries) GetIdleRunners(ctx context.Context, labels []byte, limit int64
 	return items, nil
 }
 
+const getIngressHTTPRequest = `-- name: GetIngressHTTPRequest :one
+SELECT ihr.method, ihr.host, ihr.path, ihr.query, ihr.headers, ihr.body,
+       ihr.response_status, ihr.response_headers, ihr.response_body
+FROM ingress_http_requests ihr
+INNER JOIN requests r ON ihr.request_id = r.id
+WHERE r.key = $1::request_key
+`
+
+type GetIngressHTTPRequestRow struct {
+	Method          string
+	Host            string
+	Path            string
+	Query           string
+	Headers         []byte
+	Body            []byte
+	ResponseStatus  int32
+	ResponseHeaders []byte
+	ResponseBody    []byte
+}
+
+func (q *Queries) GetIngressHTTPRequest(ctx context.Context, key model.RequestKey) (GetIngressHTTPRequestRow, error) {
+	row := q.db.QueryRow(ctx, getIngressHTTPRequest, key)
+	var i GetIngressHTTPRequestRow
+	err := row.Scan(
+		&i.Method,
+		&i.Host,
+		&i.Path,
+		&i.Query,
+		&i.Headers,
+		&i.Body,
+		&i.ResponseStatus,
+		&i.ResponseHeaders,
+		&i.ResponseBody,
+	)
+	return i, err
+}
+
 const getIngressRoutes = `-- name: GetIngressRoutes :many
-SELECT r.key AS runner_key, d.key AS deployment_key, endpoint, ir.path, ir.module, ir.verb
+SELECT r.key AS runner_key, d.key AS deployment_key, endpoint, ir.path, ir.host, ir.module, ir.verb
 FROM ingress_routes ir
          INNER JOIN runners r ON ir.deployment_id = r.deployment_id
          INNER JOIN deployments d ON ir.deployment_id = d.id
@@ -965,6 +1531,7 @@ type GetIngressRoutesRow struct {
 	DeploymentKey model.DeploymentKey
 	Endpoint      string
 	Path          string
+	Host          string
 	Module        string
 	Verb          string
 }
@@ -984,6 +1551,7 @@ func (q *Queries) GetIngressRoutes(ctx context.Context, method string) ([]GetIng
 			&i.DeploymentKey,
 			&i.Endpoint,
 			&i.Path,
+			&i.Host,
 			&i.Module,
 			&i.Verb,
 		); err != nil {
@@ -997,6 +1565,49 @@ func (q *Queries) GetIngressRoutes(ctx context.Context, method string) ([]GetIng
 	return items, nil
 }
 
+const getLeases = `-- name: GetLeases :many
+SELECT key, created_at, expires_at, fencing_token
+FROM leases
+WHERE expires_at >= NOW() AT TIME ZONE 'utc'
+  AND ($1::TEXT IS NULL
+    OR starts_with(key || '/', $1::TEXT))
+ORDER BY key
+`
+
+type GetLeasesRow struct {
+	Key          leases.Key
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+	FencingToken int64
+}
+
+// Get all unexpired leases, optionally only those under a "/" terminated key
+// prefix.
+func (q *Queries) GetLeases(ctx context.Context, prefix optional.Option[string]) ([]GetLeasesRow, error) {
+	rows, err := q.db.Query(ctx, getLeases, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLeasesRow
+	for rows.Next() {
+		var i GetLeasesRow
+		if err := rows.Scan(
+			&i.Key,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.FencingToken,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getModulesByID = `-- name: GetModulesByID :many
 SELECT id, language, name
 FROM modules
@@ -1023,6 +1634,29 @@ func (q *Queries) GetModulesByID(ctx context.Context, ids []int64) ([]Module, er
 	return items, nil
 }
 
+const getNextEventForSubscription = `-- name: GetNextEventForSubscription :one
+SELECT topic_events.id, topic_events.key, topic_events.payload
+FROM topic_events
+INNER JOIN topic_subscriptions subs ON topic_events.topic_id = subs.topic_id
+WHERE subs.key = $1::subscription_key
+  AND topic_events.id > COALESCE(subs.cursor, 0)
+ORDER BY topic_events.id
+LIMIT 1
+`
+
+type GetNextEventForSubscriptionRow struct {
+	ID      int64
+	Key     model.TopicEventKey
+	Payload []byte
+}
+
+func (q *Queries) GetNextEventForSubscription(ctx context.Context, key model.SubscriptionKey) (GetNextEventForSubscriptionRow, error) {
+	row := q.db.QueryRow(ctx, getNextEventForSubscription, key)
+	var i GetNextEventForSubscriptionRow
+	err := row.Scan(&i.ID, &i.Key, &i.Payload)
+	return i, err
+}
+
 const getProcessList = `-- name: GetProcessList :many
 SELECT d.min_replicas,
        d.key   AS deployment_key,
@@ -1072,6 +1706,34 @@ func (q *Queries) GetProcessList(ctx context.Context) ([]GetProcessListRow, erro
 	return items, nil
 }
 
+const getRandomSubscriber = `-- name: GetRandomSubscriber :one
+SELECT subscribers.verb AS sink, modules.name AS module_name, deployments.key AS deployment_key
+FROM topic_subscribers AS subscribers
+INNER JOIN deployments ON subscribers.deployment_id = deployments.id
+INNER JOIN modules ON deployments.module_id = modules.id
+WHERE subscribers.topic_subscriptions_id = (
+    SELECT id
+    FROM topic_subscriptions
+    WHERE key = $1::subscription_key
+  )
+  AND deployments.min_replicas > 0
+ORDER BY RANDOM()
+LIMIT 1
+`
+
+type GetRandomSubscriberRow struct {
+	Sink          string
+	ModuleName    string
+	DeploymentKey model.DeploymentKey
+}
+
+func (q *Queries) GetRandomSubscriber(ctx context.Context, key model.SubscriptionKey) (GetRandomSubscriberRow, error) {
+	row := q.db.QueryRow(ctx, getRandomSubscriber, key)
+	var i GetRandomSubscriberRow
+	err := row.Scan(&i.Sink, &i.ModuleName, &i.DeploymentKey)
+	return i, err
+}
+
 const getRouteForRunner = `-- name: GetRouteForRunner :one
 SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key, r.state
 FROM runners r
@@ -1102,7 +1764,7 @@ func (q *Queries) GetRouteForRunner(ctx context.Context, key model.RunnerKey) (G
 }
 
 const getRoutingTable = `-- name: GetRoutingTable :many
-SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key
+SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key, d.canary_weight
 FROM runners r
          LEFT JOIN deployments d on r.deployment_id = d.id
 WHERE state = 'assigned'
@@ -1115,6 +1777,7 @@ type GetRoutingTableRow struct {
 	RunnerKey     model.RunnerKey
 	ModuleName    optional.Option[string]
 	DeploymentKey NullDeploymentKey
+	CanaryWeight  optional.Option[int32]
 }
 
 func (q *Queries) GetRoutingTable(ctx context.Context, modules []string) ([]GetRoutingTableRow, error) {
@@ -1131,6 +1794,7 @@ func (q *Queries) GetRoutingTable(ctx context.Context, modules []string) ([]GetR
 			&i.RunnerKey,
 			&i.ModuleName,
 			&i.DeploymentKey,
+			&i.CanaryWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -1196,7 +1860,7 @@ func (q *Queries) GetRunnerState(ctx context.Context, key model.RunnerKey) (Runn
 }
 
 const getRunnersForDeployment = `-- name: GetRunnersForDeployment :many
-SELECT r.id, r.key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, r.labels, d.id, created_at, module_id, d.key, schema, d.labels, min_replicas
+SELECT r.id, r.key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, r.labels, d.id, created_at, module_id, d.key, schema, d.labels, min_replicas, canary_weight
 FROM runners r
          INNER JOIN deployments d on r.deployment_id = d.id
 WHERE state = 'assigned'
@@ -1221,6 +1885,7 @@ type GetRunnersForDeploymentRow struct {
 	Schema             *schema.Module
 	Labels_2           []byte
 	MinReplicas        int32
+	CanaryWeight       optional.Option[int32]
 }
 
 func (q *Queries) GetRunnersForDeployment(ctx context.Context, key model.DeploymentKey) ([]GetRunnersForDeploymentRow, error) {
@@ -1250,6 +1915,7 @@ func (q *Queries) GetRunnersForDeployment(ctx context.Context, key model.Deploym
 			&i.Schema,
 			&i.Labels_2,
 			&i.MinReplicas,
+			&i.CanaryWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -1262,7 +1928,7 @@ func (q *Queries) GetRunnersForDeployment(ctx context.Context, key model.Deploym
 }
 
 const getStaleCronJobs = `-- name: GetStaleCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE state = 'executing'
@@ -1278,6 +1944,10 @@ type GetStaleCronJobsRow struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
+	Paused        bool
+	Concurrency   model.CronJobConcurrency
+	Jitter        time.Duration
+	CatchupLimit  int32
 }
 
 func (q *Queries) GetStaleCronJobs(ctx context.Context, dollar_1 time.Duration) ([]GetStaleCronJobsRow, error) {
@@ -1298,6 +1968,10 @@ func (q *Queries) GetStaleCronJobs(ctx context.Context, dollar_1 time.Duration)
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
+			&i.Paused,
+			&i.Concurrency,
+			&i.Jitter,
+			&i.CatchupLimit,
 		); err != nil {
 			return nil, err
 		}
@@ -1309,6 +1983,169 @@ func (q *Queries) GetStaleCronJobs(ctx context.Context, dollar_1 time.Duration)
 	return items, nil
 }
 
+const getSubscriptionsNeedingUpdate = `-- name: GetSubscriptionsNeedingUpdate :many
+SELECT subs.key::subscription_key AS key, modules.name AS module_name, subs.name
+FROM topic_subscriptions subs
+INNER JOIN modules ON subs.module_id = modules.id
+WHERE subs.state = 'idle'
+  AND EXISTS (
+    SELECT 1
+    FROM topic_events
+    WHERE topic_events.topic_id = subs.topic_id
+      AND topic_events.id > COALESCE(subs.cursor, 0)
+  )
+  AND EXISTS (
+    SELECT 1
+    FROM topic_subscribers
+    INNER JOIN deployments ON topic_subscribers.deployment_id = deployments.id
+    WHERE topic_subscribers.topic_subscriptions_id = subs.id
+      AND deployments.min_replicas > 0
+  )
+ORDER BY subs.created_at
+FOR UPDATE OF subs SKIP LOCKED
+`
+
+type GetSubscriptionsNeedingUpdateRow struct {
+	Key        model.SubscriptionKey
+	ModuleName string
+	Name       string
+}
+
+// Returns idle subscriptions that have unconsumed events and at least one
+// subscriber in an active deployment.
+func (q *Queries) GetSubscriptionsNeedingUpdate(ctx context.Context) ([]GetSubscriptionsNeedingUpdateRow, error) {
+	rows, err := q.db.Query(ctx, getSubscriptionsNeedingUpdate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSubscriptionsNeedingUpdateRow
+	for rows.Next() {
+		var i GetSubscriptionsNeedingUpdateRow
+		if err := rows.Scan(&i.Key, &i.ModuleName, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnreferencedArtefacts = `-- name: GetUnreferencedArtefacts :many
+SELECT a.digest
+FROM artefacts a
+WHERE a.created_at < (NOW() AT TIME ZONE 'utc') - $1::interval
+  AND NOT EXISTS (SELECT 1 FROM deployment_artefacts da WHERE da.artefact_id = a.id)
+ORDER BY a.created_at
+LIMIT $2::INT
+`
+
+// Get the digests of artefacts older than the grace period that are not
+// referenced by any deployment.
+func (q *Queries) GetUnreferencedArtefacts(ctx context.Context, gracePeriod time.Duration, maxArtefacts int32) ([][]byte, error) {
+	rows, err := q.db.Query(ctx, getUnreferencedArtefacts, gracePeriod, maxArtefacts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var digest []byte
+		if err := rows.Scan(&digest); err != nil {
+			return nil, err
+		}
+		items = append(items, digest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const importEvent = `-- name: ImportEvent :execrows
+INSERT INTO events (id, time_stamp, deployment_id, request_id, type,
+                    custom_key_1, custom_key_2, custom_key_3, custom_key_4, payload)
+VALUES ($1::BIGINT,
+        $2::TIMESTAMPTZ,
+        (SELECT id FROM deployments WHERE deployments.key = $3::deployment_key),
+        (CASE
+             WHEN $4::TEXT IS NULL THEN NULL
+             ELSE (SELECT id FROM requests ir WHERE ir.key = $4::TEXT)
+            END),
+        $5::event_type,
+        $6::TEXT,
+        $7::TEXT,
+        $8::TEXT,
+        $9::TEXT,
+        $10::JSONB)
+ON CONFLICT (id) DO NOTHING
+`
+
+type ImportEventParams struct {
+	ID            int64
+	TimeStamp     time.Time
+	DeploymentKey model.DeploymentKey
+	RequestKey    optional.Option[string]
+	Type          EventType
+	CustomKey1    optional.Option[string]
+	CustomKey2    optional.Option[string]
+	CustomKey3    optional.Option[string]
+	CustomKey4    optional.Option[string]
+	Payload       []byte
+}
+
+// Insert an archived event with its original ID, unless it already exists.
+func (q *Queries) ImportEvent(ctx context.Context, arg ImportEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, importEvent,
+		arg.ID,
+		arg.TimeStamp,
+		arg.DeploymentKey,
+		arg.RequestKey,
+		arg.Type,
+		arg.CustomKey1,
+		arg.CustomKey2,
+		arg.CustomKey3,
+		arg.CustomKey4,
+		arg.Payload,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const incrementRateLimit = `-- name: IncrementRateLimit :one
+INSERT INTO rate_limits ("key", window_start, expires_at, count)
+VALUES (
+  $1::TEXT,
+  $2::TIMESTAMPTZ,
+  $3::TIMESTAMPTZ,
+  1
+)
+ON CONFLICT ("key") DO UPDATE
+SET count = CASE
+    WHEN rate_limits.window_start >= EXCLUDED.window_start THEN rate_limits.count + 1
+    ELSE 1
+  END,
+  window_start = GREATEST(rate_limits.window_start, EXCLUDED.window_start),
+  expires_at = GREATEST(rate_limits.expires_at, EXCLUDED.expires_at)
+RETURNING count
+`
+
+// Count a call against a rate limit window, starting a new window if the
+// current one has ended, and return the number of calls in the window.
+//
+// Windows only move forward, so that calls from controllers with clocks that
+// lag slightly behind count against the current window.
+func (q *Queries) IncrementRateLimit(ctx context.Context, key string, windowStart time.Time, expiresAt time.Time) (int64, error) {
+	row := q.db.QueryRow(ctx, incrementRateLimit, key, windowStart, expiresAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const insertCallEvent = `-- name: InsertCallEvent :exec
 INSERT INTO events (deployment_id, request_id, time_stamp, type,
                     custom_key_1, custom_key_2, custom_key_3, custom_key_4, payload)
@@ -1464,6 +2301,54 @@ func (q *Queries) InsertEvent(ctx context.Context, arg InsertEventParams) error
 	return err
 }
 
+const insertIngressHTTPRequest = `-- name: InsertIngressHTTPRequest :exec
+INSERT INTO ingress_http_requests (request_id, method, host, path, query, headers, body,
+                                   response_status, response_headers, response_body)
+SELECT r.id,
+       $1::TEXT,
+       $2::TEXT,
+       $3::TEXT,
+       $4::TEXT,
+       $5::JSONB,
+       $6::BYTEA,
+       $7::INT,
+       $8::JSONB,
+       $9::BYTEA
+FROM requests r
+WHERE r.key = $10::request_key
+`
+
+type InsertIngressHTTPRequestParams struct {
+	Method          string
+	Host            string
+	Path            string
+	Query           string
+	Headers         []byte
+	Body            []byte
+	ResponseStatus  int32
+	ResponseHeaders []byte
+	ResponseBody    []byte
+	Key             model.RequestKey
+}
+
+// Record the HTTP request and response of an ingress request. Nothing is
+// recorded if the request does not exist.
+func (q *Queries) InsertIngressHTTPRequest(ctx context.Context, arg InsertIngressHTTPRequestParams) error {
+	_, err := q.db.Exec(ctx, insertIngressHTTPRequest,
+		arg.Method,
+		arg.Host,
+		arg.Path,
+		arg.Query,
+		arg.Headers,
+		arg.Body,
+		arg.ResponseStatus,
+		arg.ResponseHeaders,
+		arg.ResponseBody,
+		arg.Key,
+	)
+	return err
+}
+
 const insertLogEvent = `-- name: InsertLogEvent :exec
 INSERT INTO events (deployment_id, request_id, time_stamp, custom_key_1, type, payload)
 VALUES ((SELECT id FROM deployments d WHERE d.key = $1::deployment_key LIMIT 1),
@@ -1507,6 +2392,60 @@ func (q *Queries) InsertLogEvent(ctx context.Context, arg InsertLogEventParams)
 	return err
 }
 
+const insertSubscriber = `-- name: InsertSubscriber :exec
+INSERT INTO topic_subscribers (key, topic_subscriptions_id, deployment_id, verb)
+VALUES (
+  $1::subscriber_key,
+  (
+    SELECT topic_subscriptions.id
+    FROM topic_subscriptions
+    INNER JOIN modules ON topic_subscriptions.module_id = modules.id
+    WHERE modules.name = $2::TEXT
+      AND topic_subscriptions.name = $3::TEXT
+  ),
+  (SELECT id FROM deployments WHERE key = $4::deployment_key),
+  $5::TEXT
+)
+`
+
+type InsertSubscriberParams struct {
+	Key              model.SubscriberKey
+	Module           string
+	SubscriptionName string
+	Deployment       model.DeploymentKey
+	Verb             string
+}
+
+func (q *Queries) InsertSubscriber(ctx context.Context, arg InsertSubscriberParams) error {
+	_, err := q.db.Exec(ctx, insertSubscriber,
+		arg.Key,
+		arg.Module,
+		arg.SubscriptionName,
+		arg.Deployment,
+		arg.Verb,
+	)
+	return err
+}
+
+const isCronJobExecuting = `-- name: IsCronJobExecuting :one
+SELECT EXISTS (
+  SELECT 1
+  FROM cron_jobs
+  WHERE key = $1::cron_job_key
+    AND state = 'executing'
+    AND start_time = $2::TIMESTAMPTZ
+)
+`
+
+// Whether the execution of a cron job that started at start_time is still
+// running, ie. it has neither ended nor been replaced by a later execution.
+func (q *Queries) IsCronJobExecuting(ctx context.Context, key model.CronJobKey, startTime time.Time) (bool, error) {
+	row := q.db.QueryRow(ctx, isCronJobExecuting, key, startTime)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const killStaleControllers = `-- name: KillStaleControllers :one
 WITH matches AS (
     UPDATE controller
@@ -1544,7 +2483,7 @@ func (q *Queries) KillStaleRunners(ctx context.Context, timeout time.Duration) (
 }
 
 const loadAsyncCall = `-- name: LoadAsyncCall :one
-SELECT id, created_at, lease_id, verb, state, origin, origin_key, request, response, error
+SELECT id, created_at, lease_id, verb, state, origin, origin_key, request, response, error, scheduled_at, retry_attempt
 FROM async_calls
 WHERE id = $1
 `
@@ -1563,6 +2502,8 @@ func (q *Queries) LoadAsyncCall(ctx context.Context, id int64) (AsyncCall, error
 		&i.Request,
 		&i.Response,
 		&i.Error,
+		&i.ScheduledAt,
+		&i.RetryAttempt,
 	)
 	return i, err
 }
@@ -1570,14 +2511,77 @@ func (q *Queries) LoadAsyncCall(ctx context.Context, id int64) (AsyncCall, error
 const newLease = `-- name: NewLease :one
 INSERT INTO leases (idempotency_key, key, expires_at)
 VALUES (gen_random_uuid(), $1::lease_key, (NOW() AT TIME ZONE 'utc') + $2::interval)
-RETURNING idempotency_key
+RETURNING idempotency_key, fencing_token
 `
 
-func (q *Queries) NewLease(ctx context.Context, key leases.Key, ttl time.Duration) (uuid.UUID, error) {
+type NewLeaseRow struct {
+	IdempotencyKey uuid.UUID
+	FencingToken   int64
+}
+
+func (q *Queries) NewLease(ctx context.Context, key leases.Key, ttl time.Duration) (NewLeaseRow, error) {
 	row := q.db.QueryRow(ctx, newLease, key, ttl)
-	var idempotency_key uuid.UUID
-	err := row.Scan(&idempotency_key)
-	return idempotency_key, err
+	var i NewLeaseRow
+	err := row.Scan(&i.IdempotencyKey, &i.FencingToken)
+	return i, err
+}
+
+const publishEventForTopic = `-- name: PublishEventForTopic :exec
+INSERT INTO topic_events (key, topic_id, payload)
+VALUES (
+  $1::topic_event_key,
+  (
+    SELECT topics.id
+    FROM topics
+    INNER JOIN modules ON topics.module_id = modules.id
+    WHERE modules.name = $2::TEXT
+      AND topics.name = $3::TEXT
+  ),
+  $4
+)
+`
+
+type PublishEventForTopicParams struct {
+	Key     model.TopicEventKey
+	Module  string
+	Topic   string
+	Payload []byte
+}
+
+func (q *Queries) PublishEventForTopic(ctx context.Context, arg PublishEventForTopicParams) error {
+	_, err := q.db.Exec(ctx, publishEventForTopic,
+		arg.Key,
+		arg.Module,
+		arg.Topic,
+		arg.Payload,
+	)
+	return err
+}
+
+const queueIngressConnectionMessage = `-- name: QueueIngressConnectionMessage :one
+WITH connection AS (
+  SELECT ic."key"
+  FROM ingress_connections ic
+  INNER JOIN controller c ON ic.controller_id = c.id
+  WHERE ic."key" = $1::connection_key
+    AND c.state <> 'dead'
+), queued AS (
+  INSERT INTO ingress_connection_messages ("key", body, is_binary)
+  SELECT connection."key", $2::BYTEA, $3::BOOLEAN
+  FROM connection
+  RETURNING 1
+)
+SELECT COUNT(*)
+FROM queued
+`
+
+// Queue a message for a connection held by a live controller, returning the
+// number of messages queued.
+func (q *Queries) QueueIngressConnectionMessage(ctx context.Context, key model.ConnectionKey, body []byte, isBinary bool) (int64, error) {
+	row := q.db.QueryRow(ctx, queueIngressConnectionMessage, key, body, isBinary)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
 const releaseLease = `-- name: ReleaseLease :one
@@ -1627,6 +2631,26 @@ func (q *Queries) ReplaceDeployment(ctx context.Context, oldDeployment model.Dep
 	return count, err
 }
 
+const requeueFailedAsyncCall = `-- name: RequeueFailedAsyncCall :one
+UPDATE async_calls
+SET state = 'pending',
+    lease_id = NULL,
+    error = NULL,
+    scheduled_at = (NOW() AT TIME ZONE 'utc'),
+    retry_attempt = 0
+WHERE id = $1 AND state = 'error'
+RETURNING origin
+`
+
+// Move a failed async call back to pending so that it will be executed
+// again, resetting its retry attempts.
+func (q *Queries) RequeueFailedAsyncCall(ctx context.Context, id int64) (AsyncCallOrigin, error) {
+	row := q.db.QueryRow(ctx, requeueFailedAsyncCall, id)
+	var origin AsyncCallOrigin
+	err := row.Scan(&origin)
+	return origin, err
+}
+
 const reserveRunner = `-- name: ReserveRunner :one
 UPDATE runners
 SET state               = 'reserved',
@@ -1664,44 +2688,135 @@ func (q *Queries) ReserveRunner(ctx context.Context, reservationTimeout time.Tim
 	return i, err
 }
 
-const sendFSMEvent = `-- name: SendFSMEvent :one
-WITH execution AS (
-  INSERT INTO fsm_executions (key, name, state)
-  VALUES ($1, $2, $3)
-  ON CONFLICT(key) DO UPDATE SET state = $3
-  RETURNING id
-), call AS (
-  INSERT INTO async_calls (verb, request, origin, origin_key)
-  VALUES ($4, $5, 'fsm', $1)
-  RETURNING id
+const resumeFSMExecutionForAsyncCall = `-- name: ResumeFSMExecutionForAsyncCall :exec
+UPDATE fsm_executions
+SET status = 'running'::fsm_status,
+    destination_state = transitions.destination_state,
+    async_call_id = transitions.async_calls_id
+FROM fsm_transitions transitions
+WHERE transitions.async_calls_id = $1::BIGINT
+  AND fsm_executions.id = transitions.fsm_executions_id
+  AND fsm_executions.status = 'failed'::fsm_status
+`
+
+// Resume a failed FSM execution whose transition is being retried, restoring
+// the transition from the fsm_transitions record of the async call.
+func (q *Queries) ResumeFSMExecutionForAsyncCall(ctx context.Context, asyncCallID int64) error {
+	_, err := q.db.Exec(ctx, resumeFSMExecutionForAsyncCall, asyncCallID)
+	return err
+}
+
+const resumeSubscriptionForAsyncCall = `-- name: ResumeSubscriptionForAsyncCall :exec
+UPDATE topic_subscriptions
+SET state = 'executing'
+WHERE async_call_id = $1::BIGINT
+`
+
+// Re-arm a subscription blocked on a failed async call that is being retried.
+func (q *Queries) ResumeSubscriptionForAsyncCall(ctx context.Context, asyncCallID int64) error {
+	_, err := q.db.Exec(ctx, resumeSubscriptionForAsyncCall, asyncCallID)
+	return err
+}
+
+const retryAsyncCall = `-- name: RetryAsyncCall :one
+UPDATE async_calls
+SET state = 'pending',
+    lease_id = NULL,
+    error = $1::TEXT,
+    scheduled_at = (NOW() AT TIME ZONE 'utc') + $2::interval,
+    retry_attempt = retry_attempt + 1
+WHERE id = $3
+RETURNING true
+`
+
+// Reschedule a failed async call to be executed again after a backoff.
+func (q *Queries) RetryAsyncCall(ctx context.Context, error string, backoff time.Duration, iD int64) (bool, error) {
+	row := q.db.QueryRow(ctx, retryAsyncCall, error, backoff, iD)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const scheduleCronJob = `-- name: ScheduleCronJob :one
+WITH j AS (
+UPDATE cron_jobs
+  SET next_execution = $1::TIMESTAMPTZ
+  WHERE key = $2::cron_job_key
+    AND state = 'executing'
+    AND start_time = $3::TIMESTAMPTZ
+  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, paused, concurrency, jitter, catchup_limit, module_name
 )
-INSERT INTO fsm_transitions (fsm_executions_id, async_calls_id)
-VALUES ((SELECT id FROM execution), (SELECT id FROM call))
-RETURNING id
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
+  FROM j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LIMIT 1
 `
 
-type SendFSMEventParams struct {
-	Key     string
-	Name    string
-	State   string
-	Verb    schema.Ref
-	Request []byte
+type ScheduleCronJobRow struct {
+	Key           model.CronJobKey
+	DeploymentKey model.DeploymentKey
+	Module        string
+	Verb          string
+	Schedule      string
+	StartTime     time.Time
+	NextExecution time.Time
+	State         model.CronJobState
+	Paused        bool
+	Concurrency   model.CronJobConcurrency
+	Jitter        time.Duration
+	CatchupLimit  int32
 }
 
-// Creates a new FSM execution, including initial async call and transition.
-//
-// "key" is the unique identifier for the FSM execution.
-func (q *Queries) SendFSMEvent(ctx context.Context, arg SendFSMEventParams) (int64, error) {
-	row := q.db.QueryRow(ctx, sendFSMEvent,
-		arg.Key,
-		arg.Name,
-		arg.State,
-		arg.Verb,
-		arg.Request,
+// Set the next execution of a cron job that is still executing, so that
+// executions can overlap.
+func (q *Queries) ScheduleCronJob(ctx context.Context, nextExecution time.Time, key model.CronJobKey, startTime time.Time) (ScheduleCronJobRow, error) {
+	row := q.db.QueryRow(ctx, scheduleCronJob, nextExecution, key, startTime)
+	var i ScheduleCronJobRow
+	err := row.Scan(
+		&i.Key,
+		&i.DeploymentKey,
+		&i.Module,
+		&i.Verb,
+		&i.Schedule,
+		&i.StartTime,
+		&i.NextExecution,
+		&i.State,
+		&i.Paused,
+		&i.Concurrency,
+		&i.Jitter,
+		&i.CatchupLimit,
 	)
-	var id int64
-	err := row.Scan(&id)
-	return id, err
+	return i, err
+}
+
+const setCronJobPaused = `-- name: SetCronJobPaused :one
+WITH updated AS (
+  UPDATE cron_jobs
+  SET paused = $1::BOOLEAN,
+    next_execution = $2::TIMESTAMPTZ
+  WHERE key = $3::cron_job_key
+  RETURNING 1
+)
+SELECT COUNT(*)
+FROM updated
+`
+
+func (q *Queries) SetCronJobPaused(ctx context.Context, paused bool, nextExecution time.Time, key model.CronJobKey) (int64, error) {
+	row := q.db.QueryRow(ctx, setCronJobPaused, paused, nextExecution, key)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const setDeploymentCanaryWeight = `-- name: SetDeploymentCanaryWeight :exec
+UPDATE deployments
+SET canary_weight = $1::INT
+WHERE key = $2::deployment_key
+`
+
+func (q *Queries) SetDeploymentCanaryWeight(ctx context.Context, canaryWeight optional.Option[int32], key model.DeploymentKey) error {
+	_, err := q.db.Exec(ctx, setDeploymentCanaryWeight, canaryWeight, key)
+	return err
 }
 
 const setDeploymentDesiredReplicas = `-- name: SetDeploymentDesiredReplicas :exec
@@ -1716,20 +2831,35 @@ func (q *Queries) SetDeploymentDesiredReplicas(ctx context.Context, key model.De
 	return err
 }
 
+const startCanaryDeployment = `-- name: StartCanaryDeployment :exec
+UPDATE deployments
+SET min_replicas = $1::INT,
+    canary_weight = $2::INT
+WHERE key = $3::deployment_key
+`
+
+func (q *Queries) StartCanaryDeployment(ctx context.Context, minReplicas int32, canaryWeight int32, key model.DeploymentKey) error {
+	_, err := q.db.Exec(ctx, startCanaryDeployment, minReplicas, canaryWeight, key)
+	return err
+}
+
 const startCronJobs = `-- name: StartCronJobs :many
 WITH updates AS (
   UPDATE cron_jobs
   SET state = 'executing',
     start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
   WHERE key = ANY ($1)
-    AND state = 'idle'
-    AND start_time < next_execution
+    -- Executions may only overlap if the job's concurrency policy allows it,
+    -- and the next execution has been scheduled since the job last started.
+    AND (state = 'idle' OR (concurrency <> 'forbid' AND start_time < next_execution))
+    AND NOT paused
     AND (next_execution AT TIME ZONE 'utc') < (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
   RETURNING id, key, state, start_time, next_execution)
 SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule,
   COALESCE(u.start_time, j.start_time) as start_time,
   COALESCE(u.next_execution, j.next_execution) as next_execution,
   COALESCE(u.state, j.state) as state,
+  j.paused, j.concurrency, j.jitter, j.catchup_limit,
   d.min_replicas > 0 as has_min_replicas,
   CASE WHEN u.key IS NULL THEN FALSE ELSE TRUE END as updated
 FROM cron_jobs j
@@ -1747,6 +2877,10 @@ type StartCronJobsRow struct {
 	StartTime      time.Time
 	NextExecution  time.Time
 	State          model.CronJobState
+	Paused         bool
+	Concurrency    model.CronJobConcurrency
+	Jitter         time.Duration
+	CatchupLimit   int32
 	HasMinReplicas bool
 	Updated        bool
 }
@@ -1769,6 +2903,10 @@ func (q *Queries) StartCronJobs(ctx context.Context, keys []string) ([]StartCron
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
+			&i.Paused,
+			&i.Concurrency,
+			&i.Jitter,
+			&i.CatchupLimit,
 			&i.HasMinReplicas,
 			&i.Updated,
 		); err != nil {
@@ -1782,6 +2920,182 @@ func (q *Queries) StartCronJobs(ctx context.Context, keys []string) ([]StartCron
 	return items, nil
 }
 
+const startFSMTransition = `-- name: StartFSMTransition :one
+WITH call AS (
+  INSERT INTO async_calls (verb, request, origin, origin_key)
+  VALUES ($1, $2, 'fsm', $3)
+  RETURNING id
+), execution AS (
+  INSERT INTO fsm_executions AS executions (fsm, key, destination_state, async_call_id)
+  VALUES ($4, $5, $1, (SELECT id FROM call))
+  ON CONFLICT (fsm, key) DO
+  UPDATE SET destination_state = EXCLUDED.destination_state,
+             async_call_id = EXCLUDED.async_call_id
+  -- Only one transition can be in progress at a time.
+  WHERE executions.destination_state IS NULL AND executions.status = 'running'
+  RETURNING id, current_state
+)
+INSERT INTO fsm_transitions (fsm_executions_id, source_state, destination_state, async_calls_id)
+VALUES (
+  (SELECT id FROM execution),
+  (SELECT current_state FROM execution),
+  $1,
+  (SELECT id FROM call)
+)
+RETURNING id
+`
+
+type StartFSMTransitionParams struct {
+	DestinationState schema.Ref
+	Request          []byte
+	OriginKey        string
+	Fsm              schema.Ref
+	Key              string
+}
+
+// Starts a new FSM transition, populating the destination state and async call ID.
+//
+// "key" is the unique identifier for the FSM execution.
+func (q *Queries) StartFSMTransition(ctx context.Context, arg StartFSMTransitionParams) (int64, error) {
+	row := q.db.QueryRow(ctx, startFSMTransition,
+		arg.DestinationState,
+		arg.Request,
+		arg.OriginKey,
+		arg.Fsm,
+		arg.Key,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const succeedAsyncCall = `-- name: SucceedAsyncCall :one
+UPDATE async_calls
+SET state = 'success',
+    response = $1,
+    error = NULL
+WHERE id = $2
+RETURNING true
+`
+
+func (q *Queries) SucceedAsyncCall(ctx context.Context, response []byte, iD int64) (bool, error) {
+	row := q.db.QueryRow(ctx, succeedAsyncCall, response, iD)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const succeedFSMExecution = `-- name: SucceedFSMExecution :one
+UPDATE fsm_executions
+SET status = 'completed'::fsm_status
+WHERE fsm = $1::schema_ref AND key = $2::TEXT
+RETURNING true
+`
+
+func (q *Queries) SucceedFSMExecution(ctx context.Context, fsm schema.Ref, key string) (bool, error) {
+	row := q.db.QueryRow(ctx, succeedFSMExecution, fsm, key)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const touchArtefacts = `-- name: TouchArtefacts :many
+UPDATE artefacts
+SET created_at = NOW() AT TIME ZONE 'utc'
+WHERE digest = ANY ($1::bytea[])
+RETURNING id, digest
+`
+
+type TouchArtefactsRow struct {
+	ID     int64
+	Digest []byte
+}
+
+// Return the digests that exist in the database, locking them and restarting
+// their grace period for garbage collection.
+func (q *Queries) TouchArtefacts(ctx context.Context, digests [][]byte) ([]TouchArtefactsRow, error) {
+	rows, err := q.db.Query(ctx, touchArtefacts, digests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TouchArtefactsRow
+	for rows.Next() {
+		var i TouchArtefactsRow
+		if err := rows.Scan(&i.ID, &i.Digest); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const triggerCronJob = `-- name: TriggerCronJob :one
+WITH j AS (
+UPDATE cron_jobs
+  SET state = 'executing',
+    start_time = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+  WHERE key = $1::cron_job_key
+    AND state = 'idle'
+  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, paused, concurrency, jitter, catchup_limit, module_name
+)
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused, j.concurrency, j.jitter, j.catchup_limit
+  FROM j
+  INNER JOIN deployments d on j.deployment_id = d.id
+  LIMIT 1
+`
+
+type TriggerCronJobRow struct {
+	Key           model.CronJobKey
+	DeploymentKey model.DeploymentKey
+	Module        string
+	Verb          string
+	Schedule      string
+	StartTime     time.Time
+	NextExecution time.Time
+	State         model.CronJobState
+	Paused        bool
+	Concurrency   model.CronJobConcurrency
+	Jitter        time.Duration
+	CatchupLimit  int32
+}
+
+// Start executing an idle cron job immediately, whether or not it is due or
+// paused.
+func (q *Queries) TriggerCronJob(ctx context.Context, key model.CronJobKey) (TriggerCronJobRow, error) {
+	row := q.db.QueryRow(ctx, triggerCronJob, key)
+	var i TriggerCronJobRow
+	err := row.Scan(
+		&i.Key,
+		&i.DeploymentKey,
+		&i.Module,
+		&i.Verb,
+		&i.Schedule,
+		&i.StartTime,
+		&i.NextExecution,
+		&i.State,
+		&i.Paused,
+		&i.Concurrency,
+		&i.Jitter,
+		&i.CatchupLimit,
+	)
+	return i, err
+}
+
+const upsertArtefactContent = `-- name: UpsertArtefactContent :exec
+INSERT INTO artefact_contents (digest, content)
+VALUES ($1, $2)
+ON CONFLICT (digest) DO NOTHING
+`
+
+func (q *Queries) UpsertArtefactContent(ctx context.Context, digest []byte, content []byte) error {
+	_, err := q.db.Exec(ctx, upsertArtefactContent, digest, content)
+	return err
+}
+
 const upsertController = `-- name: UpsertController :one
 INSERT INTO controller (key, endpoint)
 VALUES ($1, $2)
@@ -1862,3 +3176,77 @@ func (q *Queries) UpsertRunner(ctx context.Context, arg UpsertRunnerParams) (opt
 	err := row.Scan(&deployment_id)
 	return deployment_id, err
 }
+
+const upsertSubscription = `-- name: UpsertSubscription :exec
+INSERT INTO topic_subscriptions (key, topic_id, module_id, name, cursor)
+VALUES (
+  $1::subscription_key,
+  (
+    SELECT topics.id
+    FROM topics
+    INNER JOIN modules ON topics.module_id = modules.id
+    WHERE modules.name = $2::TEXT
+      AND topics.name = $3::TEXT
+  ),
+  (SELECT id FROM modules WHERE name = $4::TEXT),
+  $5::TEXT,
+  (
+    SELECT MAX(topic_events.id)
+    FROM topic_events
+    INNER JOIN topics ON topic_events.topic_id = topics.id
+    INNER JOIN modules ON topics.module_id = modules.id
+    WHERE modules.name = $2::TEXT
+      AND topics.name = $3::TEXT
+  )
+)
+ON CONFLICT (module_id, name) DO NOTHING
+`
+
+type UpsertSubscriptionParams struct {
+	Key         model.SubscriptionKey
+	TopicModule string
+	TopicName   string
+	Module      string
+	Name        string
+}
+
+// A new subscription starts consuming from the most recent event in the topic.
+func (q *Queries) UpsertSubscription(ctx context.Context, arg UpsertSubscriptionParams) error {
+	_, err := q.db.Exec(ctx, upsertSubscription,
+		arg.Key,
+		arg.TopicModule,
+		arg.TopicName,
+		arg.Module,
+		arg.Name,
+	)
+	return err
+}
+
+const upsertTopic = `-- name: UpsertTopic :exec
+INSERT INTO topics (key, module_id, name, type)
+VALUES (
+  $1::topic_key,
+  (SELECT id FROM modules WHERE name = $2::TEXT LIMIT 1),
+  $3::TEXT,
+  $4::TEXT
+)
+ON CONFLICT (module_id, name) DO
+UPDATE SET type = $4::TEXT
+`
+
+type UpsertTopicParams struct {
+	Topic     model.TopicKey
+	Module    string
+	Name      string
+	EventType string
+}
+
+func (q *Queries) UpsertTopic(ctx context.Context, arg UpsertTopicParams) error {
+	_, err := q.db.Exec(ctx, upsertTopic,
+		arg.Topic,
+		arg.Module,
+		arg.Name,
+		arg.EventType,
+	)
+	return err
+}
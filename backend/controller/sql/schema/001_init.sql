@@ -13,6 +13,7 @@ BEGIN
         WHEN 'deployments' THEN 'deployments_events'
         WHEN 'topics' THEN 'topics_events'
         WHEN 'topic_events' THEN 'topic_events_events'
+        WHEN 'ingress_connection_messages' THEN 'ingress_connection_messages_events'
     END;
     IF TG_OP = 'DELETE'
     THEN
@@ -58,14 +59,20 @@ CREATE TABLE deployments
     "schema"     module_schema_pb  NOT NULL,
     -- Labels are used to match deployments to runners.
     "labels"     JSONB          NOT NULL DEFAULT '{}',
-    min_replicas INT            NOT NULL DEFAULT 0
+    min_replicas INT            NOT NULL DEFAULT 0,
+    -- If set, this deployment is a canary receiving this percentage of the
+    -- module's traffic, with the remainder going to the primary deployment.
+    canary_weight INT           CHECK (canary_weight BETWEEN 1 AND 99)
 );
 
 CREATE UNIQUE INDEX deployments_key_idx ON deployments (key);
 CREATE INDEX deployments_module_id_idx ON deployments (module_id);
--- Only allow one deployment per module.
+-- Only allow one primary deployment per module.
 CREATE UNIQUE INDEX deployments_unique_idx ON deployments (module_id)
-    WHERE min_replicas > 0;
+    WHERE min_replicas > 0 AND canary_weight IS NULL;
+-- Only allow one canary deployment per module.
+CREATE UNIQUE INDEX deployments_unique_canary_idx ON deployments (module_id)
+    WHERE min_replicas > 0 AND canary_weight IS NOT NULL;
 
 CREATE TRIGGER deployments_notify_event
     AFTER INSERT OR UPDATE OR DELETE
@@ -73,17 +80,26 @@ CREATE TRIGGER deployments_notify_event
     FOR EACH ROW
 EXECUTE PROCEDURE notify_event();
 
+-- Artefacts known to the controller. The content of each artefact is held by
+-- the controller's artefact store.
 CREATE TABLE artefacts
 (
     id         BIGINT       NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
     created_at TIMESTAMPTZ  NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
     -- SHA256 digest of the content.
-    digest     BYTEA UNIQUE NOT NULL,
-    content    BYTEA        NOT NULL
+    digest     BYTEA UNIQUE NOT NULL
 );
 
 CREATE UNIQUE INDEX artefacts_digest_idx ON artefacts (digest);
 
+-- Artefact content for the default Postgres artefact store.
+CREATE TABLE artefact_contents
+(
+    -- SHA256 digest of the content.
+    digest  BYTEA PRIMARY KEY,
+    content BYTEA NOT NULL
+);
+
 CREATE TABLE deployment_artefacts
 (
     artefact_id   BIGINT      NOT NULL REFERENCES artefacts (id) ON DELETE CASCADE,
@@ -95,6 +111,7 @@ CREATE TABLE deployment_artefacts
 );
 
 CREATE INDEX deployment_artefacts_deployment_id_idx ON deployment_artefacts (deployment_id);
+CREATE INDEX deployment_artefacts_artefact_id_idx ON deployment_artefacts (artefact_id);
 
 CREATE TYPE runner_state AS ENUM (
     -- The Runner is available to run deployments.
@@ -180,6 +197,8 @@ CREATE TABLE ingress_routes
 (
     method        TEXT NOT NULL,
     path          TEXT NOT NULL,
+    -- Host the route is restricted to, or empty for any host.
+    host          TEXT NOT NULL DEFAULT '',
     -- The deployment that should handle this route.
     deployment_id BIGINT  NOT NULL REFERENCES deployments (id) ON DELETE CASCADE,
     -- Duplicated here to avoid having to join from this to deployments then modules.
@@ -193,7 +212,8 @@ CREATE TYPE origin AS ENUM (
     'ingress',
     'cron',
     -- Not supported yet.
-    'pubsub'
+    'pubsub',
+    'fsm'
     );
 
 CREATE DOMAIN request_key AS TEXT;
@@ -216,6 +236,24 @@ CREATE TABLE requests
 CREATE INDEX requests_origin_idx ON requests (origin);
 CREATE UNIQUE INDEX ingress_requests_key_idx ON requests ("key");
 
+-- HTTP requests received through ingress, and the responses sent for them,
+-- recorded so that they can be replayed.
+CREATE TABLE ingress_http_requests
+(
+    id               BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    request_id       BIGINT NOT NULL UNIQUE REFERENCES requests (id) ON DELETE CASCADE,
+    method           TEXT   NOT NULL,
+    host             TEXT   NOT NULL,
+    path             TEXT   NOT NULL,
+    -- Raw query string, without the leading "?".
+    query            TEXT   NOT NULL,
+    headers          JSONB  NOT NULL,
+    body             BYTEA  NOT NULL,
+    response_status  INT    NOT NULL,
+    response_headers JSONB  NOT NULL,
+    response_body    BYTEA  NOT NULL
+);
+
 CREATE TYPE controller_state AS ENUM (
     'live',
     'dead'
@@ -235,11 +273,66 @@ CREATE TABLE controller
 
 CREATE UNIQUE INDEX controller_endpoint_not_dead_idx ON controller (endpoint) WHERE state <> 'dead';
 
+CREATE DOMAIN connection_key AS TEXT;
+
+-- WebSocket connections to ingress verbs, held open by a controller.
+CREATE TABLE ingress_connections
+(
+    id            BIGINT         NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "key"         connection_key UNIQUE NOT NULL,
+    created_at    TIMESTAMPTZ    NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    controller_id BIGINT         NOT NULL REFERENCES controller (id) ON DELETE CASCADE,
+    module        TEXT           NOT NULL,
+    verb          TEXT           NOT NULL
+);
+
+CREATE INDEX ingress_connections_controller_id_idx ON ingress_connections (controller_id);
+
+-- Messages queued for connections held by other controllers. The controller
+-- holding the connection is notified of new messages, and removes them as it
+-- sends them.
+CREATE TABLE ingress_connection_messages
+(
+    id         BIGINT         NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ    NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    -- Key of the connection the message is for.
+    "key"      connection_key NOT NULL REFERENCES ingress_connections ("key") ON DELETE CASCADE,
+    body       BYTEA          NOT NULL,
+    is_binary  BOOLEAN        NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX ingress_connection_messages_key_idx ON ingress_connection_messages ("key");
+
+CREATE TRIGGER ingress_connection_messages_notify_event
+    AFTER INSERT
+    ON ingress_connection_messages
+    FOR EACH ROW
+EXECUTE PROCEDURE notify_event();
+
+-- Calls to rate limited verbs, counted per key within fixed windows so that
+-- limits hold across controllers.
+CREATE TABLE rate_limits
+(
+    -- Verb, "by" kind and subject the calls are counted for.
+    "key"        TEXT        NOT NULL PRIMARY KEY,
+    window_start TIMESTAMPTZ NOT NULL,
+    expires_at   TIMESTAMPTZ NOT NULL,
+    count        BIGINT      NOT NULL
+);
+
+CREATE INDEX rate_limits_expires_at_idx ON rate_limits (expires_at);
+
 CREATE TYPE cron_job_state AS ENUM (
     'idle',
     'executing'
 );
 
+CREATE TYPE cron_job_concurrency AS ENUM (
+    'forbid',
+    'allow',
+    'replace'
+);
+
 CREATE DOMAIN cron_job_key AS TEXT;
 
 CREATE TABLE cron_jobs
@@ -252,6 +345,15 @@ CREATE TABLE cron_jobs
     start_time     TIMESTAMPTZ NOT NULL,
     next_execution TIMESTAMPTZ NOT NULL,
     state          cron_job_state   NOT NULL DEFAULT 'idle',
+    -- Paused jobs are not executed until resumed. New deployments of the
+    -- module inherit the paused state of the job they replace.
+    paused         BOOLEAN     NOT NULL DEFAULT FALSE,
+    -- Policy for executions that are due while the previous one is running.
+    concurrency    cron_job_concurrency NOT NULL DEFAULT 'forbid',
+    -- Maximum random delay added to each execution.
+    jitter         INTERVAL    NOT NULL DEFAULT '0',
+    -- Maximum number of missed executions to run.
+    catchup_limit  INT         NOT NULL DEFAULT 1,
 
     -- Some denormalisation for performance. Without this we need to do a two table join.
     module_name    TEXT     NOT NULL
@@ -321,9 +423,12 @@ CREATE TRIGGER topics_notify_event
     FOR EACH ROW
 EXECUTE PROCEDURE notify_event();
 
+CREATE DOMAIN topic_event_key AS TEXT;
+
 -- This table contains the actual topic data.
 CREATE TABLE topic_events (
     id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "key" topic_event_key UNIQUE NOT NULL,
     created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
 
     topic_id BIGINT NOT NULL REFERENCES topics(id) ON DELETE CASCADE,
@@ -339,6 +444,11 @@ EXECUTE PROCEDURE notify_event();
 
 CREATE DOMAIN subscription_key AS TEXT;
 
+CREATE TYPE topic_subscription_state AS ENUM (
+    'idle', -- Waiting for the next event.
+    'executing' -- An event is being delivered to a subscriber.
+);
+
 -- A subscription to a topic.
 --
 -- Multiple subscribers can consume from a single subscription.
@@ -349,13 +459,31 @@ CREATE TABLE topic_subscriptions (
 
     topic_id BIGINT NOT NULL REFERENCES topics(id) ON DELETE CASCADE,
 
+    -- Each subscription is associated with an owning module.
+    module_id BIGINT NOT NULL REFERENCES modules(id),
+
     -- Name of the subscription.
-    name TEXT UNIQUE NOT NULL,
+    name TEXT NOT NULL,
+
+    -- Cursor pointing into the topic_events table at the last consumed event.
+    -- NULL if no events have been consumed yet.
+    cursor BIGINT REFERENCES topic_events(id) ON DELETE CASCADE,
+
+    state topic_subscription_state NOT NULL DEFAULT 'idle',
+
+    -- The async call delivering the event after the cursor. Only the
+    -- completion of this call will move the subscription back to "idle". If
+    -- the call fails the subscription stays blocked on it until it is
+    -- requeued or discarded.
+    async_call_id BIGINT,
 
-    -- Cursor pointing into the topic_events table.
-    cursor BIGINT NOT NULL REFERENCES topic_events(id) ON DELETE CASCADE
+    -- The event being delivered by async_call_id. The cursor only moves to
+    -- this event once the call succeeds.
+    delivering_event_id BIGINT REFERENCES topic_events(id) ON DELETE SET NULL
 );
 
+CREATE UNIQUE INDEX topic_subscriptions_module_name_idx ON topic_subscriptions(module_id, name);
+
 CREATE DOMAIN subscriber_key AS TEXT;
 
 -- A subscriber to a topic.
@@ -366,7 +494,7 @@ CREATE TABLE topic_subscribers (
    "key" subscriber_key UNIQUE NOT NULL,
    created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
 
-   topic_subscriptions_id BIGINT NOT NULL REFERENCES topic_subscriptions(id),
+   topic_subscriptions_id BIGINT NOT NULL REFERENCES topic_subscriptions(id) ON DELETE CASCADE,
 
    deployment_id BIGINT NOT NULL REFERENCES deployments(id) ON DELETE CASCADE,
    -- Name of the verb to call on the deployment.
@@ -375,12 +503,17 @@ CREATE TABLE topic_subscribers (
 
 CREATE DOMAIN lease_key AS TEXT;
 
+-- Fencing tokens increase with every lease acquired, so a holder whose lease
+-- has been lost can be told apart from the holder that acquired it since.
+CREATE SEQUENCE lease_fencing_tokens;
+
 CREATE TABLE leases (
     id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
     idempotency_key UUID UNIQUE NOT NULL,
     key lease_key UNIQUE NOT NULL,
     created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
-    expires_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc')
+    expires_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    fencing_token BIGINT NOT NULL DEFAULT nextval('lease_fencing_tokens')
 );
 
 CREATE INDEX leases_expires_at_idx ON leases (expires_at);
@@ -389,7 +522,8 @@ CREATE TYPE async_call_state AS ENUM (
     'pending', -- The call is scheduled to be executed.
     'executing',  -- A controller is executing the call.
     'success', -- The call was successful.
-    'error' -- The call failed and "error" is populated.
+    'error', -- The call failed and "error" is populated.
+    'discarded' -- The call failed and was discarded by an operator.
 );
 
 CREATE TYPE async_call_origin AS ENUM (
@@ -419,32 +553,49 @@ CREATE TABLE async_calls (
     -- Populated on success.
     response JSONB,
     -- Populated on error.
-    error TEXT
+    error TEXT,
+    -- The call will not be executed before this time.
+    scheduled_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    -- Number of times the call has been retried after failing.
+    retry_attempt INT NOT NULL DEFAULT 0
 );
 
 CREATE INDEX async_calls_state_idx ON async_calls (state);
+CREATE INDEX async_calls_scheduled_at_idx ON async_calls (scheduled_at);
 
 CREATE TYPE fsm_status AS ENUM ('running', 'completed', 'failed');
 
 CREATE TABLE fsm_executions (
   id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
   created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+  -- Reference to the FSM declaration in the schema.
+  fsm schema_ref NOT NULL,
   -- Unique user-provided key identifying the run. In the example above this would be the "invoice ID".
-  key TEXT UNIQUE NOT NULL,
-  -- Name of the finite state machine.
-  name TEXT NOT NULL,
+  key TEXT NOT NULL,
   status fsm_status NOT NULL DEFAULT 'running'::fsm_status,
-  -- The current state of the state machine. NULL if the state machine has not started.
-  state TEXT NOT NULL
+  -- The current state of the state machine. NULL if the state machine has not
+  -- completed its first transition.
+  current_state schema_ref,
+  -- The state the state machine is transitioning to. NULL if no transition is
+  -- in progress.
+  destination_state schema_ref,
+  -- The async call executing the transition to the destination state.
+  async_call_id BIGINT REFERENCES async_calls(id) ON DELETE SET NULL
 );
 
-CREATE INDEX idx_fsm_executions_key ON fsm_executions(key);
+CREATE UNIQUE INDEX idx_fsm_executions_fsm_key ON fsm_executions(fsm, key);
 CREATE INDEX idx_fsm_executions_status ON fsm_executions(status);
+CREATE INDEX idx_fsm_executions_async_call_id ON fsm_executions(async_call_id);
 
+-- Records each state change of an FSM execution.
 CREATE TABLE fsm_transitions (
     id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
     fsm_executions_id BIGINT NOT NULL REFERENCES fsm_executions(id) ON DELETE CASCADE,
+    -- NULL if this is the first transition of the execution.
+    source_state schema_ref,
+    destination_state schema_ref NOT NULL,
     async_calls_id BIGINT NOT NULL REFERENCES async_calls(id) ON DELETE CASCADE
-)
+);
 
 -- migrate:down
\ No newline at end of file
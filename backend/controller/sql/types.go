@@ -13,7 +13,7 @@ import (
 	"github.com/TBD54566975/ftl/internal/model"
 )
 
-type NullRef = optional.Option[schema.Ref]
+type NullSchemaRef = optional.Option[schema.Ref]
 type NullUUID = optional.Option[uuid.UUID]
 type NullLeaseKey = optional.Option[leases.Key]
 type NullTime = optional.Option[time.Time]
@@ -22,6 +22,11 @@ type NullRunnerKey = optional.Option[model.RunnerKey]
 type NullCronJobKey = optional.Option[model.CronJobKey]
 type NullDeploymentKey = optional.Option[model.DeploymentKey]
 type NullRequestKey = optional.Option[model.RequestKey]
+type NullTopicKey = optional.Option[model.TopicKey]
+type NullSubscriptionKey = optional.Option[model.SubscriptionKey]
+type NullSubscriberKey = optional.Option[model.SubscriberKey]
+type NullTopicEventKey = optional.Option[model.TopicEventKey]
+type NullConnectionKey = optional.Option[model.ConnectionKey]
 
 var _ sql.Scanner = (*NullRunnerKey)(nil)
 var _ driver.Valuer = (*NullRunnerKey)(nil)
@@ -34,3 +39,18 @@ var _ driver.Valuer = (*NullDeploymentKey)(nil)
 
 var _ sql.Scanner = (*NullRequestKey)(nil)
 var _ driver.Valuer = (*NullRequestKey)(nil)
+
+var _ sql.Scanner = (*NullTopicKey)(nil)
+var _ driver.Valuer = (*NullTopicKey)(nil)
+
+var _ sql.Scanner = (*NullSubscriptionKey)(nil)
+var _ driver.Valuer = (*NullSubscriptionKey)(nil)
+
+var _ sql.Scanner = (*NullSubscriberKey)(nil)
+var _ driver.Valuer = (*NullSubscriberKey)(nil)
+
+var _ sql.Scanner = (*NullTopicEventKey)(nil)
+var _ driver.Valuer = (*NullTopicEventKey)(nil)
+
+var _ sql.Scanner = (*NullConnectionKey)(nil)
+var _ driver.Valuer = (*NullConnectionKey)(nil)
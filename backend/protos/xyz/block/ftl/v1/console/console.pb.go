@@ -1397,6 +1397,147 @@ func (x *GetEventsResponse) GetCursor() int64 {
 	return 0
 }
 
+type ReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Request to replay.
+	RequestKey string `protobuf:"bytes,1,opt,name=request_key,json=requestKey,proto3" json:"request_key,omitempty"`
+	// Deployment to replay the request against. Defaults to the current
+	// deployment of the verb's module.
+	DeploymentKey *string `protobuf:"bytes,2,opt,name=deployment_key,json=deploymentKey,proto3,oneof" json:"deployment_key,omitempty"`
+}
+
+func (x *ReplayRequest) Reset() {
+	*x = ReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRequest) ProtoMessage() {}
+
+func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayRequest.ProtoReflect.Descriptor instead.
+func (*ReplayRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_console_console_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplayRequest) GetRequestKey() string {
+	if x != nil {
+		return x.RequestKey
+	}
+	return ""
+}
+
+func (x *ReplayRequest) GetDeploymentKey() string {
+	if x != nil && x.DeploymentKey != nil {
+		return *x.DeploymentKey
+	}
+	return ""
+}
+
+type ReplayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Key of the new request created by the replay.
+	RequestKey string `protobuf:"bytes,1,opt,name=request_key,json=requestKey,proto3" json:"request_key,omitempty"`
+	// The originally recorded call.
+	Original *CallEvent `protobuf:"bytes,2,opt,name=original,proto3" json:"original,omitempty"`
+	Response string     `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	Error    *string    `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Unified diff of the original response against the replayed response.
+	// Empty if they are identical.
+	Diff string `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *ReplayResponse) Reset() {
+	*x = ReplayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayResponse) ProtoMessage() {}
+
+func (x *ReplayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayResponse.ProtoReflect.Descriptor instead.
+func (*ReplayResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_console_console_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReplayResponse) GetRequestKey() string {
+	if x != nil {
+		return x.RequestKey
+	}
+	return ""
+}
+
+func (x *ReplayResponse) GetOriginal() *CallEvent {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *ReplayResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *ReplayResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ReplayResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
 // Limit the number of events returned.
 type EventsQuery_LimitFilter struct {
 	state         protoimpl.MessageState
@@ -1409,7 +1550,7 @@ type EventsQuery_LimitFilter struct {
 func (x *EventsQuery_LimitFilter) Reset() {
 	*x = EventsQuery_LimitFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[19]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1422,7 +1563,7 @@ func (x *EventsQuery_LimitFilter) String() string {
 func (*EventsQuery_LimitFilter) ProtoMessage() {}
 
 func (x *EventsQuery_LimitFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[19]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1598,7 @@ type EventsQuery_LogLevelFilter struct {
 func (x *EventsQuery_LogLevelFilter) Reset() {
 	*x = EventsQuery_LogLevelFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[20]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1470,7 +1611,7 @@ func (x *EventsQuery_LogLevelFilter) String() string {
 func (*EventsQuery_LogLevelFilter) ProtoMessage() {}
 
 func (x *EventsQuery_LogLevelFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[20]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1505,7 +1646,7 @@ type EventsQuery_DeploymentFilter struct {
 func (x *EventsQuery_DeploymentFilter) Reset() {
 	*x = EventsQuery_DeploymentFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[21]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1518,7 +1659,7 @@ func (x *EventsQuery_DeploymentFilter) String() string {
 func (*EventsQuery_DeploymentFilter) ProtoMessage() {}
 
 func (x *EventsQuery_DeploymentFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[21]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,7 +1694,7 @@ type EventsQuery_RequestFilter struct {
 func (x *EventsQuery_RequestFilter) Reset() {
 	*x = EventsQuery_RequestFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[22]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1566,7 +1707,7 @@ func (x *EventsQuery_RequestFilter) String() string {
 func (*EventsQuery_RequestFilter) ProtoMessage() {}
 
 func (x *EventsQuery_RequestFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[22]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1601,7 +1742,7 @@ type EventsQuery_EventTypeFilter struct {
 func (x *EventsQuery_EventTypeFilter) Reset() {
 	*x = EventsQuery_EventTypeFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[23]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1614,7 +1755,7 @@ func (x *EventsQuery_EventTypeFilter) String() string {
 func (*EventsQuery_EventTypeFilter) ProtoMessage() {}
 
 func (x *EventsQuery_EventTypeFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[23]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1652,7 +1793,7 @@ type EventsQuery_TimeFilter struct {
 func (x *EventsQuery_TimeFilter) Reset() {
 	*x = EventsQuery_TimeFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[24]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1665,7 +1806,7 @@ func (x *EventsQuery_TimeFilter) String() string {
 func (*EventsQuery_TimeFilter) ProtoMessage() {}
 
 func (x *EventsQuery_TimeFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[24]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1710,7 +1851,7 @@ type EventsQuery_IDFilter struct {
 func (x *EventsQuery_IDFilter) Reset() {
 	*x = EventsQuery_IDFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[25]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1723,7 +1864,7 @@ func (x *EventsQuery_IDFilter) String() string {
 func (*EventsQuery_IDFilter) ProtoMessage() {}
 
 func (x *EventsQuery_IDFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[25]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,7 +1908,7 @@ type EventsQuery_CallFilter struct {
 func (x *EventsQuery_CallFilter) Reset() {
 	*x = EventsQuery_CallFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[26]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1780,7 +1921,7 @@ func (x *EventsQuery_CallFilter) String() string {
 func (*EventsQuery_CallFilter) ProtoMessage() {}
 
 func (x *EventsQuery_CallFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[26]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +1981,7 @@ type EventsQuery_Filter struct {
 func (x *EventsQuery_Filter) Reset() {
 	*x = EventsQuery_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[27]
+		mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1853,7 +1994,7 @@ func (x *EventsQuery_Filter) String() string {
 func (*EventsQuery_Filter) ProtoMessage() {}
 
 func (x *EventsQuery_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[27]
+	mi := &file_xyz_block_ftl_v1_console_console_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2291,56 +2432,81 @@ var file_xyz_block_ftl_v1_console_console_proto_rawDesc = []byte{
 	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x06,
 	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06,
 	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x2a, 0x92, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x10, 0x01, 0x12, 0x13,
-	0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4c,
-	0x4c, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x88, 0x01, 0x0a, 0x08, 0x4c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a,
-	0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45,
-	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
-	0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c,
-	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x4c,
-	0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x0d, 0x12,
-	0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x11, 0x32, 0x97, 0x03, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12,
-	0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
-	0x90, 0x02, 0x01, 0x12, 0x67, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x2b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
-	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0c,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x78,
-	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x78, 0x79,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x6f, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x88,
+	0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xc7, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x08, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x79,
 	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63,
-	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5f, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x6f,
-	0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x1a, 0x2b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x50,
-	0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54,
-	0x42, 0x44, 0x35, 0x34, 0x35, 0x36, 0x36, 0x39, 0x37, 0x35, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x79,
-	0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x3b, 0x70, 0x62, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a,
+	0x92, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x12, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x21,
+	0x0a, 0x1d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x50,
+	0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x2a, 0x88, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f,
+	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47,
+	0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x0d, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f,
+	0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x11, 0x32,
+	0xf4, 0x03, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x67,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x78,
+	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x2b, 0x2e, 0x78,
+	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x06, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x12, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x78,
+	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x50, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x42, 0x44, 0x35, 0x34, 0x35, 0x36, 0x36, 0x39,
+	0x37, 0x35, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f,
+	0x66, 0x74, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x3b, 0x70,
+	0x62, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2356,7 +2522,7 @@ func file_xyz_block_ftl_v1_console_console_proto_rawDescGZIP() []byte {
 }
 
 var file_xyz_block_ftl_v1_console_console_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_xyz_block_ftl_v1_console_console_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_xyz_block_ftl_v1_console_console_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_xyz_block_ftl_v1_console_console_proto_goTypes = []interface{}{
 	(EventType)(0),                       // 0: xyz.block.ftl.v1.console.EventType
 	(LogLevel)(0),                        // 1: xyz.block.ftl.v1.console.LogLevel
@@ -2379,37 +2545,39 @@ var file_xyz_block_ftl_v1_console_console_proto_goTypes = []interface{}{
 	(*StreamEventsResponse)(nil),         // 18: xyz.block.ftl.v1.console.StreamEventsResponse
 	(*Event)(nil),                        // 19: xyz.block.ftl.v1.console.Event
 	(*GetEventsResponse)(nil),            // 20: xyz.block.ftl.v1.console.GetEventsResponse
-	nil,                                  // 21: xyz.block.ftl.v1.console.LogEvent.AttributesEntry
-	(*EventsQuery_LimitFilter)(nil),      // 22: xyz.block.ftl.v1.console.EventsQuery.LimitFilter
-	(*EventsQuery_LogLevelFilter)(nil),   // 23: xyz.block.ftl.v1.console.EventsQuery.LogLevelFilter
-	(*EventsQuery_DeploymentFilter)(nil), // 24: xyz.block.ftl.v1.console.EventsQuery.DeploymentFilter
-	(*EventsQuery_RequestFilter)(nil),    // 25: xyz.block.ftl.v1.console.EventsQuery.RequestFilter
-	(*EventsQuery_EventTypeFilter)(nil),  // 26: xyz.block.ftl.v1.console.EventsQuery.EventTypeFilter
-	(*EventsQuery_TimeFilter)(nil),       // 27: xyz.block.ftl.v1.console.EventsQuery.TimeFilter
-	(*EventsQuery_IDFilter)(nil),         // 28: xyz.block.ftl.v1.console.EventsQuery.IDFilter
-	(*EventsQuery_CallFilter)(nil),       // 29: xyz.block.ftl.v1.console.EventsQuery.CallFilter
-	(*EventsQuery_Filter)(nil),           // 30: xyz.block.ftl.v1.console.EventsQuery.Filter
-	(*timestamppb.Timestamp)(nil),        // 31: google.protobuf.Timestamp
-	(*schema.Ref)(nil),                   // 32: xyz.block.ftl.v1.schema.Ref
-	(*durationpb.Duration)(nil),          // 33: google.protobuf.Duration
-	(*schema.Verb)(nil),                  // 34: xyz.block.ftl.v1.schema.Verb
-	(*schema.Data)(nil),                  // 35: xyz.block.ftl.v1.schema.Data
-	(*schema.Secret)(nil),                // 36: xyz.block.ftl.v1.schema.Secret
-	(*schema.Config)(nil),                // 37: xyz.block.ftl.v1.schema.Config
-	(*v1.PingRequest)(nil),               // 38: xyz.block.ftl.v1.PingRequest
-	(*v1.PingResponse)(nil),              // 39: xyz.block.ftl.v1.PingResponse
+	(*ReplayRequest)(nil),                // 21: xyz.block.ftl.v1.console.ReplayRequest
+	(*ReplayResponse)(nil),               // 22: xyz.block.ftl.v1.console.ReplayResponse
+	nil,                                  // 23: xyz.block.ftl.v1.console.LogEvent.AttributesEntry
+	(*EventsQuery_LimitFilter)(nil),      // 24: xyz.block.ftl.v1.console.EventsQuery.LimitFilter
+	(*EventsQuery_LogLevelFilter)(nil),   // 25: xyz.block.ftl.v1.console.EventsQuery.LogLevelFilter
+	(*EventsQuery_DeploymentFilter)(nil), // 26: xyz.block.ftl.v1.console.EventsQuery.DeploymentFilter
+	(*EventsQuery_RequestFilter)(nil),    // 27: xyz.block.ftl.v1.console.EventsQuery.RequestFilter
+	(*EventsQuery_EventTypeFilter)(nil),  // 28: xyz.block.ftl.v1.console.EventsQuery.EventTypeFilter
+	(*EventsQuery_TimeFilter)(nil),       // 29: xyz.block.ftl.v1.console.EventsQuery.TimeFilter
+	(*EventsQuery_IDFilter)(nil),         // 30: xyz.block.ftl.v1.console.EventsQuery.IDFilter
+	(*EventsQuery_CallFilter)(nil),       // 31: xyz.block.ftl.v1.console.EventsQuery.CallFilter
+	(*EventsQuery_Filter)(nil),           // 32: xyz.block.ftl.v1.console.EventsQuery.Filter
+	(*timestamppb.Timestamp)(nil),        // 33: google.protobuf.Timestamp
+	(*schema.Ref)(nil),                   // 34: xyz.block.ftl.v1.schema.Ref
+	(*durationpb.Duration)(nil),          // 35: google.protobuf.Duration
+	(*schema.Verb)(nil),                  // 36: xyz.block.ftl.v1.schema.Verb
+	(*schema.Data)(nil),                  // 37: xyz.block.ftl.v1.schema.Data
+	(*schema.Secret)(nil),                // 38: xyz.block.ftl.v1.schema.Secret
+	(*schema.Config)(nil),                // 39: xyz.block.ftl.v1.schema.Config
+	(*v1.PingRequest)(nil),               // 40: xyz.block.ftl.v1.PingRequest
+	(*v1.PingResponse)(nil),              // 41: xyz.block.ftl.v1.PingResponse
 }
 var file_xyz_block_ftl_v1_console_console_proto_depIdxs = []int32{
-	31, // 0: xyz.block.ftl.v1.console.LogEvent.time_stamp:type_name -> google.protobuf.Timestamp
-	21, // 1: xyz.block.ftl.v1.console.LogEvent.attributes:type_name -> xyz.block.ftl.v1.console.LogEvent.AttributesEntry
-	31, // 2: xyz.block.ftl.v1.console.CallEvent.time_stamp:type_name -> google.protobuf.Timestamp
-	32, // 3: xyz.block.ftl.v1.console.CallEvent.source_verb_ref:type_name -> xyz.block.ftl.v1.schema.Ref
-	32, // 4: xyz.block.ftl.v1.console.CallEvent.destination_verb_ref:type_name -> xyz.block.ftl.v1.schema.Ref
-	33, // 5: xyz.block.ftl.v1.console.CallEvent.duration:type_name -> google.protobuf.Duration
-	34, // 6: xyz.block.ftl.v1.console.Verb.verb:type_name -> xyz.block.ftl.v1.schema.Verb
-	35, // 7: xyz.block.ftl.v1.console.Data.data:type_name -> xyz.block.ftl.v1.schema.Data
-	36, // 8: xyz.block.ftl.v1.console.Secret.secret:type_name -> xyz.block.ftl.v1.schema.Secret
-	37, // 9: xyz.block.ftl.v1.console.Config.config:type_name -> xyz.block.ftl.v1.schema.Config
+	33, // 0: xyz.block.ftl.v1.console.LogEvent.time_stamp:type_name -> google.protobuf.Timestamp
+	23, // 1: xyz.block.ftl.v1.console.LogEvent.attributes:type_name -> xyz.block.ftl.v1.console.LogEvent.AttributesEntry
+	33, // 2: xyz.block.ftl.v1.console.CallEvent.time_stamp:type_name -> google.protobuf.Timestamp
+	34, // 3: xyz.block.ftl.v1.console.CallEvent.source_verb_ref:type_name -> xyz.block.ftl.v1.schema.Ref
+	34, // 4: xyz.block.ftl.v1.console.CallEvent.destination_verb_ref:type_name -> xyz.block.ftl.v1.schema.Ref
+	35, // 5: xyz.block.ftl.v1.console.CallEvent.duration:type_name -> google.protobuf.Duration
+	36, // 6: xyz.block.ftl.v1.console.Verb.verb:type_name -> xyz.block.ftl.v1.schema.Verb
+	37, // 7: xyz.block.ftl.v1.console.Data.data:type_name -> xyz.block.ftl.v1.schema.Data
+	38, // 8: xyz.block.ftl.v1.console.Secret.secret:type_name -> xyz.block.ftl.v1.schema.Secret
+	39, // 9: xyz.block.ftl.v1.console.Config.config:type_name -> xyz.block.ftl.v1.schema.Config
 	7,  // 10: xyz.block.ftl.v1.console.Module.verbs:type_name -> xyz.block.ftl.v1.console.Verb
 	8,  // 11: xyz.block.ftl.v1.console.Module.data:type_name -> xyz.block.ftl.v1.console.Data
 	9,  // 12: xyz.block.ftl.v1.console.Module.secrets:type_name -> xyz.block.ftl.v1.console.Secret
@@ -2417,42 +2585,45 @@ var file_xyz_block_ftl_v1_console_console_proto_depIdxs = []int32{
 	12, // 14: xyz.block.ftl.v1.console.Topology.levels:type_name -> xyz.block.ftl.v1.console.TopologyGroup
 	11, // 15: xyz.block.ftl.v1.console.GetModulesResponse.modules:type_name -> xyz.block.ftl.v1.console.Module
 	13, // 16: xyz.block.ftl.v1.console.GetModulesResponse.topology:type_name -> xyz.block.ftl.v1.console.Topology
-	30, // 17: xyz.block.ftl.v1.console.EventsQuery.filters:type_name -> xyz.block.ftl.v1.console.EventsQuery.Filter
+	32, // 17: xyz.block.ftl.v1.console.EventsQuery.filters:type_name -> xyz.block.ftl.v1.console.EventsQuery.Filter
 	2,  // 18: xyz.block.ftl.v1.console.EventsQuery.order:type_name -> xyz.block.ftl.v1.console.EventsQuery.Order
-	33, // 19: xyz.block.ftl.v1.console.StreamEventsRequest.update_interval:type_name -> google.protobuf.Duration
+	35, // 19: xyz.block.ftl.v1.console.StreamEventsRequest.update_interval:type_name -> google.protobuf.Duration
 	16, // 20: xyz.block.ftl.v1.console.StreamEventsRequest.query:type_name -> xyz.block.ftl.v1.console.EventsQuery
 	19, // 21: xyz.block.ftl.v1.console.StreamEventsResponse.events:type_name -> xyz.block.ftl.v1.console.Event
-	31, // 22: xyz.block.ftl.v1.console.Event.time_stamp:type_name -> google.protobuf.Timestamp
+	33, // 22: xyz.block.ftl.v1.console.Event.time_stamp:type_name -> google.protobuf.Timestamp
 	3,  // 23: xyz.block.ftl.v1.console.Event.log:type_name -> xyz.block.ftl.v1.console.LogEvent
 	4,  // 24: xyz.block.ftl.v1.console.Event.call:type_name -> xyz.block.ftl.v1.console.CallEvent
 	5,  // 25: xyz.block.ftl.v1.console.Event.deployment_created:type_name -> xyz.block.ftl.v1.console.DeploymentCreatedEvent
 	6,  // 26: xyz.block.ftl.v1.console.Event.deployment_updated:type_name -> xyz.block.ftl.v1.console.DeploymentUpdatedEvent
 	19, // 27: xyz.block.ftl.v1.console.GetEventsResponse.events:type_name -> xyz.block.ftl.v1.console.Event
-	1,  // 28: xyz.block.ftl.v1.console.EventsQuery.LogLevelFilter.log_level:type_name -> xyz.block.ftl.v1.console.LogLevel
-	0,  // 29: xyz.block.ftl.v1.console.EventsQuery.EventTypeFilter.event_types:type_name -> xyz.block.ftl.v1.console.EventType
-	31, // 30: xyz.block.ftl.v1.console.EventsQuery.TimeFilter.older_than:type_name -> google.protobuf.Timestamp
-	31, // 31: xyz.block.ftl.v1.console.EventsQuery.TimeFilter.newer_than:type_name -> google.protobuf.Timestamp
-	22, // 32: xyz.block.ftl.v1.console.EventsQuery.Filter.limit:type_name -> xyz.block.ftl.v1.console.EventsQuery.LimitFilter
-	23, // 33: xyz.block.ftl.v1.console.EventsQuery.Filter.log_level:type_name -> xyz.block.ftl.v1.console.EventsQuery.LogLevelFilter
-	24, // 34: xyz.block.ftl.v1.console.EventsQuery.Filter.deployments:type_name -> xyz.block.ftl.v1.console.EventsQuery.DeploymentFilter
-	25, // 35: xyz.block.ftl.v1.console.EventsQuery.Filter.requests:type_name -> xyz.block.ftl.v1.console.EventsQuery.RequestFilter
-	26, // 36: xyz.block.ftl.v1.console.EventsQuery.Filter.event_types:type_name -> xyz.block.ftl.v1.console.EventsQuery.EventTypeFilter
-	27, // 37: xyz.block.ftl.v1.console.EventsQuery.Filter.time:type_name -> xyz.block.ftl.v1.console.EventsQuery.TimeFilter
-	28, // 38: xyz.block.ftl.v1.console.EventsQuery.Filter.id:type_name -> xyz.block.ftl.v1.console.EventsQuery.IDFilter
-	29, // 39: xyz.block.ftl.v1.console.EventsQuery.Filter.call:type_name -> xyz.block.ftl.v1.console.EventsQuery.CallFilter
-	38, // 40: xyz.block.ftl.v1.console.ConsoleService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
-	14, // 41: xyz.block.ftl.v1.console.ConsoleService.GetModules:input_type -> xyz.block.ftl.v1.console.GetModulesRequest
-	17, // 42: xyz.block.ftl.v1.console.ConsoleService.StreamEvents:input_type -> xyz.block.ftl.v1.console.StreamEventsRequest
-	16, // 43: xyz.block.ftl.v1.console.ConsoleService.GetEvents:input_type -> xyz.block.ftl.v1.console.EventsQuery
-	39, // 44: xyz.block.ftl.v1.console.ConsoleService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
-	15, // 45: xyz.block.ftl.v1.console.ConsoleService.GetModules:output_type -> xyz.block.ftl.v1.console.GetModulesResponse
-	18, // 46: xyz.block.ftl.v1.console.ConsoleService.StreamEvents:output_type -> xyz.block.ftl.v1.console.StreamEventsResponse
-	20, // 47: xyz.block.ftl.v1.console.ConsoleService.GetEvents:output_type -> xyz.block.ftl.v1.console.GetEventsResponse
-	44, // [44:48] is the sub-list for method output_type
-	40, // [40:44] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	4,  // 28: xyz.block.ftl.v1.console.ReplayResponse.original:type_name -> xyz.block.ftl.v1.console.CallEvent
+	1,  // 29: xyz.block.ftl.v1.console.EventsQuery.LogLevelFilter.log_level:type_name -> xyz.block.ftl.v1.console.LogLevel
+	0,  // 30: xyz.block.ftl.v1.console.EventsQuery.EventTypeFilter.event_types:type_name -> xyz.block.ftl.v1.console.EventType
+	33, // 31: xyz.block.ftl.v1.console.EventsQuery.TimeFilter.older_than:type_name -> google.protobuf.Timestamp
+	33, // 32: xyz.block.ftl.v1.console.EventsQuery.TimeFilter.newer_than:type_name -> google.protobuf.Timestamp
+	24, // 33: xyz.block.ftl.v1.console.EventsQuery.Filter.limit:type_name -> xyz.block.ftl.v1.console.EventsQuery.LimitFilter
+	25, // 34: xyz.block.ftl.v1.console.EventsQuery.Filter.log_level:type_name -> xyz.block.ftl.v1.console.EventsQuery.LogLevelFilter
+	26, // 35: xyz.block.ftl.v1.console.EventsQuery.Filter.deployments:type_name -> xyz.block.ftl.v1.console.EventsQuery.DeploymentFilter
+	27, // 36: xyz.block.ftl.v1.console.EventsQuery.Filter.requests:type_name -> xyz.block.ftl.v1.console.EventsQuery.RequestFilter
+	28, // 37: xyz.block.ftl.v1.console.EventsQuery.Filter.event_types:type_name -> xyz.block.ftl.v1.console.EventsQuery.EventTypeFilter
+	29, // 38: xyz.block.ftl.v1.console.EventsQuery.Filter.time:type_name -> xyz.block.ftl.v1.console.EventsQuery.TimeFilter
+	30, // 39: xyz.block.ftl.v1.console.EventsQuery.Filter.id:type_name -> xyz.block.ftl.v1.console.EventsQuery.IDFilter
+	31, // 40: xyz.block.ftl.v1.console.EventsQuery.Filter.call:type_name -> xyz.block.ftl.v1.console.EventsQuery.CallFilter
+	40, // 41: xyz.block.ftl.v1.console.ConsoleService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
+	14, // 42: xyz.block.ftl.v1.console.ConsoleService.GetModules:input_type -> xyz.block.ftl.v1.console.GetModulesRequest
+	17, // 43: xyz.block.ftl.v1.console.ConsoleService.StreamEvents:input_type -> xyz.block.ftl.v1.console.StreamEventsRequest
+	16, // 44: xyz.block.ftl.v1.console.ConsoleService.GetEvents:input_type -> xyz.block.ftl.v1.console.EventsQuery
+	21, // 45: xyz.block.ftl.v1.console.ConsoleService.Replay:input_type -> xyz.block.ftl.v1.console.ReplayRequest
+	41, // 46: xyz.block.ftl.v1.console.ConsoleService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
+	15, // 47: xyz.block.ftl.v1.console.ConsoleService.GetModules:output_type -> xyz.block.ftl.v1.console.GetModulesResponse
+	18, // 48: xyz.block.ftl.v1.console.ConsoleService.StreamEvents:output_type -> xyz.block.ftl.v1.console.StreamEventsResponse
+	20, // 49: xyz.block.ftl.v1.console.ConsoleService.GetEvents:output_type -> xyz.block.ftl.v1.console.GetEventsResponse
+	22, // 50: xyz.block.ftl.v1.console.ConsoleService.Replay:output_type -> xyz.block.ftl.v1.console.ReplayResponse
+	46, // [46:51] is the sub-list for method output_type
+	41, // [41:46] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_xyz_block_ftl_v1_console_console_proto_init() }
@@ -2677,7 +2848,31 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 		file_xyz_block_ftl_v1_console_console_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_LimitFilter); i {
 			case 0:
 				return &v.state
@@ -2689,7 +2884,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_console_console_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_LogLevelFilter); i {
 			case 0:
 				return &v.state
@@ -2701,7 +2896,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_console_console_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_DeploymentFilter); i {
 			case 0:
 				return &v.state
@@ -2713,7 +2908,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_console_console_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_RequestFilter); i {
 			case 0:
 				return &v.state
@@ -2725,7 +2920,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_console_console_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_EventTypeFilter); i {
 			case 0:
 				return &v.state
@@ -2737,7 +2932,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_console_console_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_TimeFilter); i {
 			case 0:
 				return &v.state
@@ -2749,7 +2944,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_console_console_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_IDFilter); i {
 			case 0:
 				return &v.state
@@ -2761,7 +2956,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_console_console_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_CallFilter); i {
 			case 0:
 				return &v.state
@@ -2773,7 +2968,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 				return nil
 			}
 		}
-		file_xyz_block_ftl_v1_console_console_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_xyz_block_ftl_v1_console_console_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsQuery_Filter); i {
 			case 0:
 				return &v.state
@@ -2797,10 +2992,12 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 		(*Event_DeploymentUpdated)(nil),
 	}
 	file_xyz_block_ftl_v1_console_console_proto_msgTypes[17].OneofWrappers = []interface{}{}
-	file_xyz_block_ftl_v1_console_console_proto_msgTypes[24].OneofWrappers = []interface{}{}
-	file_xyz_block_ftl_v1_console_console_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_xyz_block_ftl_v1_console_console_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_xyz_block_ftl_v1_console_console_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	file_xyz_block_ftl_v1_console_console_proto_msgTypes[26].OneofWrappers = []interface{}{}
-	file_xyz_block_ftl_v1_console_console_proto_msgTypes[27].OneofWrappers = []interface{}{
+	file_xyz_block_ftl_v1_console_console_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_xyz_block_ftl_v1_console_console_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_xyz_block_ftl_v1_console_console_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*EventsQuery_Filter_Limit)(nil),
 		(*EventsQuery_Filter_LogLevel)(nil),
 		(*EventsQuery_Filter_Deployments)(nil),
@@ -2816,7 +3013,7 @@ func file_xyz_block_ftl_v1_console_console_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xyz_block_ftl_v1_console_console_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   28,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
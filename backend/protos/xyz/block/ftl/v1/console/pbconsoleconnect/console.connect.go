@@ -45,6 +45,8 @@ const (
 	// ConsoleServiceGetEventsProcedure is the fully-qualified name of the ConsoleService's GetEvents
 	// RPC.
 	ConsoleServiceGetEventsProcedure = "/xyz.block.ftl.v1.console.ConsoleService/GetEvents"
+	// ConsoleServiceReplayProcedure is the fully-qualified name of the ConsoleService's Replay RPC.
+	ConsoleServiceReplayProcedure = "/xyz.block.ftl.v1.console.ConsoleService/Replay"
 )
 
 // ConsoleServiceClient is a client for the xyz.block.ftl.v1.console.ConsoleService service.
@@ -54,6 +56,11 @@ type ConsoleServiceClient interface {
 	GetModules(context.Context, *connect.Request[console.GetModulesRequest]) (*connect.Response[console.GetModulesResponse], error)
 	StreamEvents(context.Context, *connect.Request[console.StreamEventsRequest]) (*connect.ServerStreamForClient[console.StreamEventsResponse], error)
 	GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error)
+	// Replay a recorded request, re-issuing its original call.
+	//
+	// Requests received through HTTP ingress are re-issued as HTTP requests, and
+	// their HTTP responses are diffed.
+	Replay(context.Context, *connect.Request[console.ReplayRequest]) (*connect.Response[console.ReplayResponse], error)
 }
 
 // NewConsoleServiceClient constructs a client for the xyz.block.ftl.v1.console.ConsoleService
@@ -87,6 +94,11 @@ func NewConsoleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+ConsoleServiceGetEventsProcedure,
 			opts...,
 		),
+		replay: connect.NewClient[console.ReplayRequest, console.ReplayResponse](
+			httpClient,
+			baseURL+ConsoleServiceReplayProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -96,6 +108,7 @@ type consoleServiceClient struct {
 	getModules   *connect.Client[console.GetModulesRequest, console.GetModulesResponse]
 	streamEvents *connect.Client[console.StreamEventsRequest, console.StreamEventsResponse]
 	getEvents    *connect.Client[console.EventsQuery, console.GetEventsResponse]
+	replay       *connect.Client[console.ReplayRequest, console.ReplayResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.console.ConsoleService.Ping.
@@ -118,6 +131,11 @@ func (c *consoleServiceClient) GetEvents(ctx context.Context, req *connect.Reque
 	return c.getEvents.CallUnary(ctx, req)
 }
 
+// Replay calls xyz.block.ftl.v1.console.ConsoleService.Replay.
+func (c *consoleServiceClient) Replay(ctx context.Context, req *connect.Request[console.ReplayRequest]) (*connect.Response[console.ReplayResponse], error) {
+	return c.replay.CallUnary(ctx, req)
+}
+
 // ConsoleServiceHandler is an implementation of the xyz.block.ftl.v1.console.ConsoleService
 // service.
 type ConsoleServiceHandler interface {
@@ -126,6 +144,11 @@ type ConsoleServiceHandler interface {
 	GetModules(context.Context, *connect.Request[console.GetModulesRequest]) (*connect.Response[console.GetModulesResponse], error)
 	StreamEvents(context.Context, *connect.Request[console.StreamEventsRequest], *connect.ServerStream[console.StreamEventsResponse]) error
 	GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error)
+	// Replay a recorded request, re-issuing its original call.
+	//
+	// Requests received through HTTP ingress are re-issued as HTTP requests, and
+	// their HTTP responses are diffed.
+	Replay(context.Context, *connect.Request[console.ReplayRequest]) (*connect.Response[console.ReplayResponse], error)
 }
 
 // NewConsoleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -155,6 +178,11 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 		svc.GetEvents,
 		opts...,
 	)
+	consoleServiceReplayHandler := connect.NewUnaryHandler(
+		ConsoleServiceReplayProcedure,
+		svc.Replay,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.console.ConsoleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConsoleServicePingProcedure:
@@ -165,6 +193,8 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 			consoleServiceStreamEventsHandler.ServeHTTP(w, r)
 		case ConsoleServiceGetEventsProcedure:
 			consoleServiceGetEventsHandler.ServeHTTP(w, r)
+		case ConsoleServiceReplayProcedure:
+			consoleServiceReplayHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -189,3 +219,7 @@ func (UnimplementedConsoleServiceHandler) StreamEvents(context.Context, *connect
 func (UnimplementedConsoleServiceHandler) GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.console.ConsoleService.GetEvents is not implemented"))
 }
+
+func (UnimplementedConsoleServiceHandler) Replay(context.Context, *connect.Request[console.ReplayRequest]) (*connect.Response[console.ReplayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.console.ConsoleService.Replay is not implemented"))
+}
@@ -45,8 +45,20 @@ const (
 	// VerbServiceAcquireLeaseProcedure is the fully-qualified name of the VerbService's AcquireLease
 	// RPC.
 	VerbServiceAcquireLeaseProcedure = "/xyz.block.ftl.v1.VerbService/AcquireLease"
+	// VerbServicePublishEventProcedure is the fully-qualified name of the VerbService's PublishEvent
+	// RPC.
+	VerbServicePublishEventProcedure = "/xyz.block.ftl.v1.VerbService/PublishEvent"
+	// VerbServiceSendFSMEventProcedure is the fully-qualified name of the VerbService's SendFSMEvent
+	// RPC.
+	VerbServiceSendFSMEventProcedure = "/xyz.block.ftl.v1.VerbService/SendFSMEvent"
+	// VerbServiceSendToConnectionProcedure is the fully-qualified name of the VerbService's
+	// SendToConnection RPC.
+	VerbServiceSendToConnectionProcedure = "/xyz.block.ftl.v1.VerbService/SendToConnection"
 	// VerbServiceCallProcedure is the fully-qualified name of the VerbService's Call RPC.
 	VerbServiceCallProcedure = "/xyz.block.ftl.v1.VerbService/Call"
+	// VerbServiceServerStreamProcedure is the fully-qualified name of the VerbService's ServerStream
+	// RPC.
+	VerbServiceServerStreamProcedure = "/xyz.block.ftl.v1.VerbService/ServerStream"
 	// ControllerServicePingProcedure is the fully-qualified name of the ControllerService's Ping RPC.
 	ControllerServicePingProcedure = "/xyz.block.ftl.v1.ControllerService/Ping"
 	// ControllerServiceProcessListProcedure is the fully-qualified name of the ControllerService's
@@ -79,6 +91,18 @@ const (
 	// ControllerServiceReplaceDeployProcedure is the fully-qualified name of the ControllerService's
 	// ReplaceDeploy RPC.
 	ControllerServiceReplaceDeployProcedure = "/xyz.block.ftl.v1.ControllerService/ReplaceDeploy"
+	// ControllerServiceListDeploymentsProcedure is the fully-qualified name of the ControllerService's
+	// ListDeployments RPC.
+	ControllerServiceListDeploymentsProcedure = "/xyz.block.ftl.v1.ControllerService/ListDeployments"
+	// ControllerServicePromoteCanaryProcedure is the fully-qualified name of the ControllerService's
+	// PromoteCanary RPC.
+	ControllerServicePromoteCanaryProcedure = "/xyz.block.ftl.v1.ControllerService/PromoteCanary"
+	// ControllerServiceAbortCanaryProcedure is the fully-qualified name of the ControllerService's
+	// AbortCanary RPC.
+	ControllerServiceAbortCanaryProcedure = "/xyz.block.ftl.v1.ControllerService/AbortCanary"
+	// ControllerServiceImportEventsProcedure is the fully-qualified name of the ControllerService's
+	// ImportEvents RPC.
+	ControllerServiceImportEventsProcedure = "/xyz.block.ftl.v1.ControllerService/ImportEvents"
 	// ControllerServiceStreamDeploymentLogsProcedure is the fully-qualified name of the
 	// ControllerService's StreamDeploymentLogs RPC.
 	ControllerServiceStreamDeploymentLogsProcedure = "/xyz.block.ftl.v1.ControllerService/StreamDeploymentLogs"
@@ -88,6 +112,39 @@ const (
 	// ControllerServicePullSchemaProcedure is the fully-qualified name of the ControllerService's
 	// PullSchema RPC.
 	ControllerServicePullSchemaProcedure = "/xyz.block.ftl.v1.ControllerService/PullSchema"
+	// ControllerServiceListFailedAsyncCallsProcedure is the fully-qualified name of the
+	// ControllerService's ListFailedAsyncCalls RPC.
+	ControllerServiceListFailedAsyncCallsProcedure = "/xyz.block.ftl.v1.ControllerService/ListFailedAsyncCalls"
+	// ControllerServiceGetAsyncCallProcedure is the fully-qualified name of the ControllerService's
+	// GetAsyncCall RPC.
+	ControllerServiceGetAsyncCallProcedure = "/xyz.block.ftl.v1.ControllerService/GetAsyncCall"
+	// ControllerServiceRequeueAsyncCallProcedure is the fully-qualified name of the ControllerService's
+	// RequeueAsyncCall RPC.
+	ControllerServiceRequeueAsyncCallProcedure = "/xyz.block.ftl.v1.ControllerService/RequeueAsyncCall"
+	// ControllerServiceDiscardAsyncCallProcedure is the fully-qualified name of the ControllerService's
+	// DiscardAsyncCall RPC.
+	ControllerServiceDiscardAsyncCallProcedure = "/xyz.block.ftl.v1.ControllerService/DiscardAsyncCall"
+	// ControllerServiceListCronJobsProcedure is the fully-qualified name of the ControllerService's
+	// ListCronJobs RPC.
+	ControllerServiceListCronJobsProcedure = "/xyz.block.ftl.v1.ControllerService/ListCronJobs"
+	// ControllerServicePauseCronJobProcedure is the fully-qualified name of the ControllerService's
+	// PauseCronJob RPC.
+	ControllerServicePauseCronJobProcedure = "/xyz.block.ftl.v1.ControllerService/PauseCronJob"
+	// ControllerServiceResumeCronJobProcedure is the fully-qualified name of the ControllerService's
+	// ResumeCronJob RPC.
+	ControllerServiceResumeCronJobProcedure = "/xyz.block.ftl.v1.ControllerService/ResumeCronJob"
+	// ControllerServiceTriggerCronJobProcedure is the fully-qualified name of the ControllerService's
+	// TriggerCronJob RPC.
+	ControllerServiceTriggerCronJobProcedure = "/xyz.block.ftl.v1.ControllerService/TriggerCronJob"
+	// ControllerServiceGetCronJobHistoryProcedure is the fully-qualified name of the
+	// ControllerService's GetCronJobHistory RPC.
+	ControllerServiceGetCronJobHistoryProcedure = "/xyz.block.ftl.v1.ControllerService/GetCronJobHistory"
+	// ControllerServiceListLeasesProcedure is the fully-qualified name of the ControllerService's
+	// ListLeases RPC.
+	ControllerServiceListLeasesProcedure = "/xyz.block.ftl.v1.ControllerService/ListLeases"
+	// ControllerServiceReleaseLeaseProcedure is the fully-qualified name of the ControllerService's
+	// ReleaseLease RPC.
+	ControllerServiceReleaseLeaseProcedure = "/xyz.block.ftl.v1.ControllerService/ReleaseLease"
 	// RunnerServicePingProcedure is the fully-qualified name of the RunnerService's Ping RPC.
 	RunnerServicePingProcedure = "/xyz.block.ftl.v1.RunnerService/Ping"
 	// RunnerServiceReserveProcedure is the fully-qualified name of the RunnerService's Reserve RPC.
@@ -108,8 +165,20 @@ type VerbServiceClient interface {
 	//
 	// Returns ResourceExhausted if the lease is held.
 	AcquireLease(context.Context) *connect.BidiStreamForClient[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]
+	// Publish an event to a topic.
+	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
+	// Send an event to an FSM instance, creating the instance if necessary.
+	SendFSMEvent(context.Context, *connect.Request[v1.SendFSMEventRequest]) (*connect.Response[v1.SendFSMEventResponse], error)
+	// Send a message to a WebSocket ingress connection.
+	//
+	// Returns NotFound if the connection is closed.
+	SendToConnection(context.Context, *connect.Request[v1.SendToConnectionRequest]) (*connect.Response[v1.SendToConnectionResponse], error)
 	// Issue a synchronous call to a Verb.
 	Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error)
+	// Issue a call to a streaming Verb, receiving each of its responses.
+	//
+	// The stream ends after the first error response.
+	ServerStream(context.Context, *connect.Request[v1.CallRequest]) (*connect.ServerStreamForClient[v1.CallResponse], error)
 }
 
 // NewVerbServiceClient constructs a client for the xyz.block.ftl.v1.VerbService service. By
@@ -138,11 +207,31 @@ func NewVerbServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			baseURL+VerbServiceAcquireLeaseProcedure,
 			opts...,
 		),
+		publishEvent: connect.NewClient[v1.PublishEventRequest, v1.PublishEventResponse](
+			httpClient,
+			baseURL+VerbServicePublishEventProcedure,
+			opts...,
+		),
+		sendFSMEvent: connect.NewClient[v1.SendFSMEventRequest, v1.SendFSMEventResponse](
+			httpClient,
+			baseURL+VerbServiceSendFSMEventProcedure,
+			opts...,
+		),
+		sendToConnection: connect.NewClient[v1.SendToConnectionRequest, v1.SendToConnectionResponse](
+			httpClient,
+			baseURL+VerbServiceSendToConnectionProcedure,
+			opts...,
+		),
 		call: connect.NewClient[v1.CallRequest, v1.CallResponse](
 			httpClient,
 			baseURL+VerbServiceCallProcedure,
 			opts...,
 		),
+		serverStream: connect.NewClient[v1.CallRequest, v1.CallResponse](
+			httpClient,
+			baseURL+VerbServiceServerStreamProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -151,7 +240,11 @@ type verbServiceClient struct {
 	ping             *connect.Client[v1.PingRequest, v1.PingResponse]
 	getModuleContext *connect.Client[v1.ModuleContextRequest, v1.ModuleContextResponse]
 	acquireLease     *connect.Client[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]
+	publishEvent     *connect.Client[v1.PublishEventRequest, v1.PublishEventResponse]
+	sendFSMEvent     *connect.Client[v1.SendFSMEventRequest, v1.SendFSMEventResponse]
+	sendToConnection *connect.Client[v1.SendToConnectionRequest, v1.SendToConnectionResponse]
 	call             *connect.Client[v1.CallRequest, v1.CallResponse]
+	serverStream     *connect.Client[v1.CallRequest, v1.CallResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.VerbService.Ping.
@@ -169,11 +262,31 @@ func (c *verbServiceClient) AcquireLease(ctx context.Context) *connect.BidiStrea
 	return c.acquireLease.CallBidiStream(ctx)
 }
 
+// PublishEvent calls xyz.block.ftl.v1.VerbService.PublishEvent.
+func (c *verbServiceClient) PublishEvent(ctx context.Context, req *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error) {
+	return c.publishEvent.CallUnary(ctx, req)
+}
+
+// SendFSMEvent calls xyz.block.ftl.v1.VerbService.SendFSMEvent.
+func (c *verbServiceClient) SendFSMEvent(ctx context.Context, req *connect.Request[v1.SendFSMEventRequest]) (*connect.Response[v1.SendFSMEventResponse], error) {
+	return c.sendFSMEvent.CallUnary(ctx, req)
+}
+
+// SendToConnection calls xyz.block.ftl.v1.VerbService.SendToConnection.
+func (c *verbServiceClient) SendToConnection(ctx context.Context, req *connect.Request[v1.SendToConnectionRequest]) (*connect.Response[v1.SendToConnectionResponse], error) {
+	return c.sendToConnection.CallUnary(ctx, req)
+}
+
 // Call calls xyz.block.ftl.v1.VerbService.Call.
 func (c *verbServiceClient) Call(ctx context.Context, req *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error) {
 	return c.call.CallUnary(ctx, req)
 }
 
+// ServerStream calls xyz.block.ftl.v1.VerbService.ServerStream.
+func (c *verbServiceClient) ServerStream(ctx context.Context, req *connect.Request[v1.CallRequest]) (*connect.ServerStreamForClient[v1.CallResponse], error) {
+	return c.serverStream.CallServerStream(ctx, req)
+}
+
 // VerbServiceHandler is an implementation of the xyz.block.ftl.v1.VerbService service.
 type VerbServiceHandler interface {
 	// Ping service for readiness.
@@ -184,8 +297,20 @@ type VerbServiceHandler interface {
 	//
 	// Returns ResourceExhausted if the lease is held.
 	AcquireLease(context.Context, *connect.BidiStream[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]) error
+	// Publish an event to a topic.
+	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
+	// Send an event to an FSM instance, creating the instance if necessary.
+	SendFSMEvent(context.Context, *connect.Request[v1.SendFSMEventRequest]) (*connect.Response[v1.SendFSMEventResponse], error)
+	// Send a message to a WebSocket ingress connection.
+	//
+	// Returns NotFound if the connection is closed.
+	SendToConnection(context.Context, *connect.Request[v1.SendToConnectionRequest]) (*connect.Response[v1.SendToConnectionResponse], error)
 	// Issue a synchronous call to a Verb.
 	Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error)
+	// Issue a call to a streaming Verb, receiving each of its responses.
+	//
+	// The stream ends after the first error response.
+	ServerStream(context.Context, *connect.Request[v1.CallRequest], *connect.ServerStream[v1.CallResponse]) error
 }
 
 // NewVerbServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -210,11 +335,31 @@ func NewVerbServiceHandler(svc VerbServiceHandler, opts ...connect.HandlerOption
 		svc.AcquireLease,
 		opts...,
 	)
+	verbServicePublishEventHandler := connect.NewUnaryHandler(
+		VerbServicePublishEventProcedure,
+		svc.PublishEvent,
+		opts...,
+	)
+	verbServiceSendFSMEventHandler := connect.NewUnaryHandler(
+		VerbServiceSendFSMEventProcedure,
+		svc.SendFSMEvent,
+		opts...,
+	)
+	verbServiceSendToConnectionHandler := connect.NewUnaryHandler(
+		VerbServiceSendToConnectionProcedure,
+		svc.SendToConnection,
+		opts...,
+	)
 	verbServiceCallHandler := connect.NewUnaryHandler(
 		VerbServiceCallProcedure,
 		svc.Call,
 		opts...,
 	)
+	verbServiceServerStreamHandler := connect.NewServerStreamHandler(
+		VerbServiceServerStreamProcedure,
+		svc.ServerStream,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.VerbService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VerbServicePingProcedure:
@@ -223,8 +368,16 @@ func NewVerbServiceHandler(svc VerbServiceHandler, opts ...connect.HandlerOption
 			verbServiceGetModuleContextHandler.ServeHTTP(w, r)
 		case VerbServiceAcquireLeaseProcedure:
 			verbServiceAcquireLeaseHandler.ServeHTTP(w, r)
+		case VerbServicePublishEventProcedure:
+			verbServicePublishEventHandler.ServeHTTP(w, r)
+		case VerbServiceSendFSMEventProcedure:
+			verbServiceSendFSMEventHandler.ServeHTTP(w, r)
+		case VerbServiceSendToConnectionProcedure:
+			verbServiceSendToConnectionHandler.ServeHTTP(w, r)
 		case VerbServiceCallProcedure:
 			verbServiceCallHandler.ServeHTTP(w, r)
+		case VerbServiceServerStreamProcedure:
+			verbServiceServerStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -246,10 +399,26 @@ func (UnimplementedVerbServiceHandler) AcquireLease(context.Context, *connect.Bi
 	return connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.AcquireLease is not implemented"))
 }
 
+func (UnimplementedVerbServiceHandler) PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.PublishEvent is not implemented"))
+}
+
+func (UnimplementedVerbServiceHandler) SendFSMEvent(context.Context, *connect.Request[v1.SendFSMEventRequest]) (*connect.Response[v1.SendFSMEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.SendFSMEvent is not implemented"))
+}
+
+func (UnimplementedVerbServiceHandler) SendToConnection(context.Context, *connect.Request[v1.SendToConnectionRequest]) (*connect.Response[v1.SendToConnectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.SendToConnection is not implemented"))
+}
+
 func (UnimplementedVerbServiceHandler) Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.Call is not implemented"))
 }
 
+func (UnimplementedVerbServiceHandler) ServerStream(context.Context, *connect.Request[v1.CallRequest], *connect.ServerStream[v1.CallResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.ServerStream is not implemented"))
+}
+
 // ControllerServiceClient is a client for the xyz.block.ftl.v1.ControllerService service.
 type ControllerServiceClient interface {
 	// Ping service for readiness.
@@ -282,6 +451,17 @@ type ControllerServiceClient interface {
 	// If a deployment already exists for the module of the new deployment,
 	// it will be scaled down and replaced by the new one.
 	ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error)
+	// List all deployments of a module, active or not.
+	ListDeployments(context.Context, *connect.Request[v1.ListDeploymentsRequest]) (*connect.Response[v1.ListDeploymentsResponse], error)
+	// Promote a canary deployment to replace the existing deployment of its
+	// module.
+	PromoteCanary(context.Context, *connect.Request[v1.PromoteCanaryRequest]) (*connect.Response[v1.PromoteCanaryResponse], error)
+	// Abort a canary deployment, routing all traffic back to the existing
+	// deployment of its module.
+	AbortCanary(context.Context, *connect.Request[v1.AbortCanaryRequest]) (*connect.Response[v1.AbortCanaryResponse], error)
+	// Import events from an archive of expired events, so that they can be
+	// queried again.
+	ImportEvents(context.Context, *connect.Request[v1.ImportEventsRequest]) (*connect.Response[v1.ImportEventsResponse], error)
 	// Stream logs from a deployment
 	StreamDeploymentLogs(context.Context) *connect.ClientStreamForClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse]
 	// Get the full schema.
@@ -291,6 +471,28 @@ type ControllerServiceClient interface {
 	// Note that if there are no deployments this will block indefinitely, making it unsuitable for
 	// just retrieving the schema. Use GetSchema for that.
 	PullSchema(context.Context, *connect.Request[v1.PullSchemaRequest]) (*connect.ServerStreamForClient[v1.PullSchemaResponse], error)
+	// List async calls that have failed and exhausted their retries.
+	ListFailedAsyncCalls(context.Context, *connect.Request[v1.ListFailedAsyncCallsRequest]) (*connect.Response[v1.ListFailedAsyncCallsResponse], error)
+	// Get an async call.
+	GetAsyncCall(context.Context, *connect.Request[v1.GetAsyncCallRequest]) (*connect.Response[v1.GetAsyncCallResponse], error)
+	// Schedule a failed async call to be executed again.
+	RequeueAsyncCall(context.Context, *connect.Request[v1.RequeueAsyncCallRequest]) (*connect.Response[v1.RequeueAsyncCallResponse], error)
+	// Discard a failed async call.
+	DiscardAsyncCall(context.Context, *connect.Request[v1.DiscardAsyncCallRequest]) (*connect.Response[v1.DiscardAsyncCallResponse], error)
+	// List the cron jobs of active deployments.
+	ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error)
+	// Pause a cron job so it is not executed until resumed.
+	PauseCronJob(context.Context, *connect.Request[v1.PauseCronJobRequest]) (*connect.Response[v1.PauseCronJobResponse], error)
+	// Resume a paused cron job.
+	ResumeCronJob(context.Context, *connect.Request[v1.ResumeCronJobRequest]) (*connect.Response[v1.ResumeCronJobResponse], error)
+	// Execute a cron job immediately, waiting for it to finish.
+	TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error)
+	// Get the most recent executions of a cron job.
+	GetCronJobHistory(context.Context, *connect.Request[v1.GetCronJobHistoryRequest]) (*connect.Response[v1.GetCronJobHistoryResponse], error)
+	// List held leases.
+	ListLeases(context.Context, *connect.Request[v1.ListLeasesRequest]) (*connect.Response[v1.ListLeasesResponse], error)
+	// Forcibly release a lease, regardless of who holds it.
+	ReleaseLease(context.Context, *connect.Request[v1.ReleaseLeaseRequest]) (*connect.Response[v1.ReleaseLeaseResponse], error)
 }
 
 // NewControllerServiceClient constructs a client for the xyz.block.ftl.v1.ControllerService
@@ -359,6 +561,26 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+ControllerServiceReplaceDeployProcedure,
 			opts...,
 		),
+		listDeployments: connect.NewClient[v1.ListDeploymentsRequest, v1.ListDeploymentsResponse](
+			httpClient,
+			baseURL+ControllerServiceListDeploymentsProcedure,
+			opts...,
+		),
+		promoteCanary: connect.NewClient[v1.PromoteCanaryRequest, v1.PromoteCanaryResponse](
+			httpClient,
+			baseURL+ControllerServicePromoteCanaryProcedure,
+			opts...,
+		),
+		abortCanary: connect.NewClient[v1.AbortCanaryRequest, v1.AbortCanaryResponse](
+			httpClient,
+			baseURL+ControllerServiceAbortCanaryProcedure,
+			opts...,
+		),
+		importEvents: connect.NewClient[v1.ImportEventsRequest, v1.ImportEventsResponse](
+			httpClient,
+			baseURL+ControllerServiceImportEventsProcedure,
+			opts...,
+		),
 		streamDeploymentLogs: connect.NewClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse](
 			httpClient,
 			baseURL+ControllerServiceStreamDeploymentLogsProcedure,
@@ -374,6 +596,61 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+ControllerServicePullSchemaProcedure,
 			opts...,
 		),
+		listFailedAsyncCalls: connect.NewClient[v1.ListFailedAsyncCallsRequest, v1.ListFailedAsyncCallsResponse](
+			httpClient,
+			baseURL+ControllerServiceListFailedAsyncCallsProcedure,
+			opts...,
+		),
+		getAsyncCall: connect.NewClient[v1.GetAsyncCallRequest, v1.GetAsyncCallResponse](
+			httpClient,
+			baseURL+ControllerServiceGetAsyncCallProcedure,
+			opts...,
+		),
+		requeueAsyncCall: connect.NewClient[v1.RequeueAsyncCallRequest, v1.RequeueAsyncCallResponse](
+			httpClient,
+			baseURL+ControllerServiceRequeueAsyncCallProcedure,
+			opts...,
+		),
+		discardAsyncCall: connect.NewClient[v1.DiscardAsyncCallRequest, v1.DiscardAsyncCallResponse](
+			httpClient,
+			baseURL+ControllerServiceDiscardAsyncCallProcedure,
+			opts...,
+		),
+		listCronJobs: connect.NewClient[v1.ListCronJobsRequest, v1.ListCronJobsResponse](
+			httpClient,
+			baseURL+ControllerServiceListCronJobsProcedure,
+			opts...,
+		),
+		pauseCronJob: connect.NewClient[v1.PauseCronJobRequest, v1.PauseCronJobResponse](
+			httpClient,
+			baseURL+ControllerServicePauseCronJobProcedure,
+			opts...,
+		),
+		resumeCronJob: connect.NewClient[v1.ResumeCronJobRequest, v1.ResumeCronJobResponse](
+			httpClient,
+			baseURL+ControllerServiceResumeCronJobProcedure,
+			opts...,
+		),
+		triggerCronJob: connect.NewClient[v1.TriggerCronJobRequest, v1.TriggerCronJobResponse](
+			httpClient,
+			baseURL+ControllerServiceTriggerCronJobProcedure,
+			opts...,
+		),
+		getCronJobHistory: connect.NewClient[v1.GetCronJobHistoryRequest, v1.GetCronJobHistoryResponse](
+			httpClient,
+			baseURL+ControllerServiceGetCronJobHistoryProcedure,
+			opts...,
+		),
+		listLeases: connect.NewClient[v1.ListLeasesRequest, v1.ListLeasesResponse](
+			httpClient,
+			baseURL+ControllerServiceListLeasesProcedure,
+			opts...,
+		),
+		releaseLease: connect.NewClient[v1.ReleaseLeaseRequest, v1.ReleaseLeaseResponse](
+			httpClient,
+			baseURL+ControllerServiceReleaseLeaseProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -390,9 +667,24 @@ type controllerServiceClient struct {
 	registerRunner         *connect.Client[v1.RegisterRunnerRequest, v1.RegisterRunnerResponse]
 	updateDeploy           *connect.Client[v1.UpdateDeployRequest, v1.UpdateDeployResponse]
 	replaceDeploy          *connect.Client[v1.ReplaceDeployRequest, v1.ReplaceDeployResponse]
+	listDeployments        *connect.Client[v1.ListDeploymentsRequest, v1.ListDeploymentsResponse]
+	promoteCanary          *connect.Client[v1.PromoteCanaryRequest, v1.PromoteCanaryResponse]
+	abortCanary            *connect.Client[v1.AbortCanaryRequest, v1.AbortCanaryResponse]
+	importEvents           *connect.Client[v1.ImportEventsRequest, v1.ImportEventsResponse]
 	streamDeploymentLogs   *connect.Client[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse]
 	getSchema              *connect.Client[v1.GetSchemaRequest, v1.GetSchemaResponse]
 	pullSchema             *connect.Client[v1.PullSchemaRequest, v1.PullSchemaResponse]
+	listFailedAsyncCalls   *connect.Client[v1.ListFailedAsyncCallsRequest, v1.ListFailedAsyncCallsResponse]
+	getAsyncCall           *connect.Client[v1.GetAsyncCallRequest, v1.GetAsyncCallResponse]
+	requeueAsyncCall       *connect.Client[v1.RequeueAsyncCallRequest, v1.RequeueAsyncCallResponse]
+	discardAsyncCall       *connect.Client[v1.DiscardAsyncCallRequest, v1.DiscardAsyncCallResponse]
+	listCronJobs           *connect.Client[v1.ListCronJobsRequest, v1.ListCronJobsResponse]
+	pauseCronJob           *connect.Client[v1.PauseCronJobRequest, v1.PauseCronJobResponse]
+	resumeCronJob          *connect.Client[v1.ResumeCronJobRequest, v1.ResumeCronJobResponse]
+	triggerCronJob         *connect.Client[v1.TriggerCronJobRequest, v1.TriggerCronJobResponse]
+	getCronJobHistory      *connect.Client[v1.GetCronJobHistoryRequest, v1.GetCronJobHistoryResponse]
+	listLeases             *connect.Client[v1.ListLeasesRequest, v1.ListLeasesResponse]
+	releaseLease           *connect.Client[v1.ReleaseLeaseRequest, v1.ReleaseLeaseResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.ControllerService.Ping.
@@ -450,6 +742,26 @@ func (c *controllerServiceClient) ReplaceDeploy(ctx context.Context, req *connec
 	return c.replaceDeploy.CallUnary(ctx, req)
 }
 
+// ListDeployments calls xyz.block.ftl.v1.ControllerService.ListDeployments.
+func (c *controllerServiceClient) ListDeployments(ctx context.Context, req *connect.Request[v1.ListDeploymentsRequest]) (*connect.Response[v1.ListDeploymentsResponse], error) {
+	return c.listDeployments.CallUnary(ctx, req)
+}
+
+// PromoteCanary calls xyz.block.ftl.v1.ControllerService.PromoteCanary.
+func (c *controllerServiceClient) PromoteCanary(ctx context.Context, req *connect.Request[v1.PromoteCanaryRequest]) (*connect.Response[v1.PromoteCanaryResponse], error) {
+	return c.promoteCanary.CallUnary(ctx, req)
+}
+
+// AbortCanary calls xyz.block.ftl.v1.ControllerService.AbortCanary.
+func (c *controllerServiceClient) AbortCanary(ctx context.Context, req *connect.Request[v1.AbortCanaryRequest]) (*connect.Response[v1.AbortCanaryResponse], error) {
+	return c.abortCanary.CallUnary(ctx, req)
+}
+
+// ImportEvents calls xyz.block.ftl.v1.ControllerService.ImportEvents.
+func (c *controllerServiceClient) ImportEvents(ctx context.Context, req *connect.Request[v1.ImportEventsRequest]) (*connect.Response[v1.ImportEventsResponse], error) {
+	return c.importEvents.CallUnary(ctx, req)
+}
+
 // StreamDeploymentLogs calls xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs.
 func (c *controllerServiceClient) StreamDeploymentLogs(ctx context.Context) *connect.ClientStreamForClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse] {
 	return c.streamDeploymentLogs.CallClientStream(ctx)
@@ -465,6 +777,61 @@ func (c *controllerServiceClient) PullSchema(ctx context.Context, req *connect.R
 	return c.pullSchema.CallServerStream(ctx, req)
 }
 
+// ListFailedAsyncCalls calls xyz.block.ftl.v1.ControllerService.ListFailedAsyncCalls.
+func (c *controllerServiceClient) ListFailedAsyncCalls(ctx context.Context, req *connect.Request[v1.ListFailedAsyncCallsRequest]) (*connect.Response[v1.ListFailedAsyncCallsResponse], error) {
+	return c.listFailedAsyncCalls.CallUnary(ctx, req)
+}
+
+// GetAsyncCall calls xyz.block.ftl.v1.ControllerService.GetAsyncCall.
+func (c *controllerServiceClient) GetAsyncCall(ctx context.Context, req *connect.Request[v1.GetAsyncCallRequest]) (*connect.Response[v1.GetAsyncCallResponse], error) {
+	return c.getAsyncCall.CallUnary(ctx, req)
+}
+
+// RequeueAsyncCall calls xyz.block.ftl.v1.ControllerService.RequeueAsyncCall.
+func (c *controllerServiceClient) RequeueAsyncCall(ctx context.Context, req *connect.Request[v1.RequeueAsyncCallRequest]) (*connect.Response[v1.RequeueAsyncCallResponse], error) {
+	return c.requeueAsyncCall.CallUnary(ctx, req)
+}
+
+// DiscardAsyncCall calls xyz.block.ftl.v1.ControllerService.DiscardAsyncCall.
+func (c *controllerServiceClient) DiscardAsyncCall(ctx context.Context, req *connect.Request[v1.DiscardAsyncCallRequest]) (*connect.Response[v1.DiscardAsyncCallResponse], error) {
+	return c.discardAsyncCall.CallUnary(ctx, req)
+}
+
+// ListCronJobs calls xyz.block.ftl.v1.ControllerService.ListCronJobs.
+func (c *controllerServiceClient) ListCronJobs(ctx context.Context, req *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error) {
+	return c.listCronJobs.CallUnary(ctx, req)
+}
+
+// PauseCronJob calls xyz.block.ftl.v1.ControllerService.PauseCronJob.
+func (c *controllerServiceClient) PauseCronJob(ctx context.Context, req *connect.Request[v1.PauseCronJobRequest]) (*connect.Response[v1.PauseCronJobResponse], error) {
+	return c.pauseCronJob.CallUnary(ctx, req)
+}
+
+// ResumeCronJob calls xyz.block.ftl.v1.ControllerService.ResumeCronJob.
+func (c *controllerServiceClient) ResumeCronJob(ctx context.Context, req *connect.Request[v1.ResumeCronJobRequest]) (*connect.Response[v1.ResumeCronJobResponse], error) {
+	return c.resumeCronJob.CallUnary(ctx, req)
+}
+
+// TriggerCronJob calls xyz.block.ftl.v1.ControllerService.TriggerCronJob.
+func (c *controllerServiceClient) TriggerCronJob(ctx context.Context, req *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error) {
+	return c.triggerCronJob.CallUnary(ctx, req)
+}
+
+// GetCronJobHistory calls xyz.block.ftl.v1.ControllerService.GetCronJobHistory.
+func (c *controllerServiceClient) GetCronJobHistory(ctx context.Context, req *connect.Request[v1.GetCronJobHistoryRequest]) (*connect.Response[v1.GetCronJobHistoryResponse], error) {
+	return c.getCronJobHistory.CallUnary(ctx, req)
+}
+
+// ListLeases calls xyz.block.ftl.v1.ControllerService.ListLeases.
+func (c *controllerServiceClient) ListLeases(ctx context.Context, req *connect.Request[v1.ListLeasesRequest]) (*connect.Response[v1.ListLeasesResponse], error) {
+	return c.listLeases.CallUnary(ctx, req)
+}
+
+// ReleaseLease calls xyz.block.ftl.v1.ControllerService.ReleaseLease.
+func (c *controllerServiceClient) ReleaseLease(ctx context.Context, req *connect.Request[v1.ReleaseLeaseRequest]) (*connect.Response[v1.ReleaseLeaseResponse], error) {
+	return c.releaseLease.CallUnary(ctx, req)
+}
+
 // ControllerServiceHandler is an implementation of the xyz.block.ftl.v1.ControllerService service.
 type ControllerServiceHandler interface {
 	// Ping service for readiness.
@@ -497,6 +864,17 @@ type ControllerServiceHandler interface {
 	// If a deployment already exists for the module of the new deployment,
 	// it will be scaled down and replaced by the new one.
 	ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error)
+	// List all deployments of a module, active or not.
+	ListDeployments(context.Context, *connect.Request[v1.ListDeploymentsRequest]) (*connect.Response[v1.ListDeploymentsResponse], error)
+	// Promote a canary deployment to replace the existing deployment of its
+	// module.
+	PromoteCanary(context.Context, *connect.Request[v1.PromoteCanaryRequest]) (*connect.Response[v1.PromoteCanaryResponse], error)
+	// Abort a canary deployment, routing all traffic back to the existing
+	// deployment of its module.
+	AbortCanary(context.Context, *connect.Request[v1.AbortCanaryRequest]) (*connect.Response[v1.AbortCanaryResponse], error)
+	// Import events from an archive of expired events, so that they can be
+	// queried again.
+	ImportEvents(context.Context, *connect.Request[v1.ImportEventsRequest]) (*connect.Response[v1.ImportEventsResponse], error)
 	// Stream logs from a deployment
 	StreamDeploymentLogs(context.Context, *connect.ClientStream[v1.StreamDeploymentLogsRequest]) (*connect.Response[v1.StreamDeploymentLogsResponse], error)
 	// Get the full schema.
@@ -506,6 +884,28 @@ type ControllerServiceHandler interface {
 	// Note that if there are no deployments this will block indefinitely, making it unsuitable for
 	// just retrieving the schema. Use GetSchema for that.
 	PullSchema(context.Context, *connect.Request[v1.PullSchemaRequest], *connect.ServerStream[v1.PullSchemaResponse]) error
+	// List async calls that have failed and exhausted their retries.
+	ListFailedAsyncCalls(context.Context, *connect.Request[v1.ListFailedAsyncCallsRequest]) (*connect.Response[v1.ListFailedAsyncCallsResponse], error)
+	// Get an async call.
+	GetAsyncCall(context.Context, *connect.Request[v1.GetAsyncCallRequest]) (*connect.Response[v1.GetAsyncCallResponse], error)
+	// Schedule a failed async call to be executed again.
+	RequeueAsyncCall(context.Context, *connect.Request[v1.RequeueAsyncCallRequest]) (*connect.Response[v1.RequeueAsyncCallResponse], error)
+	// Discard a failed async call.
+	DiscardAsyncCall(context.Context, *connect.Request[v1.DiscardAsyncCallRequest]) (*connect.Response[v1.DiscardAsyncCallResponse], error)
+	// List the cron jobs of active deployments.
+	ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error)
+	// Pause a cron job so it is not executed until resumed.
+	PauseCronJob(context.Context, *connect.Request[v1.PauseCronJobRequest]) (*connect.Response[v1.PauseCronJobResponse], error)
+	// Resume a paused cron job.
+	ResumeCronJob(context.Context, *connect.Request[v1.ResumeCronJobRequest]) (*connect.Response[v1.ResumeCronJobResponse], error)
+	// Execute a cron job immediately, waiting for it to finish.
+	TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error)
+	// Get the most recent executions of a cron job.
+	GetCronJobHistory(context.Context, *connect.Request[v1.GetCronJobHistoryRequest]) (*connect.Response[v1.GetCronJobHistoryResponse], error)
+	// List held leases.
+	ListLeases(context.Context, *connect.Request[v1.ListLeasesRequest]) (*connect.Response[v1.ListLeasesResponse], error)
+	// Forcibly release a lease, regardless of who holds it.
+	ReleaseLease(context.Context, *connect.Request[v1.ReleaseLeaseRequest]) (*connect.Response[v1.ReleaseLeaseResponse], error)
 }
 
 // NewControllerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -570,6 +970,26 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		svc.ReplaceDeploy,
 		opts...,
 	)
+	controllerServiceListDeploymentsHandler := connect.NewUnaryHandler(
+		ControllerServiceListDeploymentsProcedure,
+		svc.ListDeployments,
+		opts...,
+	)
+	controllerServicePromoteCanaryHandler := connect.NewUnaryHandler(
+		ControllerServicePromoteCanaryProcedure,
+		svc.PromoteCanary,
+		opts...,
+	)
+	controllerServiceAbortCanaryHandler := connect.NewUnaryHandler(
+		ControllerServiceAbortCanaryProcedure,
+		svc.AbortCanary,
+		opts...,
+	)
+	controllerServiceImportEventsHandler := connect.NewUnaryHandler(
+		ControllerServiceImportEventsProcedure,
+		svc.ImportEvents,
+		opts...,
+	)
 	controllerServiceStreamDeploymentLogsHandler := connect.NewClientStreamHandler(
 		ControllerServiceStreamDeploymentLogsProcedure,
 		svc.StreamDeploymentLogs,
@@ -585,6 +1005,61 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		svc.PullSchema,
 		opts...,
 	)
+	controllerServiceListFailedAsyncCallsHandler := connect.NewUnaryHandler(
+		ControllerServiceListFailedAsyncCallsProcedure,
+		svc.ListFailedAsyncCalls,
+		opts...,
+	)
+	controllerServiceGetAsyncCallHandler := connect.NewUnaryHandler(
+		ControllerServiceGetAsyncCallProcedure,
+		svc.GetAsyncCall,
+		opts...,
+	)
+	controllerServiceRequeueAsyncCallHandler := connect.NewUnaryHandler(
+		ControllerServiceRequeueAsyncCallProcedure,
+		svc.RequeueAsyncCall,
+		opts...,
+	)
+	controllerServiceDiscardAsyncCallHandler := connect.NewUnaryHandler(
+		ControllerServiceDiscardAsyncCallProcedure,
+		svc.DiscardAsyncCall,
+		opts...,
+	)
+	controllerServiceListCronJobsHandler := connect.NewUnaryHandler(
+		ControllerServiceListCronJobsProcedure,
+		svc.ListCronJobs,
+		opts...,
+	)
+	controllerServicePauseCronJobHandler := connect.NewUnaryHandler(
+		ControllerServicePauseCronJobProcedure,
+		svc.PauseCronJob,
+		opts...,
+	)
+	controllerServiceResumeCronJobHandler := connect.NewUnaryHandler(
+		ControllerServiceResumeCronJobProcedure,
+		svc.ResumeCronJob,
+		opts...,
+	)
+	controllerServiceTriggerCronJobHandler := connect.NewUnaryHandler(
+		ControllerServiceTriggerCronJobProcedure,
+		svc.TriggerCronJob,
+		opts...,
+	)
+	controllerServiceGetCronJobHistoryHandler := connect.NewUnaryHandler(
+		ControllerServiceGetCronJobHistoryProcedure,
+		svc.GetCronJobHistory,
+		opts...,
+	)
+	controllerServiceListLeasesHandler := connect.NewUnaryHandler(
+		ControllerServiceListLeasesProcedure,
+		svc.ListLeases,
+		opts...,
+	)
+	controllerServiceReleaseLeaseHandler := connect.NewUnaryHandler(
+		ControllerServiceReleaseLeaseProcedure,
+		svc.ReleaseLease,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.ControllerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ControllerServicePingProcedure:
@@ -609,12 +1084,42 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServiceUpdateDeployHandler.ServeHTTP(w, r)
 		case ControllerServiceReplaceDeployProcedure:
 			controllerServiceReplaceDeployHandler.ServeHTTP(w, r)
+		case ControllerServiceListDeploymentsProcedure:
+			controllerServiceListDeploymentsHandler.ServeHTTP(w, r)
+		case ControllerServicePromoteCanaryProcedure:
+			controllerServicePromoteCanaryHandler.ServeHTTP(w, r)
+		case ControllerServiceAbortCanaryProcedure:
+			controllerServiceAbortCanaryHandler.ServeHTTP(w, r)
+		case ControllerServiceImportEventsProcedure:
+			controllerServiceImportEventsHandler.ServeHTTP(w, r)
 		case ControllerServiceStreamDeploymentLogsProcedure:
 			controllerServiceStreamDeploymentLogsHandler.ServeHTTP(w, r)
 		case ControllerServiceGetSchemaProcedure:
 			controllerServiceGetSchemaHandler.ServeHTTP(w, r)
 		case ControllerServicePullSchemaProcedure:
 			controllerServicePullSchemaHandler.ServeHTTP(w, r)
+		case ControllerServiceListFailedAsyncCallsProcedure:
+			controllerServiceListFailedAsyncCallsHandler.ServeHTTP(w, r)
+		case ControllerServiceGetAsyncCallProcedure:
+			controllerServiceGetAsyncCallHandler.ServeHTTP(w, r)
+		case ControllerServiceRequeueAsyncCallProcedure:
+			controllerServiceRequeueAsyncCallHandler.ServeHTTP(w, r)
+		case ControllerServiceDiscardAsyncCallProcedure:
+			controllerServiceDiscardAsyncCallHandler.ServeHTTP(w, r)
+		case ControllerServiceListCronJobsProcedure:
+			controllerServiceListCronJobsHandler.ServeHTTP(w, r)
+		case ControllerServicePauseCronJobProcedure:
+			controllerServicePauseCronJobHandler.ServeHTTP(w, r)
+		case ControllerServiceResumeCronJobProcedure:
+			controllerServiceResumeCronJobHandler.ServeHTTP(w, r)
+		case ControllerServiceTriggerCronJobProcedure:
+			controllerServiceTriggerCronJobHandler.ServeHTTP(w, r)
+		case ControllerServiceGetCronJobHistoryProcedure:
+			controllerServiceGetCronJobHistoryHandler.ServeHTTP(w, r)
+		case ControllerServiceListLeasesProcedure:
+			controllerServiceListLeasesHandler.ServeHTTP(w, r)
+		case ControllerServiceReleaseLeaseProcedure:
+			controllerServiceReleaseLeaseHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -668,6 +1173,22 @@ func (UnimplementedControllerServiceHandler) ReplaceDeploy(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ReplaceDeploy is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) ListDeployments(context.Context, *connect.Request[v1.ListDeploymentsRequest]) (*connect.Response[v1.ListDeploymentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListDeployments is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) PromoteCanary(context.Context, *connect.Request[v1.PromoteCanaryRequest]) (*connect.Response[v1.PromoteCanaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PromoteCanary is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) AbortCanary(context.Context, *connect.Request[v1.AbortCanaryRequest]) (*connect.Response[v1.AbortCanaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.AbortCanary is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ImportEvents(context.Context, *connect.Request[v1.ImportEventsRequest]) (*connect.Response[v1.ImportEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ImportEvents is not implemented"))
+}
+
 func (UnimplementedControllerServiceHandler) StreamDeploymentLogs(context.Context, *connect.ClientStream[v1.StreamDeploymentLogsRequest]) (*connect.Response[v1.StreamDeploymentLogsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs is not implemented"))
 }
@@ -680,6 +1201,50 @@ func (UnimplementedControllerServiceHandler) PullSchema(context.Context, *connec
 	return connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PullSchema is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) ListFailedAsyncCalls(context.Context, *connect.Request[v1.ListFailedAsyncCallsRequest]) (*connect.Response[v1.ListFailedAsyncCallsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListFailedAsyncCalls is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetAsyncCall(context.Context, *connect.Request[v1.GetAsyncCallRequest]) (*connect.Response[v1.GetAsyncCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.GetAsyncCall is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) RequeueAsyncCall(context.Context, *connect.Request[v1.RequeueAsyncCallRequest]) (*connect.Response[v1.RequeueAsyncCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.RequeueAsyncCall is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) DiscardAsyncCall(context.Context, *connect.Request[v1.DiscardAsyncCallRequest]) (*connect.Response[v1.DiscardAsyncCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.DiscardAsyncCall is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListCronJobs is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) PauseCronJob(context.Context, *connect.Request[v1.PauseCronJobRequest]) (*connect.Response[v1.PauseCronJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PauseCronJob is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ResumeCronJob(context.Context, *connect.Request[v1.ResumeCronJobRequest]) (*connect.Response[v1.ResumeCronJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ResumeCronJob is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.TriggerCronJob is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetCronJobHistory(context.Context, *connect.Request[v1.GetCronJobHistoryRequest]) (*connect.Response[v1.GetCronJobHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.GetCronJobHistory is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListLeases(context.Context, *connect.Request[v1.ListLeasesRequest]) (*connect.Response[v1.ListLeasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListLeases is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ReleaseLease(context.Context, *connect.Request[v1.ReleaseLeaseRequest]) (*connect.Response[v1.ReleaseLeaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ReleaseLease is not implemented"))
+}
+
 // RunnerServiceClient is a client for the xyz.block.ftl.v1.RunnerService service.
 type RunnerServiceClient interface {
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
@@ -200,6 +200,24 @@ func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	return connect.NewResponse(response.Msg), err
 }
 
+func (s *Service) ServerStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	deployment, ok := s.deployment.Load().Get()
+	if !ok {
+		return connect.NewError(connect.CodeUnavailable, errors.New("no deployment"))
+	}
+	responses, err := deployment.plugin.Client.ServerStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer responses.Close()
+	for responses.Receive() {
+		if err := stream.Send(responses.Msg()); err != nil {
+			return err
+		}
+	}
+	return responses.Err()
+}
+
 func (s *Service) Reserve(ctx context.Context, c *connect.Request[ftlv1.ReserveRequest]) (*connect.Response[ftlv1.ReserveResponse], error) {
 	if !s.state.CompareAndSwap(ftlv1.RunnerState_RUNNER_IDLE, ftlv1.RunnerState_RUNNER_RESERVED) {
 		return nil, fmt.Errorf("can only reserve from IDLE state, not %s", s.state.Load())
@@ -219,6 +237,18 @@ func (s *Service) AcquireLease(context.Context, *connect.BidiStream[ftlv1.Acquir
 	return connect.NewError(connect.CodeUnimplemented, errors.New("leases must be acquired from the controller"))
 }
 
+func (s *Service) PublishEvent(context.Context, *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events must be published to the controller"))
+}
+
+func (s *Service) SendFSMEvent(context.Context, *connect.Request[ftlv1.SendFSMEventRequest]) (*connect.Response[ftlv1.SendFSMEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("FSM events must be sent to the controller"))
+}
+
+func (s *Service) SendToConnection(context.Context, *connect.Request[ftlv1.SendToConnectionRequest]) (*connect.Response[ftlv1.SendToConnectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connection messages must be sent to the controller"))
+}
+
 func (s *Service) Deploy(ctx context.Context, req *connect.Request[ftlv1.DeployRequest]) (response *connect.Response[ftlv1.DeployResponse], err error) {
 	if err, ok := s.registrationFailure.Load().Get(); ok {
 		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to register runner: %w", err))
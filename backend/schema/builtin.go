@@ -14,6 +14,18 @@ builtin module builtin {
     query {String: [String]}
     headers {String: [String]}
     body Body
+    // Present if the verb requires authentication with "+auth".
+    auth builtin.HttpAuth?
+  }
+
+  // Authenticated caller of an HTTP ingress verb.
+  export data HttpAuth {
+    // Authentication method, either "jwt" or "apikey".
+    method String
+    // Subject of the JWT, or the name of the API key.
+    subject String
+    // Verified JWT claims, empty for API keys.
+    claims {String: Any}
   }
 
   // HTTP response structure used for HTTP ingress verbs.
@@ -25,6 +37,43 @@ builtin module builtin {
     error Error?
   }
 
+  // A file uploaded in a "multipart/form-data" HTTP request body.
+  export data File {
+    filename String
+    contentType String
+    content Bytes
+  }
+
+  // RFC 7807 problem details returned by HTTP ingress for errors that occur
+  // outside of the verb, with the content type "application/problem+json".
+  export data HttpProblem {
+    // Stable URI identifying the kind of problem, eg. "urn:ftl:problem:invalid-request".
+    type String
+    title String
+    status Int
+    detail String?
+    // Path of the HTTP request.
+    instance String?
+    requestKey String?
+    // Fields of the request that failed validation.
+    invalidFields [builtin.HttpProblemField]?
+  }
+
+  // A field of an HTTP request that failed validation.
+  export data HttpProblemField {
+    // Path of the field in the request, eg. "body.items[0].name".
+    path String
+    detail String
+  }
+
+  // A message received on a WebSocket ingress connection.
+  export data WebSocketMessage<Body> {
+    // ID of the connection, for sending messages back with SendToConnection.
+    connectionId String
+    pathParameters {String: String}
+    body Body
+  }
+
   export data Empty {}
 }
 `
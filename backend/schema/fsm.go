@@ -37,6 +37,17 @@ func (f *FSM) Position() Position { return f.Pos }
 func (f *FSM) schemaDecl()        {}
 func (f *FSM) schemaSymbol()      {}
 
+// NextStates returns the states that can be transitioned to from [state].
+func (f *FSM) NextStates(state RefKey) []*Ref {
+	var out []*Ref
+	for _, t := range f.Transitions {
+		if t.From.ToRefKey() == state {
+			out = append(out, t.To)
+		}
+	}
+	return out
+}
+
 func (f *FSM) String() string {
 	w := &strings.Builder{}
 	fmt.Fprintf(w, "fsm %s {\n", f.Name)
@@ -20,40 +20,79 @@ func DataToJSONSchema(sch *Schema, ref Ref) (*jsonschema.Schema, error) {
 	}
 
 	// Encode root, and collect all data types reachable from the root.
-	refs := map[RefKey]*Ref{}
-	root := nodeToJSSchema(data, refs)
-	if len(refs) == 0 {
+	enc := &jsonSchemaEncoder{
+		refs:             map[RefKey]*Ref{},
+		definitionPrefix: "#/definitions/",
+		definitionName:   jsDefinitionName,
+	}
+	root := enc.encode(data)
+	if len(enc.refs) == 0 {
 		return root, nil
 	}
 
 	// Resolve and encode all types reachable from the root.
-	root.Definitions = map[string]jsonschema.SchemaOrBool{}
-	for _, r := range refs {
-		decl := sch.ResolveRef(r)
-		switch n := decl.(type) {
-		case *Data:
-			if len(r.TypeParameters) > 0 {
+	root.Definitions, err = enc.definitions(sch)
+	if err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// jsonSchemaEncoder encodes schema nodes as JSON Schema, collecting the
+// references it encounters so that they can be defined.
+type jsonSchemaEncoder struct {
+	refs map[RefKey]*Ref
+	// definitionPrefix is prepended to definition names to form a reference.
+	definitionPrefix string
+	// definitionName returns the name of the definition for a reference.
+	definitionName func(ref *Ref) string
+	// jsonAliases uses the JSON alias of fields as property names, if present.
+	jsonAliases bool
+}
+
+// definitions encodes all types referenced by previously encoded nodes,
+// including those referenced transitively.
+func (e *jsonSchemaEncoder) definitions(sch *Schema) (map[string]jsonschema.SchemaOrBool, error) {
+	definitions := map[string]jsonschema.SchemaOrBool{}
+	for {
+		var pending []*Ref
+		for _, r := range e.refs {
+			if _, ok := definitions[e.definitionName(r)]; !ok {
+				pending = append(pending, r)
+			}
+		}
+		if len(pending) == 0 {
+			return definitions, nil
+		}
+		for _, r := range pending {
+			decl := sch.ResolveRef(r)
+			switch n := decl.(type) {
+			case *Data:
 				monomorphisedData, err := n.Monomorphise(r)
 				if err != nil {
 					return nil, err
 				}
+				definitions[e.definitionName(r)] = jsonschema.SchemaOrBool{TypeObject: e.encode(monomorphisedData)}
 
-				ref := fmt.Sprintf("%s.%s", r.Module, refName(r))
-				root.Definitions[ref] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(monomorphisedData, refs)}
-			} else {
-				root.Definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n, refs)}
-			}
-		case *Enum:
-			root.Definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n, refs)}
+			case *Enum:
+				definitions[e.definitionName(r)] = jsonschema.SchemaOrBool{TypeObject: e.encode(n)}
+
+			case *TypeAlias:
+				alias := e.encode(n.Type)
+				alias.Description = jsComments(n.Comments)
+				definitions[e.definitionName(r)] = jsonschema.SchemaOrBool{TypeObject: alias}
 
-		case *Config, *Database, *Secret, *Verb, *FSM, *TypeAlias:
-			return nil, fmt.Errorf("reference to unsupported node type %T", decl)
+			case *Config, *Database, *Secret, *Verb, *FSM, *Topic, *Subscription:
+				return nil, fmt.Errorf("reference to unsupported node type %T", decl)
+
+			case nil:
+				return nil, fmt.Errorf("unknown reference %s", r)
+			}
 		}
 	}
-	return root, nil
 }
 
-func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
+func (e *jsonSchemaEncoder) encode(node Node) *jsonschema.Schema {
 	switch node := node.(type) {
 	case *Any:
 		return &jsonschema.Schema{}
@@ -71,12 +110,16 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 			AdditionalProperties: jsBool(false),
 		}
 		for _, field := range node.Fields {
-			jsField := nodeToJSSchema(field.Type, refs)
+			name := field.Name
+			if alias, ok := field.Alias(AliasKindJSON).Get(); ok && e.jsonAliases {
+				name = alias
+			}
+			jsField := e.encode(field.Type)
 			jsField.Description = jsComments(field.Comments)
 			if _, ok := field.Type.(*Optional); !ok {
-				schema.Required = append(schema.Required, field.Name)
+				schema.Required = append(schema.Required, name)
 			}
-			schema.Properties[field.Name] = jsonschema.SchemaOrBool{TypeObject: jsField}
+			schema.Properties[name] = jsonschema.SchemaOrBool{TypeObject: jsField}
 		}
 		return schema
 
@@ -103,7 +146,7 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 				AdditionalProperties: jsBool(false),
 			}
 			variantSch.Properties["name"] = jsonschema.SchemaOrBool{TypeObject: &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &str}}}
-			variantSch.Properties["value"] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(v.Value.(*TypeValue).schemaValueType(), refs)} //nolint:forcetypeassert
+			variantSch.Properties["value"] = jsonschema.SchemaOrBool{TypeObject: e.encode(v.Value.(*TypeValue).schemaValueType())} //nolint:forcetypeassert
 			variants = append(variants, jsonschema.SchemaOrBool{TypeObject: variantSch})
 		}
 		return schema.WithOneOf(variants...)
@@ -145,7 +188,7 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 			Type: &jsonschema.Type{SimpleTypes: &st},
 			Items: &jsonschema.Items{
 				SchemaOrBool: &jsonschema.SchemaOrBool{
-					TypeObject: nodeToJSSchema(node.Element, refs),
+					TypeObject: e.encode(node.Element),
 				},
 			},
 		}
@@ -155,25 +198,19 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 		// JSON schema generic map of key type to value type
 		return &jsonschema.Schema{
 			Type:                 &jsonschema.Type{SimpleTypes: &st},
-			PropertyNames:        &jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(node.Key, refs)},
-			AdditionalProperties: &jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(node.Value, refs)},
+			PropertyNames:        &jsonschema.SchemaOrBool{TypeObject: e.encode(node.Key)},
+			AdditionalProperties: &jsonschema.SchemaOrBool{TypeObject: e.encode(node.Value)},
 		}
 
 	case *Ref:
-		var ref string
-		if len(node.TypeParameters) > 0 {
-			name := refName(node)
-			ref = fmt.Sprintf("#/definitions/%s.%s", node.Module, name)
-		} else {
-			ref = fmt.Sprintf("#/definitions/%s", node.String())
-		}
-		refs[node.ToRefKey()] = node
+		ref := e.definitionPrefix + e.definitionName(node)
+		e.refs[node.ToRefKey()] = node
 		return &jsonschema.Schema{Ref: &ref}
 
 	case *Optional:
 		null := jsonschema.Null
 		return &jsonschema.Schema{AnyOf: []jsonschema.SchemaOrBool{
-			{TypeObject: nodeToJSSchema(node.Type, refs)},
+			{TypeObject: e.encode(node.Type)},
 			{TypeObject: &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &null}}},
 		}}
 
@@ -184,7 +221,8 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 		*MetadataAlias, IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *Module,
 		*Schema, Type, *Database, *Verb, *EnumVariant, *MetadataCronJob, Value,
 		*StringValue, *IntValue, *TypeValue, *Config, *Secret, Symbol, Named,
-		*FSM, *FSMTransition, *TypeAlias, *MetadataRetry:
+		*FSM, *FSMTransition, *TypeAlias, *MetadataRetry, *Topic, *Subscription,
+		*MetadataSubscriber, *MetadataAuth, *MetadataStream, *MetadataCORS, *MetadataCache, *MetadataRateLimit:
 		panic(fmt.Sprintf("unsupported node type %T", node))
 
 	default:
@@ -204,6 +242,15 @@ func jsComments(comments []string) *string {
 	return &out
 }
 
+// jsDefinitionName returns the name of the JSON Schema definition for a
+// reference, including its type arguments.
+func jsDefinitionName(ref *Ref) string {
+	if len(ref.TypeParameters) == 0 {
+		return ref.String()
+	}
+	return fmt.Sprintf("%s.%s", ref.Module, refName(ref))
+}
+
 func refName(ref *Ref) string {
 	var suffix []string
 	for _, t := range ref.TypeParameters {
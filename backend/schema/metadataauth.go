@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+const (
+	// AuthMethodJWT authenticates requests with a JWT bearer token, verified
+	// against the JWK set in the module's "jwks" config and issued by its
+	// "jwtIssuer" for its "jwtAudience". Tokens must have an expiry.
+	AuthMethodJWT = "jwt"
+	// AuthMethodAPIKey authenticates requests with an API key from the
+	// module's "apiKeys" secret.
+	AuthMethodAPIKey = "apikey"
+)
+
+// MetadataAuth requires requests to an HTTP ingress verb to be authenticated
+// before the verb is called.
+type MetadataAuth struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Method string `parser:"'+' 'auth' @('jwt' | 'apikey')" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataAuth)(nil)
+
+func (m *MetadataAuth) Position() Position { return m.Pos }
+func (m *MetadataAuth) String() string {
+	return fmt.Sprintf("+auth %s", m.Method)
+}
+
+func (m *MetadataAuth) schemaChildren() []Node { return nil }
+func (*MetadataAuth) schemaMetadata()          {}
+
+func (m *MetadataAuth) ToProto() proto.Message {
+	return &schemapb.MetadataAuth{
+		Pos:    posToProto(m.Pos),
+		Method: m.Method,
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MinCacheTTL is the shortest TTL allowed for "+cache".
+const MinCacheTTL = time.Second
+
+// MetadataCache caches the responses of a GET HTTP ingress verb for a TTL.
+//
+// eg. +cache 5m
+type MetadataCache struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	TTL string `parser:"'+' 'cache' @(Number (?! Whitespace) Ident)" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataCache)(nil)
+
+func (m *MetadataCache) Position() Position { return m.Pos }
+func (m *MetadataCache) String() string {
+	return fmt.Sprintf("+cache %s", m.TTL)
+}
+
+func (m *MetadataCache) schemaChildren() []Node { return nil }
+func (*MetadataCache) schemaMetadata()          {}
+
+func (m *MetadataCache) ToProto() proto.Message {
+	return &schemapb.MetadataCache{
+		Pos: posToProto(m.Pos),
+		Ttl: m.TTL,
+	}
+}
+
+// TTLDuration returns how long responses are cached for.
+func (m *MetadataCache) TTLDuration() (time.Duration, error) {
+	ttl, err := time.ParseDuration(m.TTL)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse cache TTL %q - expected duration in format like '30s' or '1h30m'", m.TTL)
+	}
+	if ttl < MinCacheTTL {
+		return 0, fmt.Errorf("cache TTL must be at least %s", MinCacheTTL)
+	}
+	return ttl, nil
+}
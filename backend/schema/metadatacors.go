@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataCORS is the CORS policy of an HTTP ingress verb.
+//
+// eg. +cors "https://example.com" methods GET, POST headers "Authorization" credentials maxAge 600
+//
+// Methods default to the method of the ingress route, and headers to the
+// CORS-safelisted request headers plus Content-Type. "*" allows any origin or
+// header, but can not be combined with credentials.
+type MetadataCORS struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	AllowOrigins     []string `parser:"'+' 'cors' @String (',' @String)*" protobuf:"2"`
+	AllowMethods     []string `parser:"('methods' @('GET' | 'POST' | 'PUT' | 'DELETE') (',' @('GET' | 'POST' | 'PUT' | 'DELETE'))*)?" protobuf:"3"`
+	AllowHeaders     []string `parser:"('headers' @String (',' @String)*)?" protobuf:"4"`
+	AllowCredentials bool     `parser:"@'credentials'?" protobuf:"5"`
+	MaxAge           int      `parser:"('maxAge' @Number)?" protobuf:"6"`
+}
+
+var _ Metadata = (*MetadataCORS)(nil)
+
+func (m *MetadataCORS) Position() Position { return m.Pos }
+func (m *MetadataCORS) String() string {
+	quote := func(values []string) string {
+		out := make([]string, len(values))
+		for i, v := range values {
+			out[i] = strconv.Quote(v)
+		}
+		return strings.Join(out, ", ")
+	}
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "+cors %s", quote(m.AllowOrigins))
+	if len(m.AllowMethods) > 0 {
+		fmt.Fprintf(w, " methods %s", strings.Join(m.AllowMethods, ", "))
+	}
+	if len(m.AllowHeaders) > 0 {
+		fmt.Fprintf(w, " headers %s", quote(m.AllowHeaders))
+	}
+	if m.AllowCredentials {
+		w.WriteString(" credentials")
+	}
+	if m.MaxAge > 0 {
+		fmt.Fprintf(w, " maxAge %d", m.MaxAge)
+	}
+	return w.String()
+}
+
+func (m *MetadataCORS) schemaChildren() []Node { return nil }
+func (*MetadataCORS) schemaMetadata()          {}
+
+func (m *MetadataCORS) ToProto() proto.Message {
+	return &schemapb.MetadataCORS{
+		Pos:              posToProto(m.Pos),
+		AllowOrigins:     m.AllowOrigins,
+		AllowMethods:     m.AllowMethods,
+		AllowHeaders:     m.AllowHeaders,
+		AllowCredentials: m.AllowCredentials,
+		MaxAge:           int64(m.MaxAge),
+	}
+}
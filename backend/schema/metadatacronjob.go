@@ -2,23 +2,63 @@ package schema
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 )
 
+// DefaultMaxCronCatchup is the number of missed executions "catchup=all" runs
+// when no limit is given.
+const DefaultMaxCronCatchup = 10
+
+// MetadataCronJob schedules a verb to be called periodically.
+//
+// eg. +cron TZ=Australia/Sydney 0 9 * * 1-5 concurrency=forbid jitter=30s catchup=all:5
+//
+// The schedule is a cron pattern optionally prefixed by an IANA time zone, a
+// descriptor such as "@hourly", or a fixed interval such as "@every 15m".
+//
+// The schedule may be followed by, in order:
+//
+//   - concurrency: what to do when an execution is due while the previous one
+//     is still running. "forbid" (the default) skips it, "allow" runs both and
+//     "replace" cancels the previous execution.
+//   - jitter: a random delay of up to this duration added to each execution.
+//   - catchup: which executions missed while no controller was running to run.
+//     "none" skips them, "last" (the default) runs the most recent one and
+//     "all" runs each in turn, up to an optional limit.
 type MetadataCronJob struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
-	Cron string `parser:"'+' 'cron' Whitespace @((' ' | Number | '-' | '/' | '*' | ',')+)" protobuf:"2"`
+	Cron        string `parser:"'+' 'cron' Whitespace @(('TZ' '=' Ident ((?! Whitespace) (Ident | Number | '/' | '-' | '+'))* ' ')? ('@' Ident (' ' Number ((?! Whitespace) (Number | Ident))*)? | (Number | '-' | '/' | '*' | ',') ((?! Whitespace) (Number | '-' | '/' | '*' | ','))* (' ' (Number | '-' | '/' | '*' | ',') ((?! Whitespace) (Number | '-' | '/' | '*' | ','))*)*))" protobuf:"2"`
+	Concurrency string `parser:"('concurrency' '=' @('forbid' | 'allow' | 'replace'))?" protobuf:"3"`
+	Jitter      string `parser:"('jitter' '=' @(Number (?! Whitespace) Ident))?" protobuf:"4"`
+	Catchup     string `parser:"('catchup' '=' @('none' | 'last' | 'all'))?" protobuf:"5"`
+	MaxCatchup  int    `parser:"(':' @Number)?" protobuf:"6"`
 }
 
 var _ Metadata = (*MetadataCronJob)(nil)
 
 func (m *MetadataCronJob) Position() Position { return m.Pos }
 func (m *MetadataCronJob) String() string {
-	return fmt.Sprintf("+cron %s", m.Cron)
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "+cron %s", m.Cron)
+	if m.Concurrency != "" {
+		fmt.Fprintf(out, " concurrency=%s", m.Concurrency)
+	}
+	if m.Jitter != "" {
+		fmt.Fprintf(out, " jitter=%s", m.Jitter)
+	}
+	if m.Catchup != "" {
+		fmt.Fprintf(out, " catchup=%s", m.Catchup)
+	}
+	if m.MaxCatchup != 0 {
+		fmt.Fprintf(out, ":%d", m.MaxCatchup)
+	}
+	return out.String()
 }
 
 func (m *MetadataCronJob) schemaChildren() []Node {
@@ -29,7 +69,41 @@ func (*MetadataCronJob) schemaMetadata() {}
 
 func (m *MetadataCronJob) ToProto() proto.Message {
 	return &schemapb.MetadataCronJob{
-		Pos:  posToProto(m.Pos),
-		Cron: m.Cron,
+		Pos:         posToProto(m.Pos),
+		Cron:        m.Cron,
+		Concurrency: m.Concurrency,
+		Jitter:      m.Jitter,
+		Catchup:     m.Catchup,
+		MaxCatchup:  int64(m.MaxCatchup),
+	}
+}
+
+// JitterDuration returns the maximum random delay added to each execution.
+func (m *MetadataCronJob) JitterDuration() (time.Duration, error) {
+	if m.Jitter == "" {
+		return 0, nil
+	}
+	jitter, err := time.ParseDuration(m.Jitter)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse cron jitter %q - expected duration in format like '30s' or '5m'", m.Jitter)
+	}
+	if jitter < 0 {
+		return 0, fmt.Errorf("cron jitter %s must not be negative", jitter)
+	}
+	return jitter, nil
+}
+
+// CatchupLimit returns the maximum number of missed executions to run.
+func (m *MetadataCronJob) CatchupLimit() int {
+	switch m.Catchup {
+	case "none":
+		return 0
+	case "all":
+		if m.MaxCatchup > 0 {
+			return m.MaxCatchup
+		}
+		return DefaultMaxCronCatchup
+	default:
+		return 1
 	}
 }
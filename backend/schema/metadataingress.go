@@ -9,12 +9,23 @@ import (
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 )
 
+// MetadataIngress exposes a verb at an HTTP path.
+//
+// "http" ingress verbs are called for each request with the given method.
+// "ws" ingress verbs accept WebSocket connections, and are called for each
+// message received on a connection. They do not have a method.
+//
+// The last path component may be a wildcard parameter such as "{path...}",
+// which matches the rest of the path. Routes with a host only match requests
+// to that host, eg. +ingress http GET /users host "api.example.com". Hosts
+// may start with "*." to match any subdomain.
 type MetadataIngress struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
-	Type   string                 `parser:"'+' 'ingress' @('http')?" protobuf:"2"`
-	Method string                 `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')" protobuf:"3"`
+	Type   string                 `parser:"'+' 'ingress' @('http' | 'ws')?" protobuf:"2"`
+	Method string                 `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')?" protobuf:"3"`
 	Path   []IngressPathComponent `parser:"('/' @@)+" protobuf:"4"`
+	Host   string                 `parser:"('host' @String)?" protobuf:"5"`
 }
 
 var _ Metadata = (*MetadataIngress)(nil)
@@ -27,10 +38,28 @@ func (m *MetadataIngress) String() string {
 		case *IngressPathLiteral:
 			path[i] = v.Text
 		case *IngressPathParameter:
-			path[i] = fmt.Sprintf("{%s}", v.Name)
+			path[i] = v.Pattern()
 		}
 	}
-	return fmt.Sprintf("+ingress %s %s /%s", m.Type, strings.ToUpper(m.Method), strings.Join(path, "/"))
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "+ingress %s", m.Type)
+	if m.Method != "" {
+		fmt.Fprintf(w, " %s", strings.ToUpper(m.Method))
+	}
+	fmt.Fprintf(w, " /%s", strings.Join(path, "/"))
+	if m.Host != "" {
+		fmt.Fprintf(w, " host %q", m.Host)
+	}
+	return w.String()
+}
+
+// RouteMethod returns the HTTP method of the ingress route, which is GET for
+// WebSocket connections.
+func (m *MetadataIngress) RouteMethod() string {
+	if m.Type == "ws" {
+		return "GET"
+	}
+	return m.Method
 }
 
 func (m *MetadataIngress) schemaChildren() []Node {
@@ -49,6 +78,7 @@ func (m *MetadataIngress) ToProto() proto.Message {
 		Type:   m.Type,
 		Method: m.Method,
 		Path:   ingressListToProto(m.Path),
+		Host:   m.Host,
 	}
 }
 func ingressPathComponentListToSchema(s []*schemapb.IngressPathComponent) []IngressPathComponent {
@@ -62,8 +92,9 @@ func ingressPathComponentListToSchema(s []*schemapb.IngressPathComponent) []Ingr
 			})
 		case *schemapb.IngressPathComponent_IngressPathParameter:
 			out = append(out, &IngressPathParameter{
-				Pos:  posFromProto(n.IngressPathParameter.Pos),
-				Name: n.IngressPathParameter.Name,
+				Pos:      posFromProto(n.IngressPathParameter.Pos),
+				Name:     n.IngressPathParameter.Name,
+				Wildcard: n.IngressPathParameter.Wildcard,
 			})
 		}
 	}
@@ -92,10 +123,13 @@ func (l *IngressPathLiteral) ToProto() proto.Message {
 	return &schemapb.IngressPathLiteral{Text: l.Text}
 }
 
+// IngressPathParameter is a path parameter such as "{id}", or a wildcard
+// parameter such as "{path...}" matching the rest of the path.
 type IngressPathParameter struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
-	Name string `parser:"'{' @Ident '}'" protobuf:"2"`
+	Name     string `parser:"'{' @Ident" protobuf:"2"`
+	Wildcard bool   `parser:"@('.' '.' '.')? '}'" protobuf:"3"`
 }
 
 var _ IngressPathComponent = (*IngressPathParameter)(nil)
@@ -104,6 +138,43 @@ func (l *IngressPathParameter) Position() Position        { return l.Pos }
 func (l *IngressPathParameter) String() string            { return l.Name }
 func (*IngressPathParameter) schemaChildren() []Node      { return nil }
 func (*IngressPathParameter) schemaIngressPathComponent() {}
+
+// Pattern returns the parameter as it appears in an ingress path.
+func (l *IngressPathParameter) Pattern() string {
+	if l.Wildcard {
+		return "{" + l.Name + "...}"
+	}
+	return "{" + l.Name + "}"
+}
+
 func (l *IngressPathParameter) ToProto() proto.Message {
-	return &schemapb.IngressPathParameter{Name: l.Name}
+	return &schemapb.IngressPathParameter{Name: l.Name, Wildcard: l.Wildcard}
+}
+
+// MatchIngressHost returns true if a request to [host] matches the host of an
+// ingress route, which matches any host if empty.
+//
+// [host] must be lower case and without a port.
+func MatchIngressHost(pattern, host string) bool {
+	if pattern == "" {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+		return len(host) > len(suffix) && strings.HasSuffix(host, suffix)
+	}
+	return pattern == host
+}
+
+// IngressHostPriority returns the priority of the host of an ingress route
+// when several routes match a request. Routes with a host take precedence
+// over those without, and exact hosts over wildcard hosts.
+func IngressHostPriority(pattern string) int {
+	switch {
+	case pattern == "":
+		return 0
+	case strings.HasPrefix(pattern, "*."):
+		return 1
+	default:
+		return 2
+	}
 }
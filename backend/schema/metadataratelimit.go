@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MinRateLimitPeriod is the shortest period allowed for "+ratelimit".
+const MinRateLimitPeriod = time.Second
+
+// MetadataRateLimit limits how often a verb can be called per period, across
+// all controllers.
+//
+// eg. +ratelimit 100/1m by=header:X-Api-Key
+//
+// Calls are limited per value of a request header, per client IP address, or
+// per calling module. Without "by" the limit applies to all calls of the verb.
+type MetadataRateLimit struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Limit  int    `parser:"'+' 'ratelimit' @Number '/'" protobuf:"2"`
+	Period string `parser:"@(Number (?! Whitespace) Ident | Ident)" protobuf:"3"`
+	By     string `parser:"('by' '=' @('ip' | 'caller' '-' 'module' | 'header' ':' Ident ('-' Ident)*))?" protobuf:"4"`
+}
+
+var _ Metadata = (*MetadataRateLimit)(nil)
+
+func (m *MetadataRateLimit) Position() Position { return m.Pos }
+func (m *MetadataRateLimit) String() string {
+	out := fmt.Sprintf("+ratelimit %d/%s", m.Limit, m.Period)
+	if m.By != "" {
+		out += " by=" + m.By
+	}
+	return out
+}
+
+func (m *MetadataRateLimit) schemaChildren() []Node { return nil }
+func (*MetadataRateLimit) schemaMetadata()          {}
+
+func (m *MetadataRateLimit) ToProto() proto.Message {
+	return &schemapb.MetadataRateLimit{
+		Pos:    posToProto(m.Pos),
+		Limit:  int64(m.Limit),
+		Period: m.Period,
+		By:     m.By,
+	}
+}
+
+// PeriodDuration returns the period the limit applies to.
+//
+// The period is a duration such as "10s", or a unit of time such as "m".
+func (m *MetadataRateLimit) PeriodDuration() (time.Duration, error) {
+	period := m.Period
+	if period != "" && !unicode.IsDigit(rune(period[0])) {
+		period = "1" + period
+	}
+	duration, err := time.ParseDuration(period)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse rate limit period %q - expected duration in format like 's', '10s' or '1h'", m.Period)
+	}
+	if duration < MinRateLimitPeriod {
+		return 0, fmt.Errorf("rate limit period must be at least %s", MinRateLimitPeriod)
+	}
+	return duration, nil
+}
+
+// Header returns the request header calls are limited by, if any.
+func (m *MetadataRateLimit) Header() (string, bool) {
+	return strings.CutPrefix(m.By, "header:")
+}
@@ -18,6 +18,9 @@ const (
 	MinBackoffLimit    = 1 * time.Second
 	MaxBackoffLimitStr = "1d"
 	MaxBackoffLimit    = 24 * time.Hour
+
+	// DefaultRetryCount is the number of retries for a "+retry" without a count.
+	DefaultRetryCount = 100
 )
 
 type MetadataRetry struct {
@@ -80,6 +83,52 @@ func (m *MetadataRetry) MaxBackoffDuration() (optional.Option[time.Duration], er
 	return optional.Some(duration), nil
 }
 
+// RetryParams returns the resolved retry parameters, applying defaults for
+// unspecified values.
+//
+// A "+retry" without a count is retried up to [DefaultRetryCount] times.
+func (m *MetadataRetry) RetryParams() (RetryParams, error) {
+	params := RetryParams{Count: DefaultRetryCount, MaxBackoff: MaxBackoffLimit}
+	if m.Count != nil {
+		params.Count = *m.Count
+	}
+	minBackoff, err := m.MinBackoffDuration()
+	if err != nil {
+		return RetryParams{}, err
+	}
+	params.MinBackoff = minBackoff
+	maxBackoff, err := m.MaxBackoffDuration()
+	if err != nil {
+		return RetryParams{}, err
+	}
+	if maxBackoff, ok := maxBackoff.Get(); ok {
+		params.MaxBackoff = maxBackoff
+	}
+	return params, nil
+}
+
+// RetryParams are the resolved parameters of a "+retry" directive.
+type RetryParams struct {
+	// Count is the maximum number of retries.
+	Count      int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// Backoff returns the delay before the given retry, starting at 0.
+//
+// The delay starts at MinBackoff and doubles with each retry, up to MaxBackoff.
+func (r RetryParams) Backoff(retry int) time.Duration {
+	backoff := r.MinBackoff
+	for i := 0; i < retry && backoff < r.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.MaxBackoff {
+		return r.MaxBackoff
+	}
+	return backoff
+}
+
 func parseRetryDuration(str string) (time.Duration, error) {
 	// regex is more lenient than what is valid to allow for better error messages.
 	re := regexp.MustCompile(`(\d+)([a-zA-Z]+)`)
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataStream marks a verb as streaming.
+//
+// A streaming verb sends zero or more responses of its response type to the
+// caller, rather than exactly one.
+type MetadataStream struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Stream bool `parser:"'+' @'stream'" protobuf:"-"`
+}
+
+var _ Metadata = (*MetadataStream)(nil)
+
+func (m *MetadataStream) Position() Position { return m.Pos }
+func (m *MetadataStream) String() string     { return "+stream" }
+
+func (m *MetadataStream) schemaChildren() []Node { return nil }
+func (*MetadataStream) schemaMetadata()          {}
+
+func (m *MetadataStream) ToProto() proto.Message {
+	return &schemapb.MetadataStream{Pos: posToProto(m.Pos)}
+}
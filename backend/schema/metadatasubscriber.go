@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataSubscriber marks a verb as a sink for events delivered to a
+// subscription in the same module.
+type MetadataSubscriber struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Name string `parser:"'+' 'subscribe' @Ident" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataSubscriber)(nil)
+
+func (m *MetadataSubscriber) Position() Position { return m.Pos }
+func (m *MetadataSubscriber) String() string {
+	return fmt.Sprintf("+subscribe %s", m.Name)
+}
+
+func (m *MetadataSubscriber) schemaChildren() []Node { return nil }
+func (*MetadataSubscriber) schemaMetadata()          {}
+
+func (m *MetadataSubscriber) ToProto() proto.Message {
+	return &schemapb.MetadataSubscriber{
+		Pos:  posToProto(m.Pos),
+		Name: m.Name,
+	}
+}
@@ -0,0 +1,437 @@
+package schema
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/swaggest/jsonschema-go"
+)
+
+// OpenAPIVersion is the version of the OpenAPI specification generated by
+// [OpenAPI].
+const OpenAPIVersion = "3.1.0"
+
+// OpenAPIDocument is the subset of an OpenAPI 3.1 document that FTL generates.
+//
+// See https://spec.openapis.org/oas/v3.1.0
+type OpenAPIDocument struct {
+	OpenAPI    string                     `json:"openapi"`
+	Info       OpenAPIInfo                `json:"info"`
+	Servers    []OpenAPIServer            `json:"servers,omitempty"`
+	Tags       []OpenAPITag               `json:"tags,omitempty"`
+	Paths      map[string]OpenAPIPathItem `json:"paths"`
+	Components OpenAPIComponents          `json:"components,omitempty"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type OpenAPIServer struct {
+	URL string `json:"url"`
+}
+
+type OpenAPITag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// OpenAPIPathItem maps lower-case HTTP methods to operations.
+type OpenAPIPathItem map[string]*OpenAPIOperation
+
+type OpenAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Description string                     `json:"description,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+	Security    []map[string][]string      `json:"security,omitempty"`
+}
+
+type OpenAPIParameter struct {
+	Name        string             `json:"name"`
+	In          string             `json:"in"`
+	Description string             `json:"description,omitempty"`
+	Required    bool               `json:"required,omitempty"`
+	Schema      *jsonschema.Schema `json:"schema"`
+	// Wildcard is true for path parameters that match the remainder of the
+	// path.
+	Wildcard bool `json:"x-ftl-wildcard,omitempty"`
+}
+
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required,omitempty"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+type OpenAPIMediaType struct {
+	Schema *jsonschema.Schema `json:"schema"`
+}
+
+type OpenAPIComponents struct {
+	Schemas         map[string]jsonschema.SchemaOrBool `json:"schemas,omitempty"`
+	SecuritySchemes map[string]OpenAPISecurityScheme   `json:"securitySchemes,omitempty"`
+}
+
+type OpenAPISecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// openAPISecuritySchemes are the security schemes for each "+auth" method.
+var openAPISecuritySchemes = map[string]OpenAPISecurityScheme{
+	AuthMethodJWT:    {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+	AuthMethodAPIKey: {Type: "apiKey", In: "header", Name: "X-API-Key"},
+}
+
+// OpenAPI generates an OpenAPI document describing the HTTP ingress verbs in
+// the schema that are served by [server], the base URL of HTTP ingress.
+//
+// OpenAPI paths do not include a host, so the document only describes the
+// routes for the host of [server]. Where several routes match the same method
+// and path, the route with the most specific host is described, as that is
+// the route ingress uses. It is an error for two verbs to serve the same
+// method and path on the same host, as a document can only describe one of
+// them.
+//
+// Data types are encoded as JSON Schema components, using the JSON alias of
+// fields where present, as this is what ingress clients send and receive.
+func OpenAPI(sch *Schema, server *url.URL) (*OpenAPIDocument, error) {
+	doc := &OpenAPIDocument{
+		OpenAPI: OpenAPIVersion,
+		Info:    OpenAPIInfo{Title: "FTL", Version: fmt.Sprintf("%x", sch.Hash())[:12]},
+		Servers: []OpenAPIServer{{URL: server.String()}},
+		Paths:   map[string]OpenAPIPathItem{},
+	}
+	routes, err := openAPIRoutes(sch, strings.ToLower(server.Hostname()))
+	if err != nil {
+		return nil, err
+	}
+	enc := &jsonSchemaEncoder{
+		refs:             map[RefKey]*Ref{},
+		definitionPrefix: "#/components/schemas/",
+		definitionName:   openAPIComponentName,
+		jsonAliases:      true,
+	}
+	for _, module := range sch.Modules {
+		tagged := false
+		for _, decl := range module.Decls {
+			verb, ok := decl.(*Verb)
+			if !ok || !routes[verb] {
+				continue
+			}
+			ingress := verb.GetMetadataIngress().MustGet()
+			op, err := openAPIOperation(sch, enc, module, verb, ingress)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", module.Name, verb.Name, err)
+			}
+			path := openAPIPath(ingress)
+			item, ok := doc.Paths[path]
+			if !ok {
+				item = OpenAPIPathItem{}
+				doc.Paths[path] = item
+			}
+			item[strings.ToLower(ingress.Method)] = op
+			if auth, ok := verb.GetMetadataAuth().Get(); ok {
+				op.Security = []map[string][]string{{auth.Method: {}}}
+				op.Responses["401"] = openAPIProblemResponse(enc, "Unauthenticated.")
+				if doc.Components.SecuritySchemes == nil {
+					doc.Components.SecuritySchemes = map[string]OpenAPISecurityScheme{}
+				}
+				doc.Components.SecuritySchemes[auth.Method] = openAPISecuritySchemes[auth.Method]
+			}
+			if _, ok := verb.GetMetadataCache().Get(); ok {
+				op.Parameters = append(op.Parameters, OpenAPIParameter{
+					Name:        "If-None-Match",
+					In:          "header",
+					Description: "ETag of a previous response, to only return the response if it has changed.",
+					Schema:      stringJSSchema(),
+				})
+				op.Responses["304"] = OpenAPIResponse{Description: "The response has not changed."}
+			}
+			if !tagged {
+				doc.Tags = append(doc.Tags, OpenAPITag{Name: module.Name, Description: strings.Join(module.Comments, "\n")})
+				tagged = true
+			}
+		}
+	}
+	schemas, err := enc.definitions(sch)
+	if err != nil {
+		return nil, err
+	}
+	if len(schemas) > 0 {
+		doc.Components.Schemas = schemas
+	}
+	return doc, nil
+}
+
+// openAPIRoutes returns the HTTP ingress verbs that serve requests to [host].
+func openAPIRoutes(sch *Schema, host string) (map[*Verb]bool, error) {
+	type route struct {
+		name    string
+		verb    *Verb
+		ingress *MetadataIngress
+	}
+	selected := map[string]route{}
+	for _, module := range sch.Modules {
+		for _, decl := range module.Decls {
+			verb, ok := decl.(*Verb)
+			if !ok {
+				continue
+			}
+			ingress, ok := verb.GetMetadataIngress().Get()
+			if !ok || ingress.Type != "http" || !MatchIngressHost(ingress.Host, host) {
+				continue
+			}
+			name := module.Name + "." + verb.Name
+			// Paths that differ only in the names of their parameters are the
+			// same path to OpenAPI.
+			path := openAPIPath(ingress)
+			key := ingress.Method + " " + openAPIPathParameter.ReplaceAllString(path, "{}")
+			if existing, ok := selected[key]; ok {
+				cmp := IngressHostPriority(ingress.Host) - IngressHostPriority(existing.ingress.Host)
+				if cmp == 0 {
+					return nil, fmt.Errorf("%s: %s %s is also served by %s", name, ingress.Method, path, existing.name)
+				} else if cmp < 0 {
+					continue
+				}
+			}
+			selected[key] = route{name: name, verb: verb, ingress: ingress}
+		}
+	}
+	verbs := make(map[*Verb]bool, len(selected))
+	for _, route := range selected {
+		verbs[route.verb] = true
+	}
+	return verbs, nil
+}
+
+// openAPIPath returns the OpenAPI path template of an ingress route.
+func openAPIPath(ingress *MetadataIngress) string {
+	path := make([]string, len(ingress.Path))
+	for i, component := range ingress.Path {
+		switch component := component.(type) {
+		case *IngressPathLiteral:
+			path[i] = component.Text
+		case *IngressPathParameter:
+			path[i] = "{" + component.Name + "}"
+		}
+	}
+	return "/" + strings.Join(path, "/")
+}
+
+func openAPIOperation(sch *Schema, enc *jsonSchemaEncoder, module *Module, verb *Verb, ingress *MetadataIngress) (*OpenAPIOperation, error) {
+	op := &OpenAPIOperation{
+		OperationID: module.Name + "." + verb.Name,
+		Description: strings.Join(verb.Comments, "\n"),
+		Tags:        []string{module.Name},
+		Responses:   map[string]OpenAPIResponse{},
+	}
+
+	requestBody, err := httpBodyType(sch, verb.Request, "builtin.HttpRequest", "body")
+	if err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+	// When the body is a data type its fields are merged with the path
+	// parameters, and for requests without a body, the query parameters.
+	var bodyData *Data
+	if ref, ok := requestBody.(*Ref); ok {
+		if _, ok := sch.ResolveRef(ref).(*Data); ok {
+			bodyData, err = sch.ResolveRefMonomorphised(ref)
+			if err != nil {
+				return nil, fmt.Errorf("request: %w", err)
+			}
+		}
+	}
+
+	pathParameters := map[string]bool{}
+	for _, component := range ingress.Path {
+		component, ok := component.(*IngressPathParameter)
+		if !ok {
+			continue
+		}
+		pathParameters[component.Name] = true
+		param := OpenAPIParameter{Name: component.Name, In: "path", Required: true, Schema: stringJSSchema()}
+		if field := openAPIField(bodyData, component.Name); field != nil {
+			param.Description = strings.Join(field.Comments, "\n")
+			param.Schema = enc.encode(field.Type)
+		}
+		// Wildcards match the remainder of the path, which OpenAPI has no
+		// way to express.
+		if component.Wildcard {
+			if param.Description != "" {
+				param.Description += "\n"
+			}
+			param.Description += `Matches the remainder of the path, so the value may contain "/".`
+			param.Required = false
+			param.Wildcard = true
+		}
+		op.Parameters = append(op.Parameters, param)
+	}
+
+	switch {
+	case bodyData != nil:
+		var fields []*Field
+		for _, field := range bodyData.Fields {
+			if !pathParameters[openAPIFieldName(field)] && !pathParameters[field.Name] {
+				fields = append(fields, field)
+			}
+		}
+		switch ingress.Method {
+		case "GET", "DELETE":
+			for _, field := range fields {
+				_, optional := field.Type.(*Optional)
+				op.Parameters = append(op.Parameters, OpenAPIParameter{
+					Name:        openAPIFieldName(field),
+					In:          "query",
+					Description: strings.Join(field.Comments, "\n"),
+					Required:    !optional,
+					Schema:      enc.encode(field.Type),
+				})
+			}
+		default:
+			var bodySchema *jsonschema.Schema
+			if len(fields) == len(bodyData.Fields) {
+				bodySchema = enc.encode(requestBody)
+			} else {
+				remaining := *bodyData
+				remaining.Fields = fields
+				bodySchema = enc.encode(&remaining)
+			}
+			op.RequestBody = &OpenAPIRequestBody{
+				Required: true,
+				Content:  map[string]OpenAPIMediaType{"application/json": {Schema: bodySchema}},
+			}
+		}
+
+	default:
+		if _, ok := requestBody.(*Unit); !ok {
+			op.RequestBody = &OpenAPIRequestBody{
+				Required: true,
+				Content:  openAPIContent(enc, requestBody),
+			}
+		}
+	}
+
+	op.Responses["400"] = openAPIProblemResponse(enc, "Invalid request.")
+	op.Responses["500"] = openAPIProblemResponse(enc, "Verb or internal error.")
+
+	// Streaming verbs send each response as a server-sent event or a JSON line.
+	if verb.Kind() == VerbKindStream {
+		schema := enc.encode(verb.Response)
+		op.Responses["200"] = OpenAPIResponse{Description: "Stream of responses.", Content: map[string]OpenAPIMediaType{
+			"text/event-stream":    {Schema: schema},
+			"application/x-ndjson": {Schema: schema},
+		}}
+		return op, nil
+	}
+
+	responseBody, err := httpBodyType(sch, verb.Response, "builtin.HttpResponse", "body")
+	if err != nil {
+		return nil, fmt.Errorf("response: %w", err)
+	}
+	responseError, err := httpBodyType(sch, verb.Response, "builtin.HttpResponse", "error")
+	if err != nil {
+		return nil, fmt.Errorf("response: %w", err)
+	}
+	op.Responses["200"] = OpenAPIResponse{Description: "Success.", Content: openAPIContent(enc, responseBody)}
+	op.Responses["default"] = OpenAPIResponse{Description: "Error.", Content: openAPIContent(enc, responseError)}
+	return op, nil
+}
+
+// httpBodyType returns the type of [field] in a builtin.HttpRequest or
+// builtin.HttpResponse, unwrapping optionals.
+func httpBodyType(sch *Schema, t Type, builtin string, field string) (Type, error) {
+	ref, ok := t.(*Ref)
+	if !ok || ref.Module+"."+ref.Name != builtin {
+		return nil, fmt.Errorf("expected %s but got %s", builtin, t)
+	}
+	data, err := sch.ResolveRefMonomorphised(ref)
+	if err != nil {
+		return nil, err
+	}
+	f := data.FieldByName(field)
+	if f == nil {
+		return nil, fmt.Errorf("%s has no %q field", builtin, field)
+	}
+	if opt, ok := f.Type.(*Optional); ok {
+		return opt.Type, nil
+	}
+	return f.Type, nil
+}
+
+// openAPIContent returns the content of a HTTP body of the given type, keyed
+// by the media type ingress uses for it.
+func openAPIContent(enc *jsonSchemaEncoder, t Type) map[string]OpenAPIMediaType {
+	switch t.(type) {
+	case *Unit:
+		return nil
+	case *Bytes:
+		return map[string]OpenAPIMediaType{"application/octet-stream": {Schema: &jsonschema.Schema{}}}
+	case *String, *Int, *Float, *Bool:
+		return map[string]OpenAPIMediaType{"text/plain": {Schema: enc.encode(t)}}
+	default:
+		return map[string]OpenAPIMediaType{"application/json": {Schema: enc.encode(t)}}
+	}
+}
+
+// openAPIProblemResponse returns a response for errors returned by ingress
+// itself, which are RFC 7807 problem details.
+func openAPIProblemResponse(enc *jsonSchemaEncoder, description string) OpenAPIResponse {
+	problem := &Ref{Module: "builtin", Name: "HttpProblem"}
+	return OpenAPIResponse{
+		Description: description,
+		Content:     map[string]OpenAPIMediaType{"application/problem+json": {Schema: enc.encode(problem)}},
+	}
+}
+
+// openAPIField returns the field of [data] with the given name or JSON alias.
+func openAPIField(data *Data, name string) *Field {
+	if data == nil {
+		return nil
+	}
+	for _, field := range data.Fields {
+		if field.Name == name || openAPIFieldName(field) == name {
+			return field
+		}
+	}
+	return nil
+}
+
+func openAPIFieldName(field *Field) string {
+	if alias, ok := field.Alias(AliasKindJSON).Get(); ok {
+		return alias
+	}
+	return field.Name
+}
+
+var openAPIPathParameter = regexp.MustCompile(`\{[^}]*\}`)
+
+var invalidOpenAPIComponentChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// openAPIComponentName returns the name of the schema component for a
+// reference.
+//
+// Component names may only contain [a-zA-Z0-9._-], so type arguments are
+// flattened, eg. "foo.Generic[String, Int]" becomes "foo.Generic_String_Int".
+func openAPIComponentName(ref *Ref) string {
+	name := invalidOpenAPIComponentChars.ReplaceAllString(jsDefinitionName(ref), "_")
+	return strings.TrimRight(name, "_")
+}
+
+func stringJSSchema() *jsonschema.Schema {
+	st := jsonschema.String
+	return &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &st}}
+}
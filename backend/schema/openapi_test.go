@@ -0,0 +1,590 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"golang.org/x/exp/maps"
+)
+
+func TestOpenAPI(t *testing.T) {
+	sch, err := ParseString("", `
+// Users service.
+module users {
+  data User {
+    // Unique user ID.
+    id Int
+    displayName String +alias json "display_name"
+    tags [String]?
+  }
+
+  data GetUserRequest {
+    id Int
+    fields [String]?
+  }
+
+  data CreateUserRequest {
+    displayName String +alias json "display_name"
+  }
+
+  data Error {
+    message String
+  }
+
+  // Get a user.
+  export verb get(HttpRequest<users.GetUserRequest>) HttpResponse<users.User, users.Error>
+    +ingress http GET /users/{id}
+    +cache 1m
+
+  export verb create(HttpRequest<users.CreateUserRequest>) HttpResponse<users.User, String>
+    +ingress http POST /users
+
+  export verb avatar(HttpRequest<Bytes>) HttpResponse<Unit, String>
+    +ingress http PUT /avatar
+    +auth jwt
+
+  // Stream changes to users.
+  export verb changes(HttpRequest<Unit>) users.User
+    +ingress http GET /users/changes
+    +stream
+
+  export verb internal(Unit) Unit
+}
+`)
+	assert.NoError(t, err)
+	doc, err := OpenAPI(sch, &url.URL{Scheme: "http", Host: "localhost:8891", Path: "/ingress"})
+	assert.NoError(t, err)
+	doc.Info.Version = "test"
+	actual, err := json.MarshalIndent(doc, "", "  ")
+	assert.NoError(t, err)
+	expected := `{
+  "openapi": "3.1.0",
+  "info": {
+    "title": "FTL",
+    "version": "test"
+  },
+  "servers": [
+    {
+      "url": "http://localhost:8891/ingress"
+    }
+  ],
+  "tags": [
+    {
+      "name": "users",
+      "description": "Users service."
+    }
+  ],
+  "paths": {
+    "/avatar": {
+      "put": {
+        "operationId": "users.avatar",
+        "tags": [
+          "users"
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/octet-stream": {
+              "schema": {}
+            }
+          }
+        },
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Invalid request.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthenticated.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          },
+          "500": {
+            "description": "Verb or internal error.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Error.",
+            "content": {
+              "text/plain": {
+                "schema": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        },
+        "security": [
+          {
+            "jwt": []
+          }
+        ]
+      }
+    },
+    "/users": {
+      "post": {
+        "operationId": "users.create",
+        "tags": [
+          "users"
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "$ref": "#/components/schemas/users.CreateUserRequest"
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/users.User"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Invalid request.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          },
+          "500": {
+            "description": "Verb or internal error.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Error.",
+            "content": {
+              "text/plain": {
+                "schema": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/users/changes": {
+      "get": {
+        "operationId": "users.changes",
+        "description": "Stream changes to users.",
+        "tags": [
+          "users"
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of responses.",
+            "content": {
+              "application/x-ndjson": {
+                "schema": {
+                  "$ref": "#/components/schemas/users.User"
+                }
+              },
+              "text/event-stream": {
+                "schema": {
+                  "$ref": "#/components/schemas/users.User"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Invalid request.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          },
+          "500": {
+            "description": "Verb or internal error.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/users/{id}": {
+      "get": {
+        "operationId": "users.get",
+        "description": "Get a user.",
+        "tags": [
+          "users"
+        ],
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "schema": {
+              "type": "integer"
+            }
+          },
+          {
+            "name": "fields",
+            "in": "query",
+            "schema": {
+              "anyOf": [
+                {
+                  "items": {
+                    "type": "string"
+                  },
+                  "type": "array"
+                },
+                {
+                  "type": "null"
+                }
+              ]
+            }
+          },
+          {
+            "name": "If-None-Match",
+            "in": "header",
+            "description": "ETag of a previous response, to only return the response if it has changed.",
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/users.User"
+                }
+              }
+            }
+          },
+          "304": {
+            "description": "The response has not changed."
+          },
+          "400": {
+            "description": "Invalid request.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          },
+          "500": {
+            "description": "Verb or internal error.",
+            "content": {
+              "application/problem+json": {
+                "schema": {
+                  "$ref": "#/components/schemas/builtin.HttpProblem"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Error.",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/users.Error"
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "builtin.HttpProblem": {
+        "description": "RFC 7807 problem details returned by HTTP ingress for errors that occur\noutside of the verb, with the content type \"application/problem+json\".",
+        "required": [
+          "type",
+          "title",
+          "status"
+        ],
+        "additionalProperties": false,
+        "properties": {
+          "detail": {
+            "anyOf": [
+              {
+                "type": "string"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          },
+          "instance": {
+            "description": "Path of the HTTP request.",
+            "anyOf": [
+              {
+                "type": "string"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          },
+          "invalidFields": {
+            "description": "Fields of the request that failed validation.",
+            "anyOf": [
+              {
+                "items": {
+                  "$ref": "#/components/schemas/builtin.HttpProblemField"
+                },
+                "type": "array"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          },
+          "requestKey": {
+            "anyOf": [
+              {
+                "type": "string"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          },
+          "status": {
+            "type": "integer"
+          },
+          "title": {
+            "type": "string"
+          },
+          "type": {
+            "description": "Stable URI identifying the kind of problem, eg. \"urn:ftl:problem:invalid-request\".",
+            "type": "string"
+          }
+        },
+        "type": "object"
+      },
+      "builtin.HttpProblemField": {
+        "description": "A field of an HTTP request that failed validation.",
+        "required": [
+          "path",
+          "detail"
+        ],
+        "additionalProperties": false,
+        "properties": {
+          "detail": {
+            "type": "string"
+          },
+          "path": {
+            "description": "Path of the field in the request, eg. \"body.items[0].name\".",
+            "type": "string"
+          }
+        },
+        "type": "object"
+      },
+      "users.CreateUserRequest": {
+        "required": [
+          "display_name"
+        ],
+        "additionalProperties": false,
+        "properties": {
+          "display_name": {
+            "type": "string"
+          }
+        },
+        "type": "object"
+      },
+      "users.Error": {
+        "required": [
+          "message"
+        ],
+        "additionalProperties": false,
+        "properties": {
+          "message": {
+            "type": "string"
+          }
+        },
+        "type": "object"
+      },
+      "users.User": {
+        "required": [
+          "id",
+          "display_name"
+        ],
+        "additionalProperties": false,
+        "properties": {
+          "display_name": {
+            "type": "string"
+          },
+          "id": {
+            "description": "Unique user ID.",
+            "type": "integer"
+          },
+          "tags": {
+            "anyOf": [
+              {
+                "items": {
+                  "type": "string"
+                },
+                "type": "array"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          }
+        },
+        "type": "object"
+      }
+    },
+    "securitySchemes": {
+      "jwt": {
+        "type": "http",
+        "scheme": "bearer",
+        "bearerFormat": "JWT"
+      }
+    }
+  }
+}`
+	assert.Equal(t, expected, string(actual))
+}
+
+func TestOpenAPIDuplicateRoutes(t *testing.T) {
+	// Validation rejects duplicate routes, so the modules are not validated.
+	users, err := moduleParser.ParseString("", `
+module users {
+  export verb list(builtin.HttpRequest<Unit>) builtin.HttpResponse<Unit, Unit>
+    +ingress http GET /users
+}
+`)
+	assert.NoError(t, err)
+	admin, err := moduleParser.ParseString("", `
+module admin {
+  export verb users(builtin.HttpRequest<Unit>) builtin.HttpResponse<Unit, Unit>
+    +ingress http GET /users
+}
+`)
+	assert.NoError(t, err)
+	sch := &Schema{Modules: []*Module{Builtins(), users, admin}}
+	_, err = OpenAPI(sch, &url.URL{Scheme: "http", Host: "localhost:8891", Path: "/ingress"})
+	assert.EqualError(t, err, "admin.users: GET /users is also served by users.list")
+}
+
+func TestOpenAPIHosts(t *testing.T) {
+	sch, err := ParseString("", `
+module users {
+  export verb list(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+    +ingress http GET /users
+
+  export verb listAPI(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+    +ingress http GET /users host "api.example.com"
+
+  export verb listAdmin(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+    +ingress http GET /users host "*.example.com"
+
+  export verb create(HttpRequest<Unit>) HttpResponse<Unit, Unit>
+    +ingress http POST /users host "api.example.com"
+}
+`)
+	assert.NoError(t, err)
+	for _, tt := range []struct {
+		server     string
+		operations map[string]string
+	}{
+		{"http://localhost:8891/ingress", map[string]string{"get": "users.list"}},
+		{"https://API.example.com/ingress", map[string]string{"get": "users.listAPI", "post": "users.create"}},
+		{"https://admin.example.com:8443/ingress", map[string]string{"get": "users.listAdmin"}},
+	} {
+		t.Run(tt.server, func(t *testing.T) {
+			server, err := url.Parse(tt.server)
+			assert.NoError(t, err)
+			doc, err := OpenAPI(sch, server)
+			assert.NoError(t, err)
+			assert.Equal(t, []OpenAPIServer{{URL: tt.server}}, doc.Servers)
+			assert.Equal(t, []string{"/users"}, maps.Keys(doc.Paths))
+			operations := map[string]string{}
+			for method, op := range doc.Paths["/users"] {
+				operations[method] = op.OperationID
+			}
+			assert.Equal(t, tt.operations, operations)
+		})
+	}
+}
+
+func TestOpenAPIComponentName(t *testing.T) {
+	ref := &Ref{Module: "foo", Name: "Generic", TypeParameters: []Type{&String{}, &Map{Key: &String{}, Value: &Ref{Module: "bar", Name: "Bar"}}}}
+	assert.Equal(t, "foo.Generic_String_String_bar.Bar", openAPIComponentName(ref))
+}
+
+func TestOpenAPIWildcard(t *testing.T) {
+	sch, err := ParseString("", `
+module files {
+  data GetRequest {
+    bucket String
+    // Path of the file.
+    path String
+  }
+
+  export verb get(HttpRequest<files.GetRequest>) HttpResponse<Unit, Unit>
+    +ingress http GET /files/{bucket}/{path...}
+}
+`)
+	assert.NoError(t, err)
+	doc, err := OpenAPI(sch, &url.URL{Scheme: "http", Host: "localhost:8891", Path: "/ingress"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/files/{bucket}/{path}"}, maps.Keys(doc.Paths))
+	assert.Equal(t, []OpenAPIParameter{
+		{Name: "bucket", In: "path", Required: true, Schema: stringJSSchema()},
+		{
+			Name:        "path",
+			In:          "path",
+			Description: "Path of the file.\nMatches the remainder of the path, so the value may contain \"/\".",
+			Schema:      stringJSSchema(),
+			Wildcard:    true,
+		},
+	}, doc.Paths["/files/{bucket}/{path}"]["get"].Parameters)
+}
@@ -37,11 +37,18 @@ func declListToSchema(s []*schemapb.Decl) []Decl {
 			out = append(out, SecretFromProto(n.Secret))
 		case *schemapb.Decl_Fsm:
 			out = append(out, FSMFromProto(n.Fsm))
+		case *schemapb.Decl_Topic:
+			out = append(out, TopicFromProto(n.Topic))
+		case *schemapb.Decl_Subscription:
+			out = append(out, SubscriptionFromProto(n.Subscription))
 		}
 	}
 	return out
 }
 
+// TypeFromProto converts a protobuf Type to a schema Type.
+func TypeFromProto(s *schemapb.Type) Type { return typeToSchema(s) }
+
 func typeToSchema(s *schemapb.Type) Type {
 	switch s := s.Value.(type) {
 	case *schemapb.Type_Ref:
@@ -121,12 +128,17 @@ func metadataToSchema(s *schemapb.Metadata) Metadata {
 			Type:   s.Ingress.Type,
 			Method: s.Ingress.Method,
 			Path:   ingressPathComponentListToSchema(s.Ingress.Path),
+			Host:   s.Ingress.Host,
 		}
 
 	case *schemapb.Metadata_CronJob:
 		return &MetadataCronJob{
-			Pos:  posFromProto(s.CronJob.Pos),
-			Cron: s.CronJob.Cron,
+			Pos:         posFromProto(s.CronJob.Pos),
+			Cron:        s.CronJob.Cron,
+			Concurrency: s.CronJob.Concurrency,
+			Jitter:      s.CronJob.Jitter,
+			Catchup:     s.CronJob.Catchup,
+			MaxCatchup:  int(s.CronJob.MaxCatchup),
 		}
 
 	case *schemapb.Metadata_Alias:
@@ -136,6 +148,61 @@ func metadataToSchema(s *schemapb.Metadata) Metadata {
 			Alias: s.Alias.Alias,
 		}
 
+	case *schemapb.Metadata_Subscriber:
+		return &MetadataSubscriber{
+			Pos:  posFromProto(s.Subscriber.Pos),
+			Name: s.Subscriber.Name,
+		}
+
+	case *schemapb.Metadata_Auth:
+		return &MetadataAuth{
+			Pos:    posFromProto(s.Auth.Pos),
+			Method: s.Auth.Method,
+		}
+
+	case *schemapb.Metadata_Cors:
+		return &MetadataCORS{
+			Pos:              posFromProto(s.Cors.Pos),
+			AllowOrigins:     s.Cors.AllowOrigins,
+			AllowMethods:     s.Cors.AllowMethods,
+			AllowHeaders:     s.Cors.AllowHeaders,
+			AllowCredentials: s.Cors.AllowCredentials,
+			MaxAge:           int(s.Cors.MaxAge),
+		}
+
+	case *schemapb.Metadata_Cache:
+		return &MetadataCache{
+			Pos: posFromProto(s.Cache.Pos),
+			TTL: s.Cache.Ttl,
+		}
+
+	case *schemapb.Metadata_RateLimit:
+		return &MetadataRateLimit{
+			Pos:    posFromProto(s.RateLimit.Pos),
+			Limit:  int(s.RateLimit.Limit),
+			Period: s.RateLimit.Period,
+			By:     s.RateLimit.By,
+		}
+
+	case *schemapb.Metadata_Stream:
+		return &MetadataStream{
+			Pos:    posFromProto(s.Stream.Pos),
+			Stream: true,
+		}
+
+	case *schemapb.Metadata_Retry:
+		var count *int
+		if s.Retry.Count != nil {
+			c := int(*s.Retry.Count)
+			count = &c
+		}
+		return &MetadataRetry{
+			Pos:        posFromProto(s.Retry.Pos),
+			Count:      count,
+			MinBackoff: s.Retry.MinBackoff,
+			MaxBackoff: s.Retry.MaxBackoff,
+		}
+
 	default:
 		panic(fmt.Sprintf("unhandled metadata type: %T", s))
 	}
@@ -49,6 +49,12 @@ func declListToProto(nodes []Decl) []*schemapb.Decl {
 
 		case *TypeAlias:
 			v = &schemapb.Decl_TypeAlias{TypeAlias: n.ToProto().(*schemapb.TypeAlias)}
+
+		case *Topic:
+			v = &schemapb.Decl_Topic{Topic: n.ToProto().(*schemapb.Topic)}
+
+		case *Subscription:
+			v = &schemapb.Decl_Subscription{Subscription: n.ToProto().(*schemapb.Subscription)}
 		}
 		out[i] = &schemapb.Decl{Value: v}
 	}
@@ -78,6 +84,24 @@ func metadataListToProto(nodes []Metadata) []*schemapb.Metadata {
 		case *MetadataRetry:
 			v = &schemapb.Metadata_Retry{Retry: n.ToProto().(*schemapb.MetadataRetry)}
 
+		case *MetadataSubscriber:
+			v = &schemapb.Metadata_Subscriber{Subscriber: n.ToProto().(*schemapb.MetadataSubscriber)}
+
+		case *MetadataAuth:
+			v = &schemapb.Metadata_Auth{Auth: n.ToProto().(*schemapb.MetadataAuth)}
+
+		case *MetadataCORS:
+			v = &schemapb.Metadata_Cors{Cors: n.ToProto().(*schemapb.MetadataCORS)}
+
+		case *MetadataCache:
+			v = &schemapb.Metadata_Cache{Cache: n.ToProto().(*schemapb.MetadataCache)}
+
+		case *MetadataRateLimit:
+			v = &schemapb.Metadata_RateLimit{RateLimit: n.ToProto().(*schemapb.MetadataRateLimit)}
+
+		case *MetadataStream:
+			v = &schemapb.Metadata_Stream{Stream: n.ToProto().(*schemapb.MetadataStream)}
+
 		default:
 			panic(fmt.Sprintf("unhandled metadata type %T", n))
 		}
@@ -397,6 +397,56 @@ func TestParsing(t *testing.T) {
 				}},
 			},
 		},
+		{name: "PubSub",
+			input: `
+				module test {
+					export topic events test.Event
+					subscription consumer test.events
+
+					export data Event {
+						value String
+					}
+
+					verb consume(test.Event) Unit
+						+subscribe consumer
+				}
+				`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Topic{
+							Comments: []string{},
+							Export:   true,
+							Name:     "events",
+							Event:    &Ref{Module: "test", Name: "Event"},
+						},
+						&Subscription{
+							Comments: []string{},
+							Name:     "consumer",
+							Topic:    &Ref{Module: "test", Name: "events"},
+						},
+						&Data{
+							Comments: []string{},
+							Export:   true,
+							Name:     "Event",
+							Fields: []*Field{
+								{Name: "value", Type: &String{}},
+							},
+						},
+						&Verb{
+							Comments: []string{},
+							Name:     "consume",
+							Request:  &Ref{Module: "test", Name: "Event"},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{
+								&MetadataSubscriber{Name: "consumer"},
+							},
+						},
+					},
+				}},
+			},
+		},
 		{name: "RetryFSM",
 			input: `
 				module test {
@@ -791,3 +841,102 @@ func TestRetryParsing(t *testing.T) {
 		assert.Equal(t, time.Second*time.Duration(tt.seconds), duration)
 	}
 }
+
+func TestIngressParsing(t *testing.T) {
+	for _, input := range []string{
+		`+ingress http GET /users/{id}`,
+		`+ingress http GET /files/{path...}`,
+		`+ingress http POST /users host "api.example.com"`,
+		`+ingress ws /chat/{room} host "*.example.com"`,
+	} {
+		module, err := moduleParser.ParseString("", "module test {\n  verb test(Unit) Unit\n    "+input+"\n}")
+		assert.NoError(t, err)
+		verb, ok := module.Decls[0].(*Verb)
+		assert.True(t, ok)
+		assert.Equal(t, input, verb.Metadata[0].String())
+	}
+}
+
+func TestCronParsing(t *testing.T) {
+	for _, input := range []string{
+		`+cron TZ=America/Argentina/Buenos_Aires 0 9 * * 1-5`,
+		`+cron TZ=Etc/GMT+10 */5 * * * *`,
+		`+cron @hourly`,
+		`+cron @every 1h30m`,
+		`+cron 0 9 * * 1-5 concurrency=allow`,
+		`+cron @every 10m jitter=1m30s catchup=all:5`,
+		`+cron TZ=Europe/London @daily concurrency=forbid jitter=5m catchup=last`,
+	} {
+		module, err := moduleParser.ParseString("", "module test {\n  verb test(Unit) Unit\n    "+input+"\n}")
+		assert.NoError(t, err)
+		verb, ok := module.Decls[0].(*Verb)
+		assert.True(t, ok)
+		assert.Equal(t, input, verb.Metadata[0].String())
+	}
+}
+
+func TestRateLimitParsing(t *testing.T) {
+	for _, input := range []string{
+		`+ratelimit 10/s`,
+		`+ratelimit 100/1m by=header:X-Api-Key`,
+		`+ratelimit 5/1h by=caller-module`,
+		`+ratelimit 5/10s by=ip`,
+	} {
+		module, err := moduleParser.ParseString("", "module test {\n  verb test(Unit) Unit\n    "+input+"\n}")
+		assert.NoError(t, err)
+		verb, ok := module.Decls[0].(*Verb)
+		assert.True(t, ok)
+		assert.Equal(t, input, verb.Metadata[0].String())
+	}
+}
+
+func TestFSMNextStates(t *testing.T) {
+	fsm := &FSM{
+		Name:  "FSM",
+		Start: []*Ref{{Module: "test", Name: "A"}},
+		Transitions: []*FSMTransition{
+			{From: &Ref{Module: "test", Name: "A"}, To: &Ref{Module: "test", Name: "B"}},
+			{From: &Ref{Module: "test", Name: "A"}, To: &Ref{Module: "test", Name: "C"}},
+			{From: &Ref{Module: "test", Name: "B"}, To: &Ref{Module: "test", Name: "C"}},
+		},
+	}
+	assert.Equal(t, []*Ref{{Module: "test", Name: "B"}, {Module: "test", Name: "C"}}, fsm.NextStates(RefKey{Module: "test", Name: "A"}))
+	assert.Equal(t, []*Ref{{Module: "test", Name: "C"}}, fsm.NextStates(RefKey{Module: "test", Name: "B"}))
+	assert.Equal(t, nil, fsm.NextStates(RefKey{Module: "test", Name: "C"}))
+}
+
+func TestRetryBackoff(t *testing.T) {
+	count := 3
+	params, err := (&MetadataRetry{Count: &count, MinBackoff: "5s", MaxBackoff: "30s"}).RetryParams()
+	assert.NoError(t, err)
+	assert.Equal(t, RetryParams{Count: 3, MinBackoff: 5 * time.Second, MaxBackoff: 30 * time.Second}, params)
+	assert.Equal(t, 5*time.Second, params.Backoff(0))
+	assert.Equal(t, 10*time.Second, params.Backoff(1))
+	assert.Equal(t, 20*time.Second, params.Backoff(2))
+	assert.Equal(t, 30*time.Second, params.Backoff(3))
+	assert.Equal(t, 30*time.Second, params.Backoff(100))
+
+	params, err = (&MetadataRetry{MinBackoff: "1h"}).RetryParams()
+	assert.NoError(t, err)
+	assert.Equal(t, RetryParams{Count: DefaultRetryCount, MinBackoff: time.Hour, MaxBackoff: MaxBackoffLimit}, params)
+}
+
+func TestRetryWithoutCount(t *testing.T) {
+	module, err := ParseModuleString("", `
+		module test {
+			verb A(Unit) Unit
+				+retry 5s 1m
+
+			fsm FSM {
+				start test.A
+			}
+		}
+	`)
+	assert.NoError(t, err)
+	retry, ok := module.Verbs()[0].Metadata[0].(*MetadataRetry)
+	assert.True(t, ok)
+	assert.Equal(t, (*int)(nil), retry.Count)
+	params, err := retry.RetryParams()
+	assert.NoError(t, err)
+	assert.Equal(t, RetryParams{Count: DefaultRetryCount, MinBackoff: 5 * time.Second, MaxBackoff: time.Minute}, params)
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// Subscription is a named cursor into a Topic.
+//
+// Events are delivered to the verbs subscribed to the subscription with
+// +subscribe metadata.
+type Subscription struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Comments []string `parser:"@Comment*" protobuf:"2"`
+	Name     string   `parser:"'subscription' @Ident" protobuf:"3"`
+	Topic    *Ref     `parser:"@@" protobuf:"4"`
+}
+
+var _ Decl = (*Subscription)(nil)
+var _ Symbol = (*Subscription)(nil)
+
+func (s *Subscription) GetName() string    { return s.Name }
+func (s *Subscription) IsExported() bool   { return false }
+func (s *Subscription) Position() Position { return s.Pos }
+func (s *Subscription) String() string {
+	w := &strings.Builder{}
+
+	fmt.Fprint(w, EncodeComments(s.Comments))
+	fmt.Fprintf(w, "subscription %s %s", s.Name, s.Topic)
+
+	return w.String()
+}
+
+func (s *Subscription) ToProto() protoreflect.ProtoMessage {
+	return &schemapb.Subscription{
+		Pos:      posToProto(s.Pos),
+		Comments: s.Comments,
+		Name:     s.Name,
+		Topic:    s.Topic.ToProto().(*schemapb.Ref), //nolint:forcetypeassert
+	}
+}
+
+func (s *Subscription) schemaChildren() []Node { return []Node{s.Topic} }
+func (s *Subscription) schemaDecl()            {}
+func (s *Subscription) schemaSymbol()          {}
+
+func SubscriptionFromProto(p *schemapb.Subscription) *Subscription {
+	return &Subscription{
+		Pos:      posFromProto(p.Pos),
+		Comments: p.Comments,
+		Name:     p.Name,
+		Topic:    RefFromProto(p.Topic),
+	}
+}
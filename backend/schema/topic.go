@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// Topic is a durable, ordered stream of events that modules can publish to
+// and subscribe to.
+type Topic struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Comments []string `parser:"@Comment*" protobuf:"2"`
+	Export   bool     `parser:"@'export'?" protobuf:"3"`
+	Name     string   `parser:"'topic' @Ident" protobuf:"4"`
+	Event    Type     `parser:"@@" protobuf:"5"`
+}
+
+var _ Decl = (*Topic)(nil)
+var _ Symbol = (*Topic)(nil)
+
+func (t *Topic) GetName() string    { return t.Name }
+func (t *Topic) IsExported() bool   { return t.Export }
+func (t *Topic) Position() Position { return t.Pos }
+func (t *Topic) String() string {
+	w := &strings.Builder{}
+
+	fmt.Fprint(w, EncodeComments(t.Comments))
+	if t.Export {
+		fmt.Fprint(w, "export ")
+	}
+	fmt.Fprintf(w, "topic %s %s", t.Name, t.Event)
+
+	return w.String()
+}
+
+func (t *Topic) ToProto() protoreflect.ProtoMessage {
+	return &schemapb.Topic{
+		Pos:      posToProto(t.Pos),
+		Comments: t.Comments,
+		Export:   t.Export,
+		Name:     t.Name,
+		Event:    typeToProto(t.Event),
+	}
+}
+
+func (t *Topic) schemaChildren() []Node { return []Node{t.Event} }
+func (t *Topic) schemaDecl()            {}
+func (t *Topic) schemaSymbol()          {}
+
+func TopicFromProto(p *schemapb.Topic) *Topic {
+	return &Topic{
+		Pos:      posFromProto(p.Pos),
+		Comments: p.Comments,
+		Export:   p.Export,
+		Name:     p.Name,
+		Event:    typeToSchema(p.Event),
+	}
+}
@@ -3,11 +3,13 @@ package schema
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/types/optional"
@@ -59,7 +61,7 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 	schema = dc.DeepCopy(schema)
 	modules := map[string]bool{}
 	merr := []error{}
-	ingress := map[string]*Verb{}
+	ingress := map[string]ingressRoute{}
 
 	// Inject builtins.
 	builtins := Builtins()
@@ -84,6 +86,21 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 		}
 	}
 
+	// Routes of other modules conflict with those of the module being
+	// validated, so they must be known before validating it.
+	if v, ok := m.Get(); ok {
+		for _, module := range schema.Modules {
+			if module.Name == v.Name || module.Name == builtins.Name {
+				continue
+			}
+			for _, verb := range module.Verbs() {
+				if md, ok := verb.GetMetadataIngress().Get(); ok {
+					ingress[ingressRouteKey(md)] = ingressRoute{module: module.Name, verb: verb}
+				}
+			}
+		}
+	}
+
 	// Validate modules.
 	for _, module := range schema.Modules {
 		// Skip builtin module, it's already been validated.
@@ -150,20 +167,18 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 						continue
 					}
 					// Check for duplicate ingress keys
-					key := md.Method + " "
-					for _, path := range md.Path {
-						switch path := path.(type) {
-						case *IngressPathLiteral:
-							key += "/" + path.Text
-
-						case *IngressPathParameter:
-							key += "/{}"
-						}
-					}
+					key := ingressRouteKey(md)
 					if existing, ok := ingress[key]; ok {
-						merr = append(merr, errorf(md, "duplicate %s ingress %s for %s:%q and %s:%q", md.Type, key, existing.Pos, existing.Name, n.Pos, n.Name))
+						name := existing.verb.Name
+						if existing.module != module.Name {
+							name = existing.module + "." + name
+						}
+						merr = append(merr, errorf(md, "duplicate %s ingress %s for %s:%q and %s:%q", md.Type, key, existing.verb.Pos, name, n.Pos, n.Name))
 					}
-					ingress[key] = n
+					ingress[key] = ingressRoute{module: module.Name, verb: n}
+				}
+				if md, ok := n.GetMetadataSubscriber().Get(); ok {
+					merr = append(merr, validateVerbSubscriber(scopes, module, n, md)...)
 				}
 
 			case *Enum:
@@ -212,12 +227,20 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 					merr = append(merr, errorf(n.To, "destination state %q must be a sink but is %s", n.To, sym.Kind()))
 				}
 
+			case *Subscription:
+				if sym, decl := ResolveAs[*Topic](scopes, *n.Topic); decl == nil {
+					merr = append(merr, errorf(n.Topic, "unknown topic %q", n.Topic))
+				} else if sym == nil {
+					merr = append(merr, errorf(n.Topic, "subscription %q must reference a topic but %q is a %s", n.Name, n.Topic, typeName(decl.Symbol)))
+				}
+
 			case *Array, *Bool, *Bytes, *Data, *Database, Decl, *Field, *Float,
 				IngressPathComponent, *IngressPathLiteral, *IngressPathParameter,
 				*Int, *Map, Metadata, *MetadataCalls, *MetadataDatabases, *MetadataCronJob,
 				*MetadataIngress, *MetadataAlias, *Module, *Optional, *Schema, *TypeAlias,
 				*String, *Time, Type, *Unit, *Any, *TypeParameter, *EnumVariant, *MetadataRetry,
-				Value, *IntValue, *StringValue, *TypeValue, *Config, *Secret, Symbol, Named:
+				Value, *IntValue, *StringValue, *TypeValue, *Config, *Secret, Symbol, Named,
+				*Topic, *MetadataSubscriber, *MetadataAuth, *MetadataStream, *MetadataCORS, *MetadataCache, *MetadataRateLimit:
 			}
 			return next()
 		})
@@ -290,7 +313,7 @@ func ValidateModule(module *Module) error {
 					moduleName = m.Name
 				}
 				switch decl := mdecl.Symbol.(type) {
-				case *Verb, *Enum, *Database, *Config, *Secret:
+				case *Verb, *Enum, *Database, *Config, *Secret, *Topic:
 					if n.Module == "" {
 						n.Module = moduleName
 					}
@@ -337,7 +360,8 @@ func ValidateModule(module *Module) error {
 			*MetadataCalls, *MetadataDatabases, *MetadataIngress, *MetadataCronJob, *MetadataAlias,
 			IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *Optional,
 			*Unit, *Any, *TypeParameter, *Enum, *EnumVariant, *IntValue, *StringValue, *TypeValue,
-			*FSM, *Config, *FSMTransition, *Secret, *TypeAlias, *MetadataRetry:
+			*FSM, *Config, *FSMTransition, *Secret, *TypeAlias, *MetadataRetry,
+			*Topic, *Subscription, *MetadataSubscriber, *MetadataAuth, *MetadataStream, *MetadataCORS, *MetadataCache, *MetadataRateLimit:
 
 		case Named, Symbol, Type, Metadata, Value, Decl: // Union types.
 		}
@@ -369,16 +393,20 @@ func getDeclSortingPriority(decl Decl) int {
 		priority = 2
 	case *Database:
 		priority = 3
-	case *TypeAlias:
+	case *Topic:
 		priority = 4
-	case *Enum:
+	case *Subscription:
 		priority = 5
-	case *FSM:
+	case *TypeAlias:
 		priority = 6
-	case *Data:
+	case *Enum:
 		priority = 7
-	case *Verb:
+	case *FSM:
 		priority = 8
+	case *Data:
+		priority = 9
+	case *Verb:
+		priority = 10
 	}
 	return priority
 }
@@ -492,10 +520,22 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 
 		switch md := md.(type) {
 		case *MetadataIngress:
+			merr = append(merr, validateIngressRoute(n, md)...)
+			if md.Type == "ws" {
+				merr = append(merr, validateWebSocketIngress(scopes, n, md)...)
+				continue
+			}
+			if md.Method == "" {
+				merr = append(merr, errorf(md, "ingress verb %s: %s ingress requires a method", n.Name, md.Type))
+			}
 			reqBodyType, reqBody, errs := validateIngressRequestOrResponse(scopes, module, n, "request", n.Request)
 			merr = append(merr, errs...)
-			_, _, errs = validateIngressRequestOrResponse(scopes, module, n, "response", n.Response)
-			merr = append(merr, errs...)
+			// Streaming verbs send their responses directly rather than as a
+			// builtin.HttpResponse.
+			if n.Kind() != VerbKindStream {
+				_, _, errs = validateIngressRequestOrResponse(scopes, module, n, "response", n.Response)
+				merr = append(merr, errs...)
+			}
 
 			// Validate path
 			for _, path := range md.Path {
@@ -512,9 +552,22 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 				}
 			}
 		case *MetadataCronJob:
-			_, err := cron.Parse(md.Cron)
-			if err != nil {
-				merr = append(merr, err)
+			pattern, scheduleErr := cron.Parse(md.Cron)
+			if scheduleErr != nil {
+				merr = append(merr, errorf(md, "verb %s: invalid cron schedule %q: %s", n.Name, md.Cron, scheduleErr))
+			}
+			if jitter, err := md.JitterDuration(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			} else if jitter > 0 && scheduleErr == nil {
+				// Jitter must not delay an execution past the next one.
+				if interval, err := cron.MinInterval(pattern, time.Now()); err == nil && jitter >= interval {
+					merr = append(merr, errorf(md, "verb %s: cron jitter %s must be less than the interval between executions of %s", n.Name, jitter, interval))
+				}
+			}
+			if md.MaxCatchup != 0 && md.Catchup != "all" {
+				merr = append(merr, errorf(md, "verb %s: cron catchup limit can only be used with catchup=all", n.Name))
+			} else if md.MaxCatchup < 0 {
+				merr = append(merr, errorf(md, "verb %s: cron catchup limit must be at least 1", n.Name))
 			}
 			if _, ok := n.Request.(*Unit); !ok {
 				merr = append(merr, errorf(md, "verb %s: cron job can not have a request type", n.Name))
@@ -564,12 +617,107 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 			if maxDuration, ok := maxDuration.Get(); ok && maxDuration < minDuration {
 				merr = append(merr, errorf(md, "verb %s: max backoff duration (%s) needs to be atleast as long as initial backoff (%s)", n.Name, md.MaxBackoff, md.MinBackoff))
 			}
+		case *MetadataSubscriber:
+			if n.Kind() != VerbKindSink {
+				merr = append(merr, errorf(md, "verb %s: subscriber must be a sink but is %s", n.Name, n.Kind()))
+			}
+
+		case *MetadataAuth:
+			if ingress, ok := n.GetMetadataIngress().Get(); !ok || ingress.Type != "http" {
+				merr = append(merr, errorf(md, "verb %s: +auth can only be used on HTTP ingress verbs", n.Name))
+			}
+
+		case *MetadataCORS:
+			if ingress, ok := n.GetMetadataIngress().Get(); !ok || ingress.Type != "http" {
+				merr = append(merr, errorf(md, "verb %s: +cors can only be used on HTTP ingress verbs", n.Name))
+			}
+			for _, origin := range md.AllowOrigins {
+				if err := validateCORSOrigin(origin); err != nil {
+					merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+				}
+			}
+			if md.AllowCredentials && (slices.Contains(md.AllowOrigins, "*") || slices.Contains(md.AllowHeaders, "*")) {
+				merr = append(merr, errorf(md, "verb %s: CORS credentials can not be allowed for \"*\"", n.Name))
+			}
+
+		case *MetadataCache:
+			if ingress, ok := n.GetMetadataIngress().Get(); !ok || ingress.Type != "http" || ingress.Method != "GET" {
+				merr = append(merr, errorf(md, "verb %s: +cache can only be used on GET HTTP ingress verbs", n.Name))
+			}
+			if n.Kind() == VerbKindStream {
+				merr = append(merr, errorf(md, "verb %s: streaming verbs can not be cached", n.Name))
+			}
+			if _, ok := n.GetMetadataAuth().Get(); ok {
+				merr = append(merr, errorf(md, "verb %s: authenticated verbs can not be cached", n.Name))
+			}
+			if _, err := md.TTLDuration(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
+
+		case *MetadataRateLimit:
+			if md.Limit < 1 {
+				merr = append(merr, errorf(md, "verb %s: rate limit must be at least 1", n.Name))
+			}
+			if _, err := md.PeriodDuration(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
+
+		case *MetadataStream:
+			if _, ok := n.Response.(*Unit); ok {
+				merr = append(merr, errorf(md, "verb %s: streaming verbs must have a response type", n.Name))
+			}
+
 		case *MetadataCalls, *MetadataDatabases, *MetadataAlias:
 		}
 	}
 	return
 }
 
+// validateCORSOrigin checks that a CORS origin is "*" or a scheme and host,
+// optionally with a "*." wildcard subdomain.
+func validateCORSOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+	u, err := url.Parse(strings.Replace(origin, "://*.", "://", 1))
+	if err != nil || u.Scheme == "" || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("invalid CORS origin %q, expected \"*\" or scheme://host[:port]", origin)
+	}
+	return nil
+}
+
+// validateVerbSubscriber checks that the subscription referenced by a
+// subscriber exists in the same module, and that the verb accepts the event
+// type of the subscribed topic.
+func validateVerbSubscriber(scopes Scopes, module *Module, n *Verb, md *MetadataSubscriber) (merr []error) {
+	var subscription *Subscription
+	for _, decl := range module.Decls {
+		if s, ok := decl.(*Subscription); ok && s.Name == md.Name {
+			subscription = s
+			break
+		}
+	}
+	if subscription == nil {
+		merr = append(merr, errorf(md, "verb %s: could not find subscription %q", n.Name, md.Name))
+		return
+	}
+	topic, decl := ResolveAs[*Topic](scopes, *subscription.Topic)
+	if topic == nil {
+		// Reported when validating the subscription.
+		return
+	}
+	eventType := topic.Event
+	if ref, ok := eventType.(*Ref); ok && ref.Module == "" {
+		if m, ok := decl.Module.Get(); ok {
+			eventType = &Ref{Module: m.Name, Name: ref.Name, TypeParameters: ref.TypeParameters}
+		}
+	}
+	if n.Request.String() != eventType.String() {
+		merr = append(merr, errorf(md, "verb %s: request type %s differs from event type %s of topic %s", n.Name, n.Request, eventType, subscription.Topic))
+	}
+	return
+}
+
 func validateIngressRequestOrResponse(scopes Scopes, module *Module, n *Verb, reqOrResp string, r Type) (fieldType Type, body Symbol, merr []error) {
 	rref, _ := r.(*Ref)
 	resp, sym := ResolveTypeAs[*Data](scopes, r)
@@ -616,6 +764,67 @@ func validateIngressRequestOrResponse(scopes Scopes, module *Module, n *Verb, re
 	return
 }
 
+type ingressRoute struct {
+	module string
+	verb   *Verb
+}
+
+// ingressRouteKey returns a key identifying the requests matched by an
+// ingress route, ignoring the names of path parameters.
+func ingressRouteKey(md *MetadataIngress) string {
+	key := md.RouteMethod() + " " + strings.ToLower(md.Host)
+	for _, path := range md.Path {
+		switch path := path.(type) {
+		case *IngressPathLiteral:
+			key += "/" + path.Text
+
+		case *IngressPathParameter:
+			if path.Wildcard {
+				key += "/{...}"
+			} else {
+				key += "/{}"
+			}
+		}
+	}
+	return key
+}
+
+// validateIngressRoute checks the host and path of an ingress route.
+func validateIngressRoute(n *Verb, md *MetadataIngress) (merr []error) {
+	if md.Host != "" {
+		host := strings.TrimPrefix(md.Host, "*.")
+		if u, err := url.Parse("http://" + host); err != nil || u.Host != host || u.Port() != "" || host == "" || strings.Contains(host, "*") {
+			merr = append(merr, errorf(md, "ingress verb %s: invalid host %q, expected a hostname optionally prefixed with \"*.\"", n.Name, md.Host))
+		}
+	}
+	for i, path := range md.Path {
+		if path, ok := path.(*IngressPathParameter); ok && path.Wildcard && i != len(md.Path)-1 {
+			merr = append(merr, errorf(path, "ingress verb %s: wildcard path parameter %q must be the last path component", n.Name, path.Name))
+		}
+	}
+	return merr
+}
+
+// validateWebSocketIngress checks that a WebSocket ingress verb is a sink
+// receiving builtin.WebSocketMessage.
+//
+// Path parameters are passed to the verb in the message rather than as fields
+// of its body, so are not checked.
+func validateWebSocketIngress(scopes Scopes, n *Verb, md *MetadataIngress) (merr []error) {
+	if md.Method != "" {
+		merr = append(merr, errorf(md, "ingress verb %s: ws ingress can not have a method", n.Name))
+	}
+	data, sym := ResolveTypeAs[*Data](scopes, n.Request)
+	m, _ := sym.Module.Get()
+	if sym == nil || m == nil || m.Name != "builtin" || data.Name != "WebSocketMessage" {
+		merr = append(merr, errorf(n.Request, "ingress verb %s: request type %s must be builtin.WebSocketMessage", n.Name, n.Request))
+	}
+	if _, ok := n.Response.(*Unit); !ok {
+		merr = append(merr, errorf(n.Response, "ingress verb %s: ws ingress verbs can not have a response type", n.Name))
+	}
+	return
+}
+
 // Give a type a human-readable name.
 func typeName(v any) string {
 	return strings.ToLower(reflect.Indirect(reflect.ValueOf(v)).Type().Name())
@@ -174,6 +174,51 @@ func TestValidate(t *testing.T) {
 				"6:10-10: verb can not have multiple instances of ingress",
 			},
 		},
+		{name: "CronSchedules",
+			schema: `
+				module one {
+					verb sydney(Unit) Unit
+						+cron TZ=Australia/Sydney 0 9 * * 1-5
+					verb hourly(Unit) Unit
+						+cron @hourly
+					verb every(Unit) Unit
+						+cron @every 1h30m
+					verb unknownZone(Unit) Unit
+						+cron TZ=Mars/Olympus_Mons 0 9 * * *
+					verb unknownDescriptor(Unit) Unit
+						+cron @fortnightly
+					verb shortInterval(Unit) Unit
+						+cron @every 500ms
+				}
+			`,
+			errs: []string{
+				`10:7-7: verb unknownZone: invalid cron schedule "TZ=Mars/Olympus_Mons 0 9 * * *": unknown time zone "Mars/Olympus_Mons"`,
+				`12:7-7: verb unknownDescriptor: invalid cron schedule "@fortnightly": unknown descriptor "@fortnightly"`,
+				`14:7-7: verb shortInterval: invalid cron schedule "@every 500ms": interval 500ms must be at least 1s`,
+			},
+		},
+		{name: "CronOptions",
+			schema: `
+				module one {
+					verb options(Unit) Unit
+						+cron */5 * * * * concurrency=replace jitter=30s catchup=all:3
+					verb badJitter(Unit) Unit
+						+cron @hourly jitter=30x
+					verb badCatchupLimit(Unit) Unit
+						+cron @hourly catchup=last:3
+					verb longJitter(Unit) Unit
+						+cron @every 1m jitter=5m
+					verb intervalJitter(Unit) Unit
+						+cron */5 * * * * jitter=5m
+				}
+			`,
+			errs: []string{
+				`10:7-7: verb longJitter: cron jitter 5m0s must be less than the interval between executions of 1m0s`,
+				`12:7-7: verb intervalJitter: cron jitter 5m0s must be less than the interval between executions of 5m0s`,
+				`6:7-7: verb badJitter: unable to parse cron jitter "30x" - expected duration in format like '30s' or '5m'`,
+				`8:7-7: verb badCatchupLimit: cron catchup limit can only be used with catchup=all`,
+			},
+		},
 		{name: "CronOnNonEmptyVerb",
 			schema: `
 				module one {
@@ -312,6 +357,164 @@ func TestValidate(t *testing.T) {
 				`6:7-7: verb B: retries can only be added to FSM transitions`,
 			},
 		},
+		{name: "AuthWithoutIngress",
+			schema: `
+				module one {
+					verb A(Empty) Unit
+						+auth jwt
+					export verb B(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /b
+						+auth apikey
+				}
+				`,
+			errs: []string{
+				`4:7-7: verb A: +auth can only be used on HTTP ingress verbs`,
+			},
+		},
+		{name: "Stream",
+			schema: `
+				module one {
+					data Event {}
+					verb A(Empty) Unit
+						+stream
+					export verb B(HttpRequest<Empty>) one.Event
+						+ingress http GET /b
+						+stream
+				}
+				`,
+			errs: []string{
+				`5:7-7: verb A: streaming verbs must have a response type`,
+			},
+		},
+		{name: "CORS",
+			schema: `
+				module one {
+					verb A(Empty) Unit
+						+cors "https://example.com"
+					export verb B(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /b
+						+cors "https://example.com", "https://*.example.com" methods GET, POST headers "Authorization" credentials maxAge 600
+					export verb C(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /c
+						+cors "example.com", "https://example.com/path"
+					export verb D(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /d
+						+cors "*" credentials
+				}
+				`,
+			errs: []string{
+				`10:7-7: verb C: invalid CORS origin "example.com", expected "*" or scheme://host[:port]`,
+				`10:7-7: verb C: invalid CORS origin "https://example.com/path", expected "*" or scheme://host[:port]`,
+				`13:7-7: verb D: CORS credentials can not be allowed for "*"`,
+				`4:7-7: verb A: +cors can only be used on HTTP ingress verbs`,
+			},
+		},
+		{name: "Cache",
+			schema: `
+				module one {
+					export verb A(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /a
+						+cache 5m
+					export verb B(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http POST /b
+						+cache 1h30m
+					export verb C(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /c
+						+auth jwt
+						+cache 10s
+					export verb D(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /d
+						+cache 500ms
+					export verb E(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /e
+						+cache 5y
+				}
+				`,
+			errs: []string{
+				`12:7-7: verb C: authenticated verbs can not be cached`,
+				`15:7-7: verb D: cache TTL must be at least 1s`,
+				`18:7-7: verb E: unable to parse cache TTL "5y" - expected duration in format like '30s' or '1h30m'`,
+				`8:7-7: verb B: +cache can only be used on GET HTTP ingress verbs`,
+			},
+		},
+		{name: "WebSocketIngress",
+			schema: `
+				module one {
+					data Message {}
+					data Room {
+						room String
+					}
+					export verb A(builtin.WebSocketMessage<one.Message>) Unit
+						+ingress ws /chat/{room}
+					export verb B(HttpRequest<Empty>) Unit
+						+ingress ws /b
+					export verb C(builtin.WebSocketMessage<String>) String
+						+ingress ws GET /c
+					export verb D(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http /d
+					export verb E(HttpRequest<one.Room>) HttpResponse<Empty, Empty>
+						+ingress http GET /chat/{room}
+				}
+				`,
+			errs: []string{
+				`11:54-54: ingress verb C: ws ingress verbs can not have a response type`,
+				`12:7-7: ingress verb C: ws ingress can not have a method`,
+				`14:7-7: ingress verb D: http ingress requires a method`,
+				`16:7-7: duplicate http ingress GET /chat/{} for 7:6:"A" and 15:6:"E"`,
+				`9:20-20: ingress verb B: request type HttpRequest<Empty> must be builtin.WebSocketMessage`,
+			},
+		},
+		{name: "IngressRoutes",
+			schema: `
+				module one {
+					data Files {
+						path String
+					}
+					export verb files(HttpRequest<one.Files>) HttpResponse<Empty, Empty>
+						+ingress http GET /files/{path...}
+					export verb file(HttpRequest<one.Files>) HttpResponse<Empty, Empty>
+						+ingress http GET /files/{path}
+					export verb otherFiles(HttpRequest<one.Files>) HttpResponse<Empty, Empty>
+						+ingress http GET /files/{path...} host "files.example.com"
+					export verb dupFiles(HttpRequest<one.Files>) HttpResponse<Empty, Empty>
+						+ingress http GET /files/{other...} host "FILES.example.com"
+					export verb middle(HttpRequest<one.Files>) HttpResponse<Empty, Empty>
+						+ingress http GET /{path...}/middle
+					export verb badHost(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /bad host "example.com:8080"
+					export verb subdomains(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /bad host "*.example.com"
+				}
+				`,
+			errs: []string{
+				`11:7-7: duplicate http ingress GET files.example.com/files/{...} for 12:6:"dupFiles" and 10:6:"otherFiles"`,
+				`13:32-32: ingress verb dupFiles: request type one.Files does not contain a field corresponding to the parameter "other"`,
+				`15:26-26: ingress verb middle: wildcard path parameter "path" must be the last path component`,
+				`17:7-7: ingress verb badHost: invalid host "example.com:8080", expected a hostname optionally prefixed with "*."`,
+			},
+		},
+		{name: "RateLimit",
+			schema: `
+				module one {
+					export verb a(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /a
+						+ratelimit 100/1m by=header:X-Api-Key
+					export verb b(Empty) Empty
+						+ratelimit 10/s by=caller-module
+					export verb c(Empty) Empty
+						+ratelimit 0/1h by=ip
+					export verb d(Empty) Empty
+						+ratelimit 10/100ms
+					export verb e(Empty) Empty
+						+ratelimit 10/fortnight
+				}
+				`,
+			errs: []string{
+				`11:7-7: verb d: rate limit period must be at least 1s`,
+				`13:7-7: verb e: unable to parse rate limit period "fortnight" - expected duration in format like 's', '10s' or '1h'`,
+				`9:7-7: verb c: rate limit must be at least 1`,
+			},
+		},
 		{name: "InvalidRetryDurations",
 			schema: `
 				module one {
@@ -379,6 +582,35 @@ func TestValidate(t *testing.T) {
 				`4:19: unexpected token "s"`,
 			},
 		},
+		{name: "InvalidPubSub",
+			schema: `
+				module payments {
+					export data Invoice { amount Int }
+					export topic invoices payments.Invoice
+					topic private payments.Invoice
+				}
+				module billing {
+					subscription newInvoices payments.invoices
+					subscription internal payments.private
+					subscription notATopic payments.Invoice
+					verb process(payments.Invoice) Unit
+						+subscribe newInvoices
+					verb wrongType(String) Unit
+						+subscribe newInvoices
+					verb missing(payments.Invoice) Unit
+						+subscribe unknown
+					verb notSink(payments.Invoice) String
+						+subscribe newInvoices
+				}
+				`,
+			errs: []string{
+				`10:29-29: subscription "notATopic" must reference a topic but "payments.Invoice" is a data`,
+				`14:7-7: verb wrongType: request type String differs from event type payments.Invoice of topic payments.invoices`,
+				`16:7-7: verb missing: could not find subscription "unknown"`,
+				`18:7-7: verb notSink: subscriber must be a sink but is verb`,
+				`9:28-28: topic "payments.private" must be exported`,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -430,6 +662,24 @@ func TestValidateModuleWithSchema(t *testing.T) {
 				`4:14-14: verb "one.one" must be exported`,
 			},
 		},
+		{name: "IngressConflictWithOtherModule",
+			schema: `
+				module one {
+					export verb users(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /users
+				}
+				`,
+			moduleSchema: `
+				module two {
+					export verb users(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /users
+					export verb hostUsers(HttpRequest<Empty>) HttpResponse<Empty, Empty>
+						+ingress http GET /users host "two.example.com"
+				}`,
+			errs: []string{
+				`4:7-7: duplicate http ingress GET /users for 3:6:"one.users" and 3:6:"users"`,
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
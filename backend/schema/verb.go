@@ -24,7 +24,7 @@ type Verb struct {
 var _ Decl = (*Verb)(nil)
 var _ Symbol = (*Verb)(nil)
 
-// VerbKind is the kind of Verb: verb, sink, source or empty.
+// VerbKind is the kind of Verb: verb, sink, source, empty or stream.
 type VerbKind string
 
 const (
@@ -36,10 +36,15 @@ const (
 	VerbKindSource VerbKind = "source"
 	// VerbKindEmpty is a verb that takes unit and returns unit.
 	VerbKindEmpty VerbKind = "empty"
+	// VerbKindStream is a verb with "+stream" that sends zero or more outputs.
+	VerbKindStream VerbKind = "stream"
 )
 
 // Kind returns the kind of Verb this is.
 func (v *Verb) Kind() VerbKind {
+	if v.GetMetadataStream().Ok() {
+		return VerbKindStream
+	}
 	_, inIsUnit := v.Request.(*Unit)
 	_, outIsUnit := v.Response.(*Unit)
 	switch {
@@ -113,6 +118,69 @@ func (v *Verb) GetMetadataCronJob() optional.Option[*MetadataCronJob] {
 	return optional.None[*MetadataCronJob]()
 }
 
+func (v *Verb) GetMetadataSubscriber() optional.Option[*MetadataSubscriber] {
+	for _, m := range v.Metadata {
+		if m, ok := m.(*MetadataSubscriber); ok {
+			return optional.Some(m)
+		}
+	}
+	return optional.None[*MetadataSubscriber]()
+}
+
+func (v *Verb) GetMetadataRetry() optional.Option[*MetadataRetry] {
+	for _, m := range v.Metadata {
+		if m, ok := m.(*MetadataRetry); ok {
+			return optional.Some(m)
+		}
+	}
+	return optional.None[*MetadataRetry]()
+}
+
+func (v *Verb) GetMetadataAuth() optional.Option[*MetadataAuth] {
+	for _, m := range v.Metadata {
+		if m, ok := m.(*MetadataAuth); ok {
+			return optional.Some(m)
+		}
+	}
+	return optional.None[*MetadataAuth]()
+}
+
+func (v *Verb) GetMetadataStream() optional.Option[*MetadataStream] {
+	for _, m := range v.Metadata {
+		if m, ok := m.(*MetadataStream); ok {
+			return optional.Some(m)
+		}
+	}
+	return optional.None[*MetadataStream]()
+}
+
+func (v *Verb) GetMetadataCORS() optional.Option[*MetadataCORS] {
+	for _, m := range v.Metadata {
+		if m, ok := m.(*MetadataCORS); ok {
+			return optional.Some(m)
+		}
+	}
+	return optional.None[*MetadataCORS]()
+}
+
+func (v *Verb) GetMetadataCache() optional.Option[*MetadataCache] {
+	for _, m := range v.Metadata {
+		if m, ok := m.(*MetadataCache); ok {
+			return optional.Some(m)
+		}
+	}
+	return optional.None[*MetadataCache]()
+}
+
+func (v *Verb) GetMetadataRateLimit() optional.Option[*MetadataRateLimit] {
+	for _, m := range v.Metadata {
+		if m, ok := m.(*MetadataRateLimit); ok {
+			return optional.Some(m)
+		}
+	}
+	return optional.None[*MetadataRateLimit]()
+}
+
 func (v *Verb) ToProto() proto.Message {
 	return &schemapb.Verb{
 		Pos:      posToProto(v.Pos),
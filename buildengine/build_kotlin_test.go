@@ -264,6 +264,17 @@ data class HttpRequest<Body>(
   val query: Map<String, List<String>>,
   val headers: Map<String, List<String>>,
   val body: Body,
+  val auth: HttpAuth? = null,
+)
+
+/**
+ * Authenticated caller of an HTTP ingress verb.
+ */
+@Data
+data class HttpAuth(
+  val method: String,
+  val subject: String,
+  val claims: Map<String, Any>,
 )
 
 /**
@@ -277,6 +288,50 @@ data class HttpResponse<Body, Error>(
   val error: Error? = null,
 )
 
+/**
+ * A file uploaded in a "multipart/form-data" HTTP request body.
+ */
+@Data
+data class File(
+  val filename: String,
+  val contentType: String,
+  val content: ByteArray,
+)
+
+/**
+ * RFC 7807 problem details returned by HTTP ingress for errors that occur
+ * outside of the verb, with the content type "application/problem+json".
+ */
+@Data
+data class HttpProblem(
+  val type: String,
+  val title: String,
+  val status: Long,
+  val detail: String? = null,
+  val instance: String? = null,
+  val requestKey: String? = null,
+  val invalidFields: List<HttpProblemField>? = null,
+)
+
+/**
+ * A field of an HTTP request that failed validation.
+ */
+@Data
+data class HttpProblemField(
+  val path: String,
+  val detail: String,
+)
+
+/**
+ * A message received on a WebSocket ingress connection.
+ */
+@Data
+data class WebSocketMessage<Body>(
+  val connectionId: String,
+  val pathParameters: Map<String, String>,
+  val body: Body,
+)
+
 @Data
 class Empty
 `
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
 	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -35,7 +36,10 @@ type DeployClient interface {
 }
 
 // Deploy a module to the FTL controller with the given number of replicas. Optionally wait for the deployment to become ready.
-func Deploy(ctx context.Context, module Module, replicas int32, waitForDeployOnline bool, client DeployClient) error {
+//
+// If [canaryWeight] is set the module is deployed as a canary receiving that
+// percentage of the module's traffic.
+func Deploy(ctx context.Context, module Module, replicas int32, canaryWeight optional.Option[int32], waitForDeployOnline bool, client DeployClient) error {
 	logger := log.FromContext(ctx).Scope(module.Module)
 	ctx = log.ContextWithLogger(ctx, logger)
 	logger.Infof("Deploying module")
@@ -89,7 +93,12 @@ func Deploy(ctx context.Context, module Module, replicas int32, waitForDeployOnl
 		return err
 	}
 
-	_, err = client.ReplaceDeploy(ctx, connect.NewRequest(&ftlv1.ReplaceDeployRequest{DeploymentKey: resp.Msg.GetDeploymentKey(), MinReplicas: replicas}))
+	replaceReq := &ftlv1.ReplaceDeployRequest{DeploymentKey: resp.Msg.GetDeploymentKey(), MinReplicas: replicas}
+	if weight, ok := canaryWeight.Get(); ok {
+		logger.Infof("Deploying as canary with %d%% of traffic", weight)
+		replaceReq.CanaryWeight = &weight
+	}
+	_, err = client.ReplaceDeploy(ctx, connect.NewRequest(replaceReq))
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/schema"
@@ -83,6 +84,6 @@ func TestDeploy(t *testing.T) {
 		DeploymentKey:  "test-deployment",
 	}
 
-	err = Deploy(ctx, module, int32(1), true, client)
+	err = Deploy(ctx, module, int32(1), optional.None[int32](), true, client)
 	assert.NoError(t, err)
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
 	"github.com/alecthomas/types/pubsub"
 	"github.com/jpillora/backoff"
 	"github.com/puzpuzpuz/xsync/v3"
@@ -58,6 +59,7 @@ type Engine struct {
 	parallelism      int
 	listener         Listener
 	projectsToBuild  *xsync.MapOf[ProjectKey, bool]
+	canaryWeight     optional.Option[int32]
 }
 
 type Option func(o *Engine)
@@ -68,6 +70,14 @@ func Parallelism(n int) Option {
 	}
 }
 
+// Canary deploys modules as canaries receiving [weight] percent of each
+// module's traffic, rather than replacing the existing deployments.
+func Canary(weight int32) Option {
+	return func(o *Engine) {
+		o.canaryWeight = optional.Some(weight)
+	}
+}
+
 // WithListener sets the event listener for the Engine.
 func WithListener(listener Listener) Option {
 	return func(o *Engine) {
@@ -394,7 +404,7 @@ func (e *Engine) buildAndDeploy(ctx context.Context, replicas int32, waitForDepl
 					// Skip deploying external libraries
 					return nil
 				}
-				return Deploy(buildCtx, module, replicas, waitForDeployOnline, e.client)
+				return Deploy(buildCtx, module, replicas, e.canaryWeight, waitForDeployOnline, e.client)
 			})
 			return nil
 		}, projects...)
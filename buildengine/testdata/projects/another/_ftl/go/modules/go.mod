@@ -0,0 +1,6 @@
+module ftl
+
+go 1.22.2
+
+
+replace github.com/TBD54566975/ftl => /root/module
\ No newline at end of file
@@ -22,6 +22,7 @@ var cli struct {
 	ObservabilityConfig observability.Config `embed:"" prefix:"o11y-"`
 	LogConfig           log.Config           `embed:"" prefix:"log-"`
 	ControllerConfig    controller.Config    `embed:""`
+	K8sConfig           scaling.K8sConfig    `embed:"" prefix:"k8s-"`
 }
 
 func main() {
@@ -38,6 +39,9 @@ func main() {
 	err = observability.Init(ctx, "ftl-controller", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialize observability")
 
-	err = controller.Start(ctx, cli.ControllerConfig, scaling.NewK8sScaling())
+	runnerScaling, err := scaling.NewK8sScaling(cli.K8sConfig)
+	kctx.FatalIfErrorf(err, "failed to initialize Kubernetes runner scaling")
+
+	err = controller.Start(ctx, cli.ControllerConfig, runnerScaling)
 	kctx.FatalIfErrorf(err)
 }
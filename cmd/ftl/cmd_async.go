@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang/protobuf/jsonpb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+type asyncCmd struct {
+	Ls      asyncLsCmd      `cmd:"" help:"List failed async calls."`
+	Show    asyncShowCmd    `cmd:"" help:"Show an async call, including its request and error."`
+	Retry   asyncRetryCmd   `cmd:"" help:"Requeue a failed async call for execution."`
+	Discard asyncDiscardCmd `cmd:"" help:"Discard a failed async call."`
+}
+
+type asyncLsCmd struct {
+	Origin string `help:"Only list calls with this origin." enum:"cron,fsm,pubsub," default:""`
+	JSON   bool   `help:"Output JSON."`
+}
+
+func (a *asyncLsCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.ListFailedAsyncCallsRequest{}
+	if a.Origin != "" {
+		req.Origin = &a.Origin
+	}
+	resp, err := client.ListFailedAsyncCalls(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if a.JSON {
+		marshaller := jsonpb.Marshaler{Indent: "  "}
+		for _, call := range resp.Msg.Calls {
+			if err := marshaller.Marshal(os.Stdout, call); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+	format := "%-8s %-8s %-40s %-30s %-20s %s\n"
+	fmt.Printf(format, "ID", "ORIGIN", "ORIGIN-KEY", "VERB", "CREATED", "ERROR")
+	for _, call := range resp.Msg.Calls {
+		fmt.Printf(format,
+			fmt.Sprint(call.Id),
+			call.Origin,
+			call.OriginKey,
+			schema.RefFromProto(call.Verb),
+			call.CreatedAt.AsTime().Local().Format(time.DateTime),
+			call.GetError(),
+		)
+	}
+	return nil
+}
+
+type asyncShowCmd struct {
+	ID int64 `arg:"" help:"ID of the async call."`
+}
+
+func (a *asyncShowCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetAsyncCall(ctx, connect.NewRequest(&ftlv1.GetAsyncCallRequest{Id: a.ID}))
+	if err != nil {
+		return err
+	}
+	call := resp.Msg.Call
+	fmt.Printf("ID:          %d\n", call.Id)
+	fmt.Printf("State:       %s\n", call.State)
+	fmt.Printf("Origin:      %s %s\n", call.Origin, call.OriginKey)
+	fmt.Printf("Verb:        %s\n", schema.RefFromProto(call.Verb))
+	fmt.Printf("Created:     %s\n", call.CreatedAt.AsTime().Local().Format(time.DateTime))
+	fmt.Printf("Scheduled:   %s\n", call.ScheduledAt.AsTime().Local().Format(time.DateTime))
+	fmt.Printf("Retries:     %d\n", call.RetryAttempt)
+	fmt.Printf("Request:     %s\n", call.Request)
+	if call.Response != nil {
+		fmt.Printf("Response:    %s\n", call.Response)
+	}
+	if call.Error != nil {
+		fmt.Printf("Error:       %s\n", *call.Error)
+	}
+	return nil
+}
+
+type asyncRetryCmd struct {
+	ID int64 `arg:"" help:"ID of the failed async call."`
+}
+
+func (a *asyncRetryCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.RequeueAsyncCall(ctx, connect.NewRequest(&ftlv1.RequeueAsyncCallRequest{Id: a.ID}))
+	return err
+}
+
+type asyncDiscardCmd struct {
+	ID int64 `arg:"" help:"ID of the failed async call."`
+}
+
+func (a *asyncDiscardCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.DiscardAsyncCall(ctx, connect.NewRequest(&ftlv1.DiscardAsyncCallRequest{Id: a.ID}))
+	return err
+}
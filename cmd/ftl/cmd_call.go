@@ -25,9 +25,11 @@ type callCmd struct {
 	Wait    time.Duration  `short:"w" help:"Wait up to this elapsed time for the FTL cluster to become available." default:"1m"`
 	Verb    reflection.Ref `arg:"" required:"" help:"Full path of Verb to call."`
 	Request string         `arg:"" optional:"" help:"JSON5 request payload." default:"{}"`
+	Stream  bool           `help:"Call a streaming verb, printing each response as it is received."`
 }
 
 func (c *callCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient, ctlCli ftlv1connect.ControllerServiceClient) error {
+	streamCtx := ctx
 	ctx, cancel := context.WithTimeout(ctx, c.Wait)
 	defer cancel()
 	if err := rpc.Wait(ctx, backoff.Backoff{Max: time.Second * 2}, client); err != nil {
@@ -47,6 +49,11 @@ func (c *callCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient
 
 	logger.Debugf("Calling %s", c.Verb)
 
+	if c.Stream {
+		// Streams are not subject to the wait timeout.
+		return c.callStream(streamCtx, client, requestJSON)
+	}
+
 	// otherwise, we have a match so call the verb
 	resp, err := client.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{
 		Verb: c.Verb.ToProto(),
@@ -77,6 +84,31 @@ func (c *callCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient
 	return nil
 }
 
+func (c *callCmd) callStream(ctx context.Context, client ftlv1connect.VerbServiceClient, requestJSON []byte) error {
+	logger := log.FromContext(ctx)
+	stream, err := client.ServerStream(ctx, connect.NewRequest(&ftlv1.CallRequest{
+		Verb: c.Verb.ToProto(),
+		Body: requestJSON,
+	}))
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	for stream.Receive() {
+		switch resp := stream.Msg().Response.(type) {
+		case *ftlv1.CallResponse_Error_:
+			if resp.Error.Stack != nil && logger.GetLevel() <= log.Debug {
+				fmt.Println(*resp.Error.Stack)
+			}
+			return fmt.Errorf("verb error: %s", resp.Error.Message)
+
+		case *ftlv1.CallResponse_Body:
+			fmt.Println(string(resp.Body))
+		}
+	}
+	return stream.Err()
+}
+
 // findSuggestions looks up the schema and finds verbs that are similar to the one that was not found
 // it uses the levenshtein distance to determine similarity - if the distance is less than 40% of the length of the verb,
 // it returns an error if no closely matching suggestions are found
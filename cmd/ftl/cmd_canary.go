@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+type canaryCmd struct {
+	Promote canaryPromoteCmd `cmd:"" help:"Promote a canary deployment, replacing the existing deployment of its module."`
+	Abort   canaryAbortCmd   `cmd:"" help:"Abort a canary deployment, routing all traffic back to the existing deployment of its module."`
+}
+
+type canaryPromoteCmd struct {
+	Deployment model.DeploymentKey `arg:"" help:"Canary deployment to promote."`
+}
+
+func (c *canaryPromoteCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.PromoteCanary(ctx, connect.NewRequest(&ftlv1.PromoteCanaryRequest{DeploymentKey: c.Deployment.String()}))
+	return err
+}
+
+type canaryAbortCmd struct {
+	Deployment model.DeploymentKey `arg:"" help:"Canary deployment to abort."`
+}
+
+func (c *canaryAbortCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.AbortCanary(ctx, connect.NewRequest(&ftlv1.AbortCanaryRequest{DeploymentKey: c.Deployment.String()}))
+	return err
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang/protobuf/jsonpb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type cronCmd struct {
+	Ls      cronLsCmd      `cmd:"" help:"List cron jobs."`
+	Pause   cronPauseCmd   `cmd:"" help:"Pause a cron job."`
+	Resume  cronResumeCmd  `cmd:"" help:"Resume a paused cron job, skipping executions missed while paused."`
+	Trigger cronTriggerCmd `cmd:"" help:"Execute a cron job now and wait for it to finish."`
+	History cronHistoryCmd `cmd:"" help:"Show the most recent executions of a cron job."`
+}
+
+type cronLsCmd struct {
+	JSON bool `help:"Output JSON."`
+}
+
+func (c *cronLsCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.ListCronJobs(ctx, connect.NewRequest(&ftlv1.ListCronJobsRequest{}))
+	if err != nil {
+		return err
+	}
+	if c.JSON {
+		marshaller := jsonpb.Marshaler{Indent: "  "}
+		for _, job := range resp.Msg.Jobs {
+			if err := marshaller.Marshal(os.Stdout, job); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+	format := "%-30s %-24s %-10s %-20s %s\n"
+	fmt.Printf(format, "VERB", "SCHEDULE", "STATE", "NEXT", "DEPLOYMENT")
+	for _, job := range resp.Msg.Jobs {
+		state := job.State
+		next := job.NextExecution.AsTime().Local().Format(time.DateTime)
+		if job.Paused {
+			state = "paused"
+			next = "-"
+		}
+		fmt.Printf(format,
+			schema.RefFromProto(job.Verb),
+			job.Schedule,
+			state,
+			next,
+			job.DeploymentKey,
+		)
+	}
+	return nil
+}
+
+type cronPauseCmd struct {
+	Verb reflection.Ref `arg:"" required:"" help:"Full path of the cron job's verb."`
+}
+
+func (c *cronPauseCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.PauseCronJob(ctx, connect.NewRequest(&ftlv1.PauseCronJobRequest{Verb: c.Verb.ToProto()}))
+	return err
+}
+
+type cronResumeCmd struct {
+	Verb reflection.Ref `arg:"" required:"" help:"Full path of the cron job's verb."`
+}
+
+func (c *cronResumeCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.ResumeCronJob(ctx, connect.NewRequest(&ftlv1.ResumeCronJobRequest{Verb: c.Verb.ToProto()}))
+	return err
+}
+
+type cronTriggerCmd struct {
+	Verb reflection.Ref `arg:"" required:"" help:"Full path of the cron job's verb."`
+}
+
+func (c *cronTriggerCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.TriggerCronJob(ctx, connect.NewRequest(&ftlv1.TriggerCronJobRequest{Verb: c.Verb.ToProto()}))
+	return err
+}
+
+type cronHistoryCmd struct {
+	Verb  reflection.Ref `arg:"" required:"" help:"Full path of the cron job's verb."`
+	Limit int32          `short:"n" help:"Number of executions to show." default:"10"`
+}
+
+func (c *cronHistoryCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetCronJobHistory(ctx, connect.NewRequest(&ftlv1.GetCronJobHistoryRequest{
+		Verb:  c.Verb.ToProto(),
+		Limit: c.Limit,
+	}))
+	if err != nil {
+		return err
+	}
+	format := "%-20s %-12s %-50s %s\n"
+	fmt.Printf(format, "STARTED", "DURATION", "REQUEST", "ERROR")
+	for _, execution := range resp.Msg.Executions {
+		fmt.Printf(format,
+			execution.TimeStamp.AsTime().Local().Format(time.DateTime),
+			execution.Duration.AsDuration().Round(time.Millisecond),
+			execution.RequestKey,
+			execution.GetError(),
+		)
+	}
+	return nil
+}
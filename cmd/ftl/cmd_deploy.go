@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/TBD54566975/ftl/buildengine"
@@ -9,17 +12,38 @@ import (
 )
 
 type deployCmd struct {
-	Parallelism int      `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
-	Replicas    int32    `short:"n" help:"Number of replicas to deploy." default:"1"`
-	Dirs        []string `arg:"" help:"Base directories containing modules." type:"existingdir" required:""`
-	NoWait      bool     `help:"Do not wait for deployment to complete." default:"false"`
+	Parallelism int        `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
+	Replicas    int32      `short:"n" help:"Number of replicas to deploy." default:"1"`
+	Canary      percentage `help:"Deploy as a canary receiving this percentage of each module's traffic, eg. 10%." placeholder:"PERCENT"`
+	Dirs        []string   `arg:"" help:"Base directories containing modules." type:"existingdir" required:""`
+	NoWait      bool       `help:"Do not wait for deployment to complete." default:"false"`
 }
 
 func (d *deployCmd) Run(ctx context.Context) error {
 	client := rpc.ClientFromContext[ftlv1connect.ControllerServiceClient](ctx)
-	engine, err := buildengine.New(ctx, client, d.Dirs, []string{}, buildengine.Parallelism(d.Parallelism))
+	options := []buildengine.Option{buildengine.Parallelism(d.Parallelism)}
+	if d.Canary != 0 {
+		options = append(options, buildengine.Canary(int32(d.Canary)))
+	}
+	engine, err := buildengine.New(ctx, client, d.Dirs, []string{}, options...)
 	if err != nil {
 		return err
 	}
 	return engine.Deploy(ctx, d.Replicas, !d.NoWait)
 }
+
+// percentage is a traffic percentage between 1 and 99, optionally suffixed
+// with "%".
+type percentage int32
+
+func (p *percentage) UnmarshalText(text []byte) error {
+	n, err := strconv.ParseInt(strings.TrimSuffix(string(text), "%"), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid percentage %q", text)
+	}
+	if n < 1 || n > 99 {
+		return fmt.Errorf("percentage must be between 1%% and 99%%, not %d%%", n)
+	}
+	*p = percentage(n)
+	return nil
+}
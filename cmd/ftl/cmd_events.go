@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+)
+
+type eventsCmd struct {
+	Import eventsImportCmd `cmd:"" help:"Import archives of expired events written by the controller, so that they can be queried again."`
+}
+
+type eventsImportCmd struct {
+	Archives []string `arg:"" help:"Event archives to import." type:"existingfile"`
+}
+
+func (e *eventsImportCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	for _, path := range e.Archives {
+		archive, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		resp, err := client.ImportEvents(ctx, connect.NewRequest(&ftlv1.ImportEventsRequest{Archive: archive}))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		fmt.Printf("%s: imported %d events\n", path, resp.Msg.Imported)
+	}
+	return nil
+}
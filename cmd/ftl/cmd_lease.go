@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/mattn/go-isatty"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+)
+
+type leaseCmd struct {
+	Ls      leaseLsCmd      `cmd:"" help:"List held leases."`
+	Release leaseReleaseCmd `cmd:"" help:"Forcibly release a lease, regardless of who holds it."`
+}
+
+type leaseLsCmd struct {
+	Module string `help:"Only list leases held by this module."`
+	JSON   bool   `help:"Output JSON."`
+}
+
+func (l *leaseLsCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.ListLeasesRequest{}
+	if l.Module != "" {
+		req.Module = &l.Module
+	}
+	resp, err := client.ListLeases(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if l.JSON {
+		marshaller := jsonpb.Marshaler{Indent: "  "}
+		for _, lease := range resp.Msg.Leases {
+			if err := marshaller.Marshal(os.Stdout, lease); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+	now := time.Now()
+	format := "%-50s %-20s %-30s %-8s %-12s %s\n"
+	fmt.Printf(format, "KEY", "MODULE", "USER-KEY", "TOKEN", "HELD-FOR", "EXPIRES-IN")
+	for _, lease := range resp.Msg.Leases {
+		fmt.Printf(format,
+			lease.Key,
+			lease.GetModule(),
+			strings.Join(lease.UserKey, "/"),
+			fmt.Sprint(lease.FencingToken),
+			now.Sub(lease.CreatedAt.AsTime()).Round(time.Second),
+			lease.ExpiresAt.AsTime().Sub(now).Round(time.Second),
+		)
+	}
+	return nil
+}
+
+type leaseReleaseCmd struct {
+	Key   string `arg:"" help:"Key of the lease, as listed by 'ftl lease ls'."`
+	Force bool   `short:"f" help:"Release the lease without asking for confirmation."`
+}
+
+func (l *leaseReleaseCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	if !l.Force {
+		if !isatty.IsTerminal(0) {
+			return errors.New("refusing to release lease without confirmation, use --force")
+		}
+		fmt.Printf("Releasing %s may allow it to be held by more than one process at once. Continue? [y/N] ", l.Key)
+		answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read confirmation: %w", err)
+		}
+		if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+			return errors.New("lease not released")
+		}
+	}
+	_, err := client.ReleaseLease(ctx, connect.NewRequest(&ftlv1.ReleaseLeaseRequest{Key: l.Key}))
+	return err
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	pbconsole "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console/pbconsoleconnect"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+type replayCmd struct {
+	Request    model.RequestKey     `arg:"" help:"Request to replay."`
+	Deployment *model.DeploymentKey `help:"Deployment to replay the request against. Defaults to the current deployment of the verb's module."`
+}
+
+func (r *replayCmd) Run(ctx context.Context, client pbconsoleconnect.ConsoleServiceClient) error {
+	req := &pbconsole.ReplayRequest{RequestKey: r.Request.String()}
+	if r.Deployment != nil {
+		key := r.Deployment.String()
+		req.DeploymentKey = &key
+	}
+	resp, err := client.Replay(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	verb := resp.Msg.Original.DestinationVerbRef
+	fmt.Printf("Replayed %s.%s from %s as %s\n", verb.Module, verb.Name, r.Request, resp.Msg.RequestKey)
+	if resp.Msg.Diff == "" {
+		fmt.Println("Response unchanged")
+		return nil
+	}
+	fmt.Print(resp.Msg.Diff)
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+type rollbackCmd struct {
+	Module     string               `arg:"" help:"Module to roll back."`
+	Deployment *model.DeploymentKey `arg:"" optional:"" help:"Deployment to roll back to. Defaults to the deployment preceding the active one."`
+}
+
+func (r *rollbackCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.ListDeployments(ctx, connect.NewRequest(&ftlv1.ListDeploymentsRequest{Module: r.Module}))
+	if err != nil {
+		return err
+	}
+	deployments := resp.Msg.Deployments
+
+	var active *ftlv1.ListDeploymentsResponse_Deployment
+	activeIndex := -1
+	for i, deployment := range deployments {
+		if deployment.MinReplicas > 0 && deployment.CanaryWeight == nil {
+			active = deployment
+			activeIndex = i
+			break
+		}
+	}
+
+	var target *ftlv1.ListDeploymentsResponse_Deployment
+	if key := r.Deployment; key != nil {
+		for _, deployment := range deployments {
+			if deployment.Key == key.String() {
+				target = deployment
+				break
+			}
+		}
+		if target == nil {
+			return fmt.Errorf("deployment %s is not a deployment of module %q", key, r.Module)
+		}
+	} else {
+		// Deployments are ordered most recent first, so the previous
+		// deployment is the first one older than the active deployment that
+		// was ever live.
+		for _, deployment := range deployments[activeIndex+1:] {
+			if wasLive(deployment) {
+				target = deployment
+				break
+			}
+		}
+		if target == nil {
+			return errors.New("no previous deployment to roll back to")
+		}
+	}
+	if active != nil && active.Key == target.Key {
+		return fmt.Errorf("deployment %s is already active", target.Key)
+	}
+
+	replicas := int32(1)
+	if active != nil {
+		replicas = active.MinReplicas
+	} else if last := lastLiveReplicas(target); last > 0 {
+		replicas = last
+	}
+
+	_, err = client.ReplaceDeploy(ctx, connect.NewRequest(&ftlv1.ReplaceDeployRequest{DeploymentKey: target.Key, MinReplicas: replicas}))
+	if err != nil {
+		return err
+	}
+	if active != nil {
+		fmt.Printf("Rolled back %s from %s to %s\n", r.Module, active.Key, target.Key)
+	} else {
+		fmt.Printf("Rolled back %s to %s\n", r.Module, target.Key)
+	}
+	return nil
+}
+
+func wasLive(deployment *ftlv1.ListDeploymentsResponse_Deployment) bool {
+	return lastLiveReplicas(deployment) > 0
+}
+
+// lastLiveReplicas returns the most recent non-zero min_replicas of a
+// deployment, or 0 if it was never live.
+func lastLiveReplicas(deployment *ftlv1.ListDeploymentsResponse_Deployment) int32 {
+	for i := len(deployment.MinReplicasHistory) - 1; i >= 0; i-- {
+		if replicas := deployment.MinReplicasHistory[i].MinReplicas; replicas > 0 {
+			return replicas
+		}
+	}
+	return 0
+}
@@ -5,4 +5,5 @@ type schemaCmd struct {
 	Protobuf schemaProtobufCmd `cmd:"" help:"Generate protobuf schema mirroring the FTL schema structure."`
 	Generate schemaGenerateCmd `cmd:"" help:"Stream the schema from the cluster and generate files from the template."`
 	Import   schemaImportCmd   `cmd:"" help:"Import messages to the FTL schema."`
+	OpenAPI  schemaOpenAPICmd  `cmd:"" name:"openapi" help:"Generate an OpenAPI 3.1 document describing all HTTP ingress verbs."`
 }
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+type schemaOpenAPICmd struct {
+	Server *url.URL `help:"Base URL of the HTTP ingress server to describe. Only routes served to its host are included (defaults to the FTL endpoint)."`
+}
+
+func (s *schemaOpenAPICmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetSchema(ctx, connect.NewRequest(&ftlv1.GetSchemaRequest{}))
+	if err != nil {
+		return err
+	}
+	sch, err := schema.FromProto(resp.Msg.Schema)
+	if err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
+	}
+	server := s.Server
+	if server == nil {
+		server = cli.Endpoint.JoinPath("ingress")
+	}
+	doc, err := schema.OpenAPI(sch, server)
+	if err != nil {
+		return fmt.Errorf("failed to generate OpenAPI document: %w", err)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
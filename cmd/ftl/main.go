@@ -13,6 +13,7 @@ import (
 	kongtoml "github.com/alecthomas/kong-toml"
 
 	"github.com/TBD54566975/ftl"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console/pbconsoleconnect"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	cf "github.com/TBD54566975/ftl/common/configuration"
 	"github.com/TBD54566975/ftl/common/projectconfig"
@@ -38,12 +39,19 @@ type CLI struct {
 	Call     callCmd     `cmd:"" help:"Call an FTL function."`
 	Update   updateCmd   `cmd:"" help:"Update a deployment."`
 	Kill     killCmd     `cmd:"" help:"Kill a deployment."`
+	Rollback rollbackCmd `cmd:"" help:"Roll a module back to a previous deployment, without rebuilding it."`
+	Replay   replayCmd   `cmd:"" help:"Replay a recorded request and diff the new response against the recorded one. HTTP ingress requests are re-issued as HTTP requests."`
 	Schema   schemaCmd   `cmd:"" help:"FTL schema commands."`
 	Build    buildCmd    `cmd:"" help:"Build all modules found in the specified directories."`
 	Deploy   deployCmd   `cmd:"" help:"Build and deploy all modules found in the specified directories."`
 	Download downloadCmd `cmd:"" help:"Download a deployment."`
 	Secret   secretCmd   `cmd:"" help:"Manage secrets."`
 	Config   configCmd   `cmd:"" help:"Manage configuration."`
+	Async    asyncCmd    `cmd:"" help:"Manage async calls."`
+	Canary   canaryCmd   `cmd:"" help:"Manage canary deployments."`
+	Events   eventsCmd   `cmd:"" help:"Manage events."`
+	Cron     cronCmd     `cmd:"" help:"Manage cron jobs."`
+	Lease    leaseCmd    `cmd:"" help:"Manage leases."`
 }
 
 var cli CLI
@@ -123,6 +131,9 @@ func main() {
 	ctx = rpc.ContextWithClient(ctx, verbServiceClient)
 	kctx.BindTo(verbServiceClient, (*ftlv1connect.VerbServiceClient)(nil))
 
+	consoleServiceClient := rpc.Dial(pbconsoleconnect.NewConsoleServiceClient, cli.Endpoint.String(), log.Error)
+	kctx.BindTo(consoleServiceClient, (*pbconsoleconnect.ConsoleServiceClient)(nil))
+
 	kctx.Bind(cli.Endpoint)
 	kctx.BindTo(ctx, (*context.Context)(nil))
 
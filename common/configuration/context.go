@@ -1,6 +1,10 @@
 package configuration
 
-import "context"
+import (
+	"context"
+
+	"github.com/alecthomas/types/optional"
+)
 
 type contextKeySecrets struct{}
 
@@ -14,13 +18,20 @@ func ContextWithSecrets(ctx context.Context, secretsManager *Manager[Secrets]) c
 // SecretsFromContext retrieves the secrets configuration.Manager previously
 // added to the context with [ContextWithConfig].
 func SecretsFromContext(ctx context.Context) *Manager[Secrets] {
-	s, ok := ctx.Value(contextKeySecrets{}).(*Manager[Secrets])
+	s, ok := MaybeSecretsFromContext(ctx).Get()
 	if !ok {
 		panic("no secrets manager in context")
 	}
 	return s
 }
 
+// MaybeSecretsFromContext is like [SecretsFromContext], but returns None if
+// there is no secrets manager in the context.
+func MaybeSecretsFromContext(ctx context.Context) optional.Option[*Manager[Secrets]] {
+	s, ok := ctx.Value(contextKeySecrets{}).(*Manager[Secrets])
+	return optional.From(s, ok)
+}
+
 // ContextWithConfig adds a configuration manager to the given context.
 func ContextWithConfig(ctx context.Context, configManager *Manager[Configuration]) context.Context {
 	return context.WithValue(ctx, contextKeyConfig{}, configManager)
@@ -29,9 +40,16 @@ func ContextWithConfig(ctx context.Context, configManager *Manager[Configuration
 // ConfigFromContext retrieves the configuration.Manager previously added to the
 // context with [ContextWithConfig].
 func ConfigFromContext(ctx context.Context) *Manager[Configuration] {
-	m, ok := ctx.Value(contextKeyConfig{}).(*Manager[Configuration])
+	m, ok := MaybeConfigFromContext(ctx).Get()
 	if !ok {
 		panic("no configuration manager in context")
 	}
 	return m
 }
+
+// MaybeConfigFromContext is like [ConfigFromContext], but returns None if
+// there is no configuration manager in the context.
+func MaybeConfigFromContext(ctx context.Context) optional.Option[*Manager[Configuration]] {
+	m, ok := ctx.Value(contextKeyConfig{}).(*Manager[Configuration])
+	return optional.From(m, ok)
+}
@@ -5,7 +5,7 @@
 
 import { PingRequest, PingResponse } from "../ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
-import { EventsQuery, GetEventsResponse, GetModulesRequest, GetModulesResponse, StreamEventsRequest, StreamEventsResponse } from "./console_pb.js";
+import { EventsQuery, GetEventsResponse, GetModulesRequest, GetModulesResponse, ReplayRequest, ReplayResponse, StreamEventsRequest, StreamEventsResponse } from "./console_pb.js";
 
 /**
  * @generated from service xyz.block.ftl.v1.console.ConsoleService
@@ -52,6 +52,20 @@ export const ConsoleService = {
       O: GetEventsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Replay a recorded request, re-issuing its original call.
+     *
+     * Requests received through HTTP ingress are re-issued as HTTP requests, and
+     * their HTTP responses are diffed.
+     *
+     * @generated from rpc xyz.block.ftl.v1.console.ConsoleService.Replay
+     */
+    replay: {
+      name: "Replay",
+      I: ReplayRequest,
+      O: ReplayResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
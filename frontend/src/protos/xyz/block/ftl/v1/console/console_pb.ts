@@ -1470,3 +1470,119 @@ export class GetEventsResponse extends Message<GetEventsResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.console.ReplayRequest
+ */
+export class ReplayRequest extends Message<ReplayRequest> {
+  /**
+   * Request to replay.
+   *
+   * @generated from field: string request_key = 1;
+   */
+  requestKey = "";
+
+  /**
+   * Deployment to replay the request against. Defaults to the current
+   * deployment of the verb's module.
+   *
+   * @generated from field: optional string deployment_key = 2;
+   */
+  deploymentKey?: string;
+
+  constructor(data?: PartialMessage<ReplayRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.ReplayRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayRequest {
+    return new ReplayRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayRequest {
+    return new ReplayRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayRequest {
+    return new ReplayRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReplayRequest | PlainMessage<ReplayRequest> | undefined, b: ReplayRequest | PlainMessage<ReplayRequest> | undefined): boolean {
+    return proto3.util.equals(ReplayRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.console.ReplayResponse
+ */
+export class ReplayResponse extends Message<ReplayResponse> {
+  /**
+   * Key of the new request created by the replay.
+   *
+   * @generated from field: string request_key = 1;
+   */
+  requestKey = "";
+
+  /**
+   * The originally recorded call.
+   *
+   * @generated from field: xyz.block.ftl.v1.console.CallEvent original = 2;
+   */
+  original?: CallEvent;
+
+  /**
+   * @generated from field: string response = 3;
+   */
+  response = "";
+
+  /**
+   * @generated from field: optional string error = 4;
+   */
+  error?: string;
+
+  /**
+   * Unified diff of the original response against the replayed response.
+   * Empty if they are identical.
+   *
+   * @generated from field: string diff = 5;
+   */
+  diff = "";
+
+  constructor(data?: PartialMessage<ReplayResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.ReplayResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "original", kind: "message", T: CallEvent },
+    { no: 3, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "diff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayResponse {
+    return new ReplayResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayResponse {
+    return new ReplayResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayResponse {
+    return new ReplayResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReplayResponse | PlainMessage<ReplayResponse> | undefined, b: ReplayResponse | PlainMessage<ReplayResponse> | undefined): boolean {
+    return proto3.util.equals(ReplayResponse, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AcquireLeaseRequest, AcquireLeaseResponse, CallRequest, CallResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeployRequest, DeployResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, GetSchemaRequest, GetSchemaResponse, ModuleContextRequest, ModuleContextResponse, PingRequest, PingResponse, ProcessListRequest, ProcessListResponse, PullSchemaRequest, PullSchemaResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, ReserveRequest, ReserveResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, TerminateRequest, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./ftl_pb.js";
+import { AbortCanaryRequest, AbortCanaryResponse, AcquireLeaseRequest, AcquireLeaseResponse, CallRequest, CallResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeployRequest, DeployResponse, DiscardAsyncCallRequest, DiscardAsyncCallResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetAsyncCallRequest, GetAsyncCallResponse, GetCronJobHistoryRequest, GetCronJobHistoryResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, GetSchemaRequest, GetSchemaResponse, ImportEventsRequest, ImportEventsResponse, ListCronJobsRequest, ListCronJobsResponse, ListDeploymentsRequest, ListDeploymentsResponse, ListFailedAsyncCallsRequest, ListFailedAsyncCallsResponse, ListLeasesRequest, ListLeasesResponse, ModuleContextRequest, ModuleContextResponse, PauseCronJobRequest, PauseCronJobResponse, PingRequest, PingResponse, ProcessListRequest, ProcessListResponse, PromoteCanaryRequest, PromoteCanaryResponse, PublishEventRequest, PublishEventResponse, PullSchemaRequest, PullSchemaResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReleaseLeaseRequest, ReleaseLeaseResponse, ReplaceDeployRequest, ReplaceDeployResponse, RequeueAsyncCallRequest, RequeueAsyncCallResponse, ReserveRequest, ReserveResponse, ResumeCronJobRequest, ResumeCronJobResponse, SendFSMEventRequest, SendFSMEventResponse, SendToConnectionRequest, SendToConnectionResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, TerminateRequest, TriggerCronJobRequest, TriggerCronJobResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -50,6 +50,41 @@ export const VerbService = {
       O: AcquireLeaseResponse,
       kind: MethodKind.BiDiStreaming,
     },
+    /**
+     * Publish an event to a topic.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.PublishEvent
+     */
+    publishEvent: {
+      name: "PublishEvent",
+      I: PublishEventRequest,
+      O: PublishEventResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Send an event to an FSM instance, creating the instance if necessary.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.SendFSMEvent
+     */
+    sendFSMEvent: {
+      name: "SendFSMEvent",
+      I: SendFSMEventRequest,
+      O: SendFSMEventResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Send a message to a WebSocket ingress connection.
+     *
+     * Returns NotFound if the connection is closed.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.SendToConnection
+     */
+    sendToConnection: {
+      name: "SendToConnection",
+      I: SendToConnectionRequest,
+      O: SendToConnectionResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Issue a synchronous call to a Verb.
      *
@@ -61,6 +96,19 @@ export const VerbService = {
       O: CallResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Issue a call to a streaming Verb, receiving each of its responses.
+     *
+     * The stream ends after the first error response.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.ServerStream
+     */
+    serverStream: {
+      name: "ServerStream",
+      I: CallRequest,
+      O: CallResponse,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -199,6 +247,53 @@ export const ControllerService = {
       O: ReplaceDeployResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * List all deployments of a module, active or not.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListDeployments
+     */
+    listDeployments: {
+      name: "ListDeployments",
+      I: ListDeploymentsRequest,
+      O: ListDeploymentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Promote a canary deployment to replace the existing deployment of its
+     * module.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.PromoteCanary
+     */
+    promoteCanary: {
+      name: "PromoteCanary",
+      I: PromoteCanaryRequest,
+      O: PromoteCanaryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Abort a canary deployment, routing all traffic back to the existing
+     * deployment of its module.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.AbortCanary
+     */
+    abortCanary: {
+      name: "AbortCanary",
+      I: AbortCanaryRequest,
+      O: AbortCanaryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Import events from an archive of expired events, so that they can be
+     * queried again.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ImportEvents
+     */
+    importEvents: {
+      name: "ImportEvents",
+      I: ImportEventsRequest,
+      O: ImportEventsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Stream logs from a deployment
      *
@@ -235,6 +330,127 @@ export const ControllerService = {
       O: PullSchemaResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * List async calls that have failed and exhausted their retries.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListFailedAsyncCalls
+     */
+    listFailedAsyncCalls: {
+      name: "ListFailedAsyncCalls",
+      I: ListFailedAsyncCallsRequest,
+      O: ListFailedAsyncCallsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get an async call.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.GetAsyncCall
+     */
+    getAsyncCall: {
+      name: "GetAsyncCall",
+      I: GetAsyncCallRequest,
+      O: GetAsyncCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Schedule a failed async call to be executed again.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.RequeueAsyncCall
+     */
+    requeueAsyncCall: {
+      name: "RequeueAsyncCall",
+      I: RequeueAsyncCallRequest,
+      O: RequeueAsyncCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Discard a failed async call.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.DiscardAsyncCall
+     */
+    discardAsyncCall: {
+      name: "DiscardAsyncCall",
+      I: DiscardAsyncCallRequest,
+      O: DiscardAsyncCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the cron jobs of active deployments.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListCronJobs
+     */
+    listCronJobs: {
+      name: "ListCronJobs",
+      I: ListCronJobsRequest,
+      O: ListCronJobsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Pause a cron job so it is not executed until resumed.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.PauseCronJob
+     */
+    pauseCronJob: {
+      name: "PauseCronJob",
+      I: PauseCronJobRequest,
+      O: PauseCronJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Resume a paused cron job.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ResumeCronJob
+     */
+    resumeCronJob: {
+      name: "ResumeCronJob",
+      I: ResumeCronJobRequest,
+      O: ResumeCronJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Execute a cron job immediately, waiting for it to finish.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.TriggerCronJob
+     */
+    triggerCronJob: {
+      name: "TriggerCronJob",
+      I: TriggerCronJobRequest,
+      O: TriggerCronJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the most recent executions of a cron job.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.GetCronJobHistory
+     */
+    getCronJobHistory: {
+      name: "GetCronJobHistory",
+      I: GetCronJobHistoryRequest,
+      O: GetCronJobHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List held leases.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListLeases
+     */
+    listLeases: {
+      name: "ListLeases",
+      I: ListLeasesRequest,
+      O: ListLeasesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Forcibly release a lease, regardless of who holds it.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ReleaseLease
+     */
+    releaseLease: {
+      name: "ReleaseLease",
+      I: ReleaseLeaseRequest,
+      O: ReleaseLeaseResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Duration, Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
-import { Module, Ref, Schema } from "./schema/schema_pb.js";
+import { Duration, Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
+import { Module, Ref, Schema, Type } from "./schema/schema_pb.js";
 
 /**
  * @generated from enum xyz.block.ftl.v1.DeploymentChangeType
@@ -619,6 +619,14 @@ export class AcquireLeaseRequest extends Message<AcquireLeaseRequest> {
  * @generated from message xyz.block.ftl.v1.AcquireLeaseResponse
  */
 export class AcquireLeaseResponse extends Message<AcquireLeaseResponse> {
+  /**
+   * Increases with every acquisition of any lease, so that writes made by a
+   * holder that has lost its lease can be rejected.
+   *
+   * @generated from field: int64 fencing_token = 1;
+   */
+  fencingToken = protoInt64.zero;
+
   constructor(data?: PartialMessage<AcquireLeaseResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -627,6 +635,7 @@ export class AcquireLeaseResponse extends Message<AcquireLeaseResponse> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "xyz.block.ftl.v1.AcquireLeaseResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fencing_token", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcquireLeaseResponse {
@@ -646,6 +655,250 @@ export class AcquireLeaseResponse extends Message<AcquireLeaseResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.PublishEventRequest
+ */
+export class PublishEventRequest extends Message<PublishEventRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref topic = 1;
+   */
+  topic?: Ref;
+
+  /**
+   * @generated from field: bytes body = 2;
+   */
+  body = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<PublishEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PublishEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "topic", kind: "message", T: Ref },
+    { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishEventRequest {
+    return new PublishEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishEventRequest {
+    return new PublishEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishEventRequest {
+    return new PublishEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishEventRequest | PlainMessage<PublishEventRequest> | undefined, b: PublishEventRequest | PlainMessage<PublishEventRequest> | undefined): boolean {
+    return proto3.util.equals(PublishEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PublishEventResponse
+ */
+export class PublishEventResponse extends Message<PublishEventResponse> {
+  constructor(data?: PartialMessage<PublishEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PublishEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishEventResponse {
+    return new PublishEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishEventResponse {
+    return new PublishEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishEventResponse {
+    return new PublishEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishEventResponse | PlainMessage<PublishEventResponse> | undefined, b: PublishEventResponse | PlainMessage<PublishEventResponse> | undefined): boolean {
+    return proto3.util.equals(PublishEventResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SendFSMEventRequest
+ */
+export class SendFSMEventRequest extends Message<SendFSMEventRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref fsm = 1;
+   */
+  fsm?: Ref;
+
+  /**
+   * @generated from field: string instance = 2;
+   */
+  instance = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Type event = 3;
+   */
+  event?: Type;
+
+  /**
+   * @generated from field: bytes body = 4;
+   */
+  body = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<SendFSMEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SendFSMEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fsm", kind: "message", T: Ref },
+    { no: 2, name: "instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event", kind: "message", T: Type },
+    { no: 4, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendFSMEventRequest {
+    return new SendFSMEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendFSMEventRequest {
+    return new SendFSMEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendFSMEventRequest {
+    return new SendFSMEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendFSMEventRequest | PlainMessage<SendFSMEventRequest> | undefined, b: SendFSMEventRequest | PlainMessage<SendFSMEventRequest> | undefined): boolean {
+    return proto3.util.equals(SendFSMEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SendFSMEventResponse
+ */
+export class SendFSMEventResponse extends Message<SendFSMEventResponse> {
+  constructor(data?: PartialMessage<SendFSMEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SendFSMEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendFSMEventResponse {
+    return new SendFSMEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendFSMEventResponse {
+    return new SendFSMEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendFSMEventResponse {
+    return new SendFSMEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendFSMEventResponse | PlainMessage<SendFSMEventResponse> | undefined, b: SendFSMEventResponse | PlainMessage<SendFSMEventResponse> | undefined): boolean {
+    return proto3.util.equals(SendFSMEventResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SendToConnectionRequest
+ */
+export class SendToConnectionRequest extends Message<SendToConnectionRequest> {
+  /**
+   * ID of a WebSocket ingress connection.
+   *
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * @generated from field: bytes body = 2;
+   */
+  body = new Uint8Array(0);
+
+  /**
+   * Send the body as a binary rather than a text message.
+   *
+   * @generated from field: bool binary = 3;
+   */
+  binary = false;
+
+  constructor(data?: PartialMessage<SendToConnectionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SendToConnectionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "binary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendToConnectionRequest {
+    return new SendToConnectionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendToConnectionRequest {
+    return new SendToConnectionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendToConnectionRequest {
+    return new SendToConnectionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendToConnectionRequest | PlainMessage<SendToConnectionRequest> | undefined, b: SendToConnectionRequest | PlainMessage<SendToConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(SendToConnectionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SendToConnectionResponse
+ */
+export class SendToConnectionResponse extends Message<SendToConnectionResponse> {
+  constructor(data?: PartialMessage<SendToConnectionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SendToConnectionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendToConnectionResponse {
+    return new SendToConnectionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendToConnectionResponse {
+    return new SendToConnectionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendToConnectionResponse {
+    return new SendToConnectionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendToConnectionResponse | PlainMessage<SendToConnectionResponse> | undefined, b: SendToConnectionResponse | PlainMessage<SendToConnectionResponse> | undefined): boolean {
+    return proto3.util.equals(SendToConnectionResponse, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.GetSchemaRequest
  */
@@ -1466,6 +1719,14 @@ export class ReplaceDeployRequest extends Message<ReplaceDeployRequest> {
    */
   minReplicas = 0;
 
+  /**
+   * If set, the deployment is started as a canary receiving this percentage
+   * of the module's traffic, rather than replacing the existing deployment.
+   *
+   * @generated from field: optional int32 canary_weight = 3;
+   */
+  canaryWeight?: number;
+
   constructor(data?: PartialMessage<ReplaceDeployRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1476,6 +1737,7 @@ export class ReplaceDeployRequest extends Message<ReplaceDeployRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "canary_weight", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplaceDeployRequest {
@@ -1527,663 +1789,2181 @@ export class ReplaceDeployResponse extends Message<ReplaceDeployResponse> {
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StreamDeploymentLogsRequest
+ * @generated from message xyz.block.ftl.v1.PromoteCanaryRequest
  */
-export class StreamDeploymentLogsRequest extends Message<StreamDeploymentLogsRequest> {
+export class PromoteCanaryRequest extends Message<PromoteCanaryRequest> {
   /**
    * @generated from field: string deployment_key = 1;
    */
   deploymentKey = "";
 
-  /**
-   * @generated from field: optional string request_key = 2;
-   */
-  requestKey?: string;
+  constructor(data?: PartialMessage<PromoteCanaryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: google.protobuf.Timestamp time_stamp = 3;
-   */
-  timeStamp?: Timestamp;
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PromoteCanaryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
 
-  /**
-   * @generated from field: int32 log_level = 4;
-   */
-  logLevel = 0;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteCanaryRequest {
+    return new PromoteCanaryRequest().fromBinary(bytes, options);
+  }
 
-  /**
-   * @generated from field: map<string, string> attributes = 5;
-   */
-  attributes: { [key: string]: string } = {};
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteCanaryRequest {
+    return new PromoteCanaryRequest().fromJson(jsonValue, options);
+  }
 
-  /**
-   * @generated from field: string message = 6;
-   */
-  message = "";
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteCanaryRequest {
+    return new PromoteCanaryRequest().fromJsonString(jsonString, options);
+  }
 
-  /**
-   * @generated from field: optional string error = 7;
-   */
-  error?: string;
+  static equals(a: PromoteCanaryRequest | PlainMessage<PromoteCanaryRequest> | undefined, b: PromoteCanaryRequest | PlainMessage<PromoteCanaryRequest> | undefined): boolean {
+    return proto3.util.equals(PromoteCanaryRequest, a, b);
+  }
+}
 
-  constructor(data?: PartialMessage<StreamDeploymentLogsRequest>) {
+/**
+ * @generated from message xyz.block.ftl.v1.PromoteCanaryResponse
+ */
+export class PromoteCanaryResponse extends Message<PromoteCanaryResponse> {
+  constructor(data?: PartialMessage<PromoteCanaryResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StreamDeploymentLogsRequest";
+  static readonly typeName = "xyz.block.ftl.v1.PromoteCanaryResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 3, name: "time_stamp", kind: "message", T: Timestamp },
-    { no: 4, name: "log_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
-    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamDeploymentLogsRequest {
-    return new StreamDeploymentLogsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteCanaryResponse {
+    return new PromoteCanaryResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamDeploymentLogsRequest {
-    return new StreamDeploymentLogsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteCanaryResponse {
+    return new PromoteCanaryResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamDeploymentLogsRequest {
-    return new StreamDeploymentLogsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteCanaryResponse {
+    return new PromoteCanaryResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StreamDeploymentLogsRequest | PlainMessage<StreamDeploymentLogsRequest> | undefined, b: StreamDeploymentLogsRequest | PlainMessage<StreamDeploymentLogsRequest> | undefined): boolean {
-    return proto3.util.equals(StreamDeploymentLogsRequest, a, b);
+  static equals(a: PromoteCanaryResponse | PlainMessage<PromoteCanaryResponse> | undefined, b: PromoteCanaryResponse | PlainMessage<PromoteCanaryResponse> | undefined): boolean {
+    return proto3.util.equals(PromoteCanaryResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StreamDeploymentLogsResponse
+ * @generated from message xyz.block.ftl.v1.AbortCanaryRequest
  */
-export class StreamDeploymentLogsResponse extends Message<StreamDeploymentLogsResponse> {
-  constructor(data?: PartialMessage<StreamDeploymentLogsResponse>) {
+export class AbortCanaryRequest extends Message<AbortCanaryRequest> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  constructor(data?: PartialMessage<AbortCanaryRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StreamDeploymentLogsResponse";
+  static readonly typeName = "xyz.block.ftl.v1.AbortCanaryRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamDeploymentLogsResponse {
-    return new StreamDeploymentLogsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AbortCanaryRequest {
+    return new AbortCanaryRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamDeploymentLogsResponse {
-    return new StreamDeploymentLogsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AbortCanaryRequest {
+    return new AbortCanaryRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamDeploymentLogsResponse {
-    return new StreamDeploymentLogsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AbortCanaryRequest {
+    return new AbortCanaryRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StreamDeploymentLogsResponse | PlainMessage<StreamDeploymentLogsResponse> | undefined, b: StreamDeploymentLogsResponse | PlainMessage<StreamDeploymentLogsResponse> | undefined): boolean {
-    return proto3.util.equals(StreamDeploymentLogsResponse, a, b);
+  static equals(a: AbortCanaryRequest | PlainMessage<AbortCanaryRequest> | undefined, b: AbortCanaryRequest | PlainMessage<AbortCanaryRequest> | undefined): boolean {
+    return proto3.util.equals(AbortCanaryRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StatusRequest
+ * @generated from message xyz.block.ftl.v1.AbortCanaryResponse
  */
-export class StatusRequest extends Message<StatusRequest> {
-  constructor(data?: PartialMessage<StatusRequest>) {
+export class AbortCanaryResponse extends Message<AbortCanaryResponse> {
+  constructor(data?: PartialMessage<AbortCanaryResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StatusRequest";
+  static readonly typeName = "xyz.block.ftl.v1.AbortCanaryResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusRequest {
-    return new StatusRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AbortCanaryResponse {
+    return new AbortCanaryResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusRequest {
-    return new StatusRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AbortCanaryResponse {
+    return new AbortCanaryResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusRequest {
-    return new StatusRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AbortCanaryResponse {
+    return new AbortCanaryResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StatusRequest | PlainMessage<StatusRequest> | undefined, b: StatusRequest | PlainMessage<StatusRequest> | undefined): boolean {
-    return proto3.util.equals(StatusRequest, a, b);
+  static equals(a: AbortCanaryResponse | PlainMessage<AbortCanaryResponse> | undefined, b: AbortCanaryResponse | PlainMessage<AbortCanaryResponse> | undefined): boolean {
+    return proto3.util.equals(AbortCanaryResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StatusResponse
+ * @generated from message xyz.block.ftl.v1.ImportEventsRequest
  */
-export class StatusResponse extends Message<StatusResponse> {
+export class ImportEventsRequest extends Message<ImportEventsRequest> {
   /**
-   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.Controller controllers = 1;
+   * Contents of an archive of expired events written by the controller.
+   *
+   * @generated from field: bytes archive = 1;
    */
-  controllers: StatusResponse_Controller[] = [];
+  archive = new Uint8Array(0);
 
-  /**
-   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.Runner runners = 2;
-   */
-  runners: StatusResponse_Runner[] = [];
+  constructor(data?: PartialMessage<ImportEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.Deployment deployments = 3;
-   */
-  deployments: StatusResponse_Deployment[] = [];
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ImportEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "archive", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportEventsRequest {
+    return new ImportEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportEventsRequest {
+    return new ImportEventsRequest().fromJson(jsonValue, options);
+  }
 
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportEventsRequest {
+    return new ImportEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportEventsRequest | PlainMessage<ImportEventsRequest> | undefined, b: ImportEventsRequest | PlainMessage<ImportEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ImportEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ImportEventsResponse
+ */
+export class ImportEventsResponse extends Message<ImportEventsResponse> {
   /**
-   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.IngressRoute ingress_routes = 4;
+   * Number of events imported, excluding events that already existed.
+   *
+   * @generated from field: int64 imported = 1;
    */
-  ingressRoutes: StatusResponse_IngressRoute[] = [];
+  imported = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ImportEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ImportEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "imported", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportEventsResponse {
+    return new ImportEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportEventsResponse {
+    return new ImportEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportEventsResponse {
+    return new ImportEventsResponse().fromJsonString(jsonString, options);
+  }
 
+  static equals(a: ImportEventsResponse | PlainMessage<ImportEventsResponse> | undefined, b: ImportEventsResponse | PlainMessage<ImportEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ImportEventsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListDeploymentsRequest
+ */
+export class ListDeploymentsRequest extends Message<ListDeploymentsRequest> {
   /**
-   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.Route routes = 5;
+   * @generated from field: string module = 1;
    */
-  routes: StatusResponse_Route[] = [];
+  module = "";
 
-  constructor(data?: PartialMessage<StatusResponse>) {
+  constructor(data?: PartialMessage<ListDeploymentsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StatusResponse";
+  static readonly typeName = "xyz.block.ftl.v1.ListDeploymentsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "controllers", kind: "message", T: StatusResponse_Controller, repeated: true },
-    { no: 2, name: "runners", kind: "message", T: StatusResponse_Runner, repeated: true },
-    { no: 3, name: "deployments", kind: "message", T: StatusResponse_Deployment, repeated: true },
-    { no: 4, name: "ingress_routes", kind: "message", T: StatusResponse_IngressRoute, repeated: true },
-    { no: 5, name: "routes", kind: "message", T: StatusResponse_Route, repeated: true },
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse {
-    return new StatusResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeploymentsRequest {
+    return new ListDeploymentsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse {
-    return new StatusResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeploymentsRequest {
+    return new ListDeploymentsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse {
-    return new StatusResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeploymentsRequest {
+    return new ListDeploymentsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StatusResponse | PlainMessage<StatusResponse> | undefined, b: StatusResponse | PlainMessage<StatusResponse> | undefined): boolean {
-    return proto3.util.equals(StatusResponse, a, b);
+  static equals(a: ListDeploymentsRequest | PlainMessage<ListDeploymentsRequest> | undefined, b: ListDeploymentsRequest | PlainMessage<ListDeploymentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListDeploymentsRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StatusResponse.Controller
+ * @generated from message xyz.block.ftl.v1.ListDeploymentsResponse
  */
-export class StatusResponse_Controller extends Message<StatusResponse_Controller> {
+export class ListDeploymentsResponse extends Message<ListDeploymentsResponse> {
+  /**
+   * Most recent first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.ListDeploymentsResponse.Deployment deployments = 1;
+   */
+  deployments: ListDeploymentsResponse_Deployment[] = [];
+
+  constructor(data?: PartialMessage<ListDeploymentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListDeploymentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployments", kind: "message", T: ListDeploymentsResponse_Deployment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeploymentsResponse {
+    return new ListDeploymentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeploymentsResponse {
+    return new ListDeploymentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeploymentsResponse {
+    return new ListDeploymentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeploymentsResponse | PlainMessage<ListDeploymentsResponse> | undefined, b: ListDeploymentsResponse | PlainMessage<ListDeploymentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListDeploymentsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListDeploymentsResponse.MinReplicasChange
+ */
+export class ListDeploymentsResponse_MinReplicasChange extends Message<ListDeploymentsResponse_MinReplicasChange> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * @generated from field: int32 min_replicas = 2;
+   */
+  minReplicas = 0;
+
+  constructor(data?: PartialMessage<ListDeploymentsResponse_MinReplicasChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListDeploymentsResponse.MinReplicasChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeploymentsResponse_MinReplicasChange {
+    return new ListDeploymentsResponse_MinReplicasChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeploymentsResponse_MinReplicasChange {
+    return new ListDeploymentsResponse_MinReplicasChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeploymentsResponse_MinReplicasChange {
+    return new ListDeploymentsResponse_MinReplicasChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeploymentsResponse_MinReplicasChange | PlainMessage<ListDeploymentsResponse_MinReplicasChange> | undefined, b: ListDeploymentsResponse_MinReplicasChange | PlainMessage<ListDeploymentsResponse_MinReplicasChange> | undefined): boolean {
+    return proto3.util.equals(ListDeploymentsResponse_MinReplicasChange, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListDeploymentsResponse.Deployment
+ */
+export class ListDeploymentsResponse_Deployment extends Message<ListDeploymentsResponse_Deployment> {
   /**
    * @generated from field: string key = 1;
    */
   key = "";
 
   /**
-   * @generated from field: string endpoint = 2;
+   * @generated from field: string language = 2;
    */
-  endpoint = "";
+  language = "";
 
-  constructor(data?: PartialMessage<StatusResponse_Controller>) {
+  /**
+   * SHA256 of the deployment's protobuf encoded schema.
+   *
+   * @generated from field: string schema_hash = 3;
+   */
+  schemaHash = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: int32 min_replicas = 5;
+   */
+  minReplicas = 0;
+
+  /**
+   * @generated from field: optional int32 canary_weight = 6;
+   */
+  canaryWeight?: number;
+
+  /**
+   * Changes to min_replicas over time, oldest first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.ListDeploymentsResponse.MinReplicasChange min_replicas_history = 7;
+   */
+  minReplicasHistory: ListDeploymentsResponse_MinReplicasChange[] = [];
+
+  constructor(data?: PartialMessage<ListDeploymentsResponse_Deployment>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.Controller";
+  static readonly typeName = "xyz.block.ftl.v1.ListDeploymentsResponse.Deployment";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "schema_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+    { no: 5, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "canary_weight", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 7, name: "min_replicas_history", kind: "message", T: ListDeploymentsResponse_MinReplicasChange, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Controller {
-    return new StatusResponse_Controller().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeploymentsResponse_Deployment {
+    return new ListDeploymentsResponse_Deployment().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_Controller {
-    return new StatusResponse_Controller().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeploymentsResponse_Deployment {
+    return new ListDeploymentsResponse_Deployment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeploymentsResponse_Deployment {
+    return new ListDeploymentsResponse_Deployment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeploymentsResponse_Deployment | PlainMessage<ListDeploymentsResponse_Deployment> | undefined, b: ListDeploymentsResponse_Deployment | PlainMessage<ListDeploymentsResponse_Deployment> | undefined): boolean {
+    return proto3.util.equals(ListDeploymentsResponse_Deployment, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StreamDeploymentLogsRequest
+ */
+export class StreamDeploymentLogsRequest extends Message<StreamDeploymentLogsRequest> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: optional string request_key = 2;
+   */
+  requestKey?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp time_stamp = 3;
+   */
+  timeStamp?: Timestamp;
+
+  /**
+   * @generated from field: int32 log_level = 4;
+   */
+  logLevel = 0;
+
+  /**
+   * @generated from field: map<string, string> attributes = 5;
+   */
+  attributes: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message = "";
+
+  /**
+   * @generated from field: optional string error = 7;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<StreamDeploymentLogsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StreamDeploymentLogsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "time_stamp", kind: "message", T: Timestamp },
+    { no: 4, name: "log_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamDeploymentLogsRequest {
+    return new StreamDeploymentLogsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamDeploymentLogsRequest {
+    return new StreamDeploymentLogsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamDeploymentLogsRequest {
+    return new StreamDeploymentLogsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamDeploymentLogsRequest | PlainMessage<StreamDeploymentLogsRequest> | undefined, b: StreamDeploymentLogsRequest | PlainMessage<StreamDeploymentLogsRequest> | undefined): boolean {
+    return proto3.util.equals(StreamDeploymentLogsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StreamDeploymentLogsResponse
+ */
+export class StreamDeploymentLogsResponse extends Message<StreamDeploymentLogsResponse> {
+  constructor(data?: PartialMessage<StreamDeploymentLogsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StreamDeploymentLogsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamDeploymentLogsResponse {
+    return new StreamDeploymentLogsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamDeploymentLogsResponse {
+    return new StreamDeploymentLogsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamDeploymentLogsResponse {
+    return new StreamDeploymentLogsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamDeploymentLogsResponse | PlainMessage<StreamDeploymentLogsResponse> | undefined, b: StreamDeploymentLogsResponse | PlainMessage<StreamDeploymentLogsResponse> | undefined): boolean {
+    return proto3.util.equals(StreamDeploymentLogsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StatusRequest
+ */
+export class StatusRequest extends Message<StatusRequest> {
+  constructor(data?: PartialMessage<StatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusRequest {
+    return new StatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusRequest {
+    return new StatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusRequest {
+    return new StatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusRequest | PlainMessage<StatusRequest> | undefined, b: StatusRequest | PlainMessage<StatusRequest> | undefined): boolean {
+    return proto3.util.equals(StatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StatusResponse
+ */
+export class StatusResponse extends Message<StatusResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.Controller controllers = 1;
+   */
+  controllers: StatusResponse_Controller[] = [];
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.Runner runners = 2;
+   */
+  runners: StatusResponse_Runner[] = [];
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.Deployment deployments = 3;
+   */
+  deployments: StatusResponse_Deployment[] = [];
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.IngressRoute ingress_routes = 4;
+   */
+  ingressRoutes: StatusResponse_IngressRoute[] = [];
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.StatusResponse.Route routes = 5;
+   */
+  routes: StatusResponse_Route[] = [];
+
+  constructor(data?: PartialMessage<StatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "controllers", kind: "message", T: StatusResponse_Controller, repeated: true },
+    { no: 2, name: "runners", kind: "message", T: StatusResponse_Runner, repeated: true },
+    { no: 3, name: "deployments", kind: "message", T: StatusResponse_Deployment, repeated: true },
+    { no: 4, name: "ingress_routes", kind: "message", T: StatusResponse_IngressRoute, repeated: true },
+    { no: 5, name: "routes", kind: "message", T: StatusResponse_Route, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse {
+    return new StatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse {
+    return new StatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse {
+    return new StatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusResponse | PlainMessage<StatusResponse> | undefined, b: StatusResponse | PlainMessage<StatusResponse> | undefined): boolean {
+    return proto3.util.equals(StatusResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StatusResponse.Controller
+ */
+export class StatusResponse_Controller extends Message<StatusResponse_Controller> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string endpoint = 2;
+   */
+  endpoint = "";
+
+  constructor(data?: PartialMessage<StatusResponse_Controller>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.Controller";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Controller {
+    return new StatusResponse_Controller().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_Controller {
+    return new StatusResponse_Controller().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_Controller {
+    return new StatusResponse_Controller().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusResponse_Controller | PlainMessage<StatusResponse_Controller> | undefined, b: StatusResponse_Controller | PlainMessage<StatusResponse_Controller> | undefined): boolean {
+    return proto3.util.equals(StatusResponse_Controller, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StatusResponse.Runner
+ */
+export class StatusResponse_Runner extends Message<StatusResponse_Runner> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: repeated string languages = 2;
+   */
+  languages: string[] = [];
+
+  /**
+   * @generated from field: string endpoint = 3;
+   */
+  endpoint = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.RunnerState state = 4;
+   */
+  state = RunnerState.RUNNER_IDLE;
+
+  /**
+   * @generated from field: optional string deployment = 5;
+   */
+  deployment?: string;
+
+  /**
+   * @generated from field: google.protobuf.Struct labels = 6;
+   */
+  labels?: Struct;
+
+  constructor(data?: PartialMessage<StatusResponse_Runner>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.Runner";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "languages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RunnerState) },
+    { no: 5, name: "deployment", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "labels", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Runner {
+    return new StatusResponse_Runner().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_Runner {
+    return new StatusResponse_Runner().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_Runner {
+    return new StatusResponse_Runner().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusResponse_Runner | PlainMessage<StatusResponse_Runner> | undefined, b: StatusResponse_Runner | PlainMessage<StatusResponse_Runner> | undefined): boolean {
+    return proto3.util.equals(StatusResponse_Runner, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StatusResponse.Deployment
+ */
+export class StatusResponse_Deployment extends Message<StatusResponse_Deployment> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string language = 2;
+   */
+  language = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: int32 min_replicas = 4;
+   */
+  minReplicas = 0;
+
+  /**
+   * @generated from field: int32 replicas = 7;
+   */
+  replicas = 0;
+
+  /**
+   * @generated from field: google.protobuf.Struct labels = 5;
+   */
+  labels?: Struct;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Module schema = 6;
+   */
+  schema?: Module;
+
+  /**
+   * @generated from field: optional int32 canary_weight = 8;
+   */
+  canaryWeight?: number;
+
+  constructor(data?: PartialMessage<StatusResponse_Deployment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.Deployment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "labels", kind: "message", T: Struct },
+    { no: 6, name: "schema", kind: "message", T: Module },
+    { no: 8, name: "canary_weight", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Deployment {
+    return new StatusResponse_Deployment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_Deployment {
+    return new StatusResponse_Deployment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_Deployment {
+    return new StatusResponse_Deployment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusResponse_Deployment | PlainMessage<StatusResponse_Deployment> | undefined, b: StatusResponse_Deployment | PlainMessage<StatusResponse_Deployment> | undefined): boolean {
+    return proto3.util.equals(StatusResponse_Deployment, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StatusResponse.IngressRoute
+ */
+export class StatusResponse_IngressRoute extends Message<StatusResponse_IngressRoute> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 5;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: string method = 3;
+   */
+  method = "";
+
+  /**
+   * @generated from field: string path = 4;
+   */
+  path = "";
+
+  constructor(data?: PartialMessage<StatusResponse_IngressRoute>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.IngressRoute";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "verb", kind: "message", T: Ref },
+    { no: 3, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_IngressRoute {
+    return new StatusResponse_IngressRoute().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_IngressRoute {
+    return new StatusResponse_IngressRoute().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_IngressRoute {
+    return new StatusResponse_IngressRoute().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusResponse_IngressRoute | PlainMessage<StatusResponse_IngressRoute> | undefined, b: StatusResponse_IngressRoute | PlainMessage<StatusResponse_IngressRoute> | undefined): boolean {
+    return proto3.util.equals(StatusResponse_IngressRoute, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.StatusResponse.Route
+ */
+export class StatusResponse_Route extends Message<StatusResponse_Route> {
+  /**
+   * @generated from field: string module = 1;
+   */
+  module = "";
+
+  /**
+   * @generated from field: string runner = 2;
+   */
+  runner = "";
+
+  /**
+   * @generated from field: string deployment = 3;
+   */
+  deployment = "";
+
+  /**
+   * @generated from field: string endpoint = 4;
+   */
+  endpoint = "";
+
+  constructor(data?: PartialMessage<StatusResponse_Route>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.Route";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "runner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "deployment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Route {
+    return new StatusResponse_Route().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_Route {
+    return new StatusResponse_Route().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_Route {
+    return new StatusResponse_Route().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusResponse_Route | PlainMessage<StatusResponse_Route> | undefined, b: StatusResponse_Route | PlainMessage<StatusResponse_Route> | undefined): boolean {
+    return proto3.util.equals(StatusResponse_Route, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ProcessListRequest
+ */
+export class ProcessListRequest extends Message<ProcessListRequest> {
+  constructor(data?: PartialMessage<ProcessListRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ProcessListRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListRequest {
+    return new ProcessListRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessListRequest {
+    return new ProcessListRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessListRequest {
+    return new ProcessListRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessListRequest | PlainMessage<ProcessListRequest> | undefined, b: ProcessListRequest | PlainMessage<ProcessListRequest> | undefined): boolean {
+    return proto3.util.equals(ProcessListRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ProcessListResponse
+ */
+export class ProcessListResponse extends Message<ProcessListResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.ProcessListResponse.Process processes = 1;
+   */
+  processes: ProcessListResponse_Process[] = [];
+
+  constructor(data?: PartialMessage<ProcessListResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ProcessListResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "processes", kind: "message", T: ProcessListResponse_Process, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListResponse {
+    return new ProcessListResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessListResponse {
+    return new ProcessListResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessListResponse {
+    return new ProcessListResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessListResponse | PlainMessage<ProcessListResponse> | undefined, b: ProcessListResponse | PlainMessage<ProcessListResponse> | undefined): boolean {
+    return proto3.util.equals(ProcessListResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ProcessListResponse.ProcessRunner
+ */
+export class ProcessListResponse_ProcessRunner extends Message<ProcessListResponse_ProcessRunner> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string endpoint = 2;
+   */
+  endpoint = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct labels = 3;
+   */
+  labels?: Struct;
+
+  constructor(data?: PartialMessage<ProcessListResponse_ProcessRunner>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ProcessListResponse.ProcessRunner";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "labels", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListResponse_ProcessRunner {
+    return new ProcessListResponse_ProcessRunner().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessListResponse_ProcessRunner {
+    return new ProcessListResponse_ProcessRunner().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessListResponse_ProcessRunner {
+    return new ProcessListResponse_ProcessRunner().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessListResponse_ProcessRunner | PlainMessage<ProcessListResponse_ProcessRunner> | undefined, b: ProcessListResponse_ProcessRunner | PlainMessage<ProcessListResponse_ProcessRunner> | undefined): boolean {
+    return proto3.util.equals(ProcessListResponse_ProcessRunner, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ProcessListResponse.Process
+ */
+export class ProcessListResponse_Process extends Message<ProcessListResponse_Process> {
+  /**
+   * @generated from field: string deployment = 1;
+   */
+  deployment = "";
+
+  /**
+   * @generated from field: int32 min_replicas = 2;
+   */
+  minReplicas = 0;
+
+  /**
+   * @generated from field: google.protobuf.Struct labels = 3;
+   */
+  labels?: Struct;
+
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.ProcessListResponse.ProcessRunner runner = 4;
+   */
+  runner?: ProcessListResponse_ProcessRunner;
+
+  constructor(data?: PartialMessage<ProcessListResponse_Process>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ProcessListResponse.Process";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "labels", kind: "message", T: Struct },
+    { no: 4, name: "runner", kind: "message", T: ProcessListResponse_ProcessRunner, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListResponse_Process {
+    return new ProcessListResponse_Process().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessListResponse_Process {
+    return new ProcessListResponse_Process().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessListResponse_Process {
+    return new ProcessListResponse_Process().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessListResponse_Process | PlainMessage<ProcessListResponse_Process> | undefined, b: ProcessListResponse_Process | PlainMessage<ProcessListResponse_Process> | undefined): boolean {
+    return proto3.util.equals(ProcessListResponse_Process, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.AsyncCall
+ */
+export class AsyncCall extends Message<AsyncCall> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * One of "pending", "executing", "success", "error" or "discarded".
+   *
+   * @generated from field: string state = 3;
+   */
+  state = "";
+
+  /**
+   * One of "cron", "fsm" or "pubsub".
+   *
+   * @generated from field: string origin = 4;
+   */
+  origin = "";
+
+  /**
+   * @generated from field: string origin_key = 5;
+   */
+  originKey = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 6;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: bytes request = 7;
+   */
+  request = new Uint8Array(0);
+
+  /**
+   * @generated from field: optional bytes response = 8;
+   */
+  response?: Uint8Array;
+
+  /**
+   * @generated from field: optional string error = 9;
+   */
+  error?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_at = 10;
+   */
+  scheduledAt?: Timestamp;
+
+  /**
+   * @generated from field: int32 retry_attempt = 11;
+   */
+  retryAttempt = 0;
+
+  constructor(data?: PartialMessage<AsyncCall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.AsyncCall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "created_at", kind: "message", T: Timestamp },
+    { no: 3, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "origin", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "origin_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "verb", kind: "message", T: Ref },
+    { no: 7, name: "request", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 8, name: "response", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 9, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 10, name: "scheduled_at", kind: "message", T: Timestamp },
+    { no: 11, name: "retry_attempt", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AsyncCall {
+    return new AsyncCall().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AsyncCall {
+    return new AsyncCall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AsyncCall {
+    return new AsyncCall().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AsyncCall | PlainMessage<AsyncCall> | undefined, b: AsyncCall | PlainMessage<AsyncCall> | undefined): boolean {
+    return proto3.util.equals(AsyncCall, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListFailedAsyncCallsRequest
+ */
+export class ListFailedAsyncCallsRequest extends Message<ListFailedAsyncCallsRequest> {
+  /**
+   * Only list calls with this origin, one of "cron", "fsm" or "pubsub".
+   *
+   * @generated from field: optional string origin = 1;
+   */
+  origin?: string;
+
+  constructor(data?: PartialMessage<ListFailedAsyncCallsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListFailedAsyncCallsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "origin", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFailedAsyncCallsRequest {
+    return new ListFailedAsyncCallsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFailedAsyncCallsRequest {
+    return new ListFailedAsyncCallsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFailedAsyncCallsRequest {
+    return new ListFailedAsyncCallsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFailedAsyncCallsRequest | PlainMessage<ListFailedAsyncCallsRequest> | undefined, b: ListFailedAsyncCallsRequest | PlainMessage<ListFailedAsyncCallsRequest> | undefined): boolean {
+    return proto3.util.equals(ListFailedAsyncCallsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListFailedAsyncCallsResponse
+ */
+export class ListFailedAsyncCallsResponse extends Message<ListFailedAsyncCallsResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.AsyncCall calls = 1;
+   */
+  calls: AsyncCall[] = [];
+
+  constructor(data?: PartialMessage<ListFailedAsyncCallsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListFailedAsyncCallsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "calls", kind: "message", T: AsyncCall, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFailedAsyncCallsResponse {
+    return new ListFailedAsyncCallsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFailedAsyncCallsResponse {
+    return new ListFailedAsyncCallsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFailedAsyncCallsResponse {
+    return new ListFailedAsyncCallsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFailedAsyncCallsResponse | PlainMessage<ListFailedAsyncCallsResponse> | undefined, b: ListFailedAsyncCallsResponse | PlainMessage<ListFailedAsyncCallsResponse> | undefined): boolean {
+    return proto3.util.equals(ListFailedAsyncCallsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetAsyncCallRequest
+ */
+export class GetAsyncCallRequest extends Message<GetAsyncCallRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetAsyncCallRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetAsyncCallRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAsyncCallRequest {
+    return new GetAsyncCallRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAsyncCallRequest {
+    return new GetAsyncCallRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAsyncCallRequest {
+    return new GetAsyncCallRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAsyncCallRequest | PlainMessage<GetAsyncCallRequest> | undefined, b: GetAsyncCallRequest | PlainMessage<GetAsyncCallRequest> | undefined): boolean {
+    return proto3.util.equals(GetAsyncCallRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetAsyncCallResponse
+ */
+export class GetAsyncCallResponse extends Message<GetAsyncCallResponse> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.AsyncCall call = 1;
+   */
+  call?: AsyncCall;
+
+  constructor(data?: PartialMessage<GetAsyncCallResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetAsyncCallResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "call", kind: "message", T: AsyncCall },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAsyncCallResponse {
+    return new GetAsyncCallResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAsyncCallResponse {
+    return new GetAsyncCallResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAsyncCallResponse {
+    return new GetAsyncCallResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAsyncCallResponse | PlainMessage<GetAsyncCallResponse> | undefined, b: GetAsyncCallResponse | PlainMessage<GetAsyncCallResponse> | undefined): boolean {
+    return proto3.util.equals(GetAsyncCallResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.RequeueAsyncCallRequest
+ */
+export class RequeueAsyncCallRequest extends Message<RequeueAsyncCallRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RequeueAsyncCallRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.RequeueAsyncCallRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequeueAsyncCallRequest {
+    return new RequeueAsyncCallRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequeueAsyncCallRequest {
+    return new RequeueAsyncCallRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequeueAsyncCallRequest {
+    return new RequeueAsyncCallRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequeueAsyncCallRequest | PlainMessage<RequeueAsyncCallRequest> | undefined, b: RequeueAsyncCallRequest | PlainMessage<RequeueAsyncCallRequest> | undefined): boolean {
+    return proto3.util.equals(RequeueAsyncCallRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.RequeueAsyncCallResponse
+ */
+export class RequeueAsyncCallResponse extends Message<RequeueAsyncCallResponse> {
+  constructor(data?: PartialMessage<RequeueAsyncCallResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.RequeueAsyncCallResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequeueAsyncCallResponse {
+    return new RequeueAsyncCallResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequeueAsyncCallResponse {
+    return new RequeueAsyncCallResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequeueAsyncCallResponse {
+    return new RequeueAsyncCallResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequeueAsyncCallResponse | PlainMessage<RequeueAsyncCallResponse> | undefined, b: RequeueAsyncCallResponse | PlainMessage<RequeueAsyncCallResponse> | undefined): boolean {
+    return proto3.util.equals(RequeueAsyncCallResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.DiscardAsyncCallRequest
+ */
+export class DiscardAsyncCallRequest extends Message<DiscardAsyncCallRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DiscardAsyncCallRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DiscardAsyncCallRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiscardAsyncCallRequest {
+    return new DiscardAsyncCallRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiscardAsyncCallRequest {
+    return new DiscardAsyncCallRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiscardAsyncCallRequest {
+    return new DiscardAsyncCallRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiscardAsyncCallRequest | PlainMessage<DiscardAsyncCallRequest> | undefined, b: DiscardAsyncCallRequest | PlainMessage<DiscardAsyncCallRequest> | undefined): boolean {
+    return proto3.util.equals(DiscardAsyncCallRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.DiscardAsyncCallResponse
+ */
+export class DiscardAsyncCallResponse extends Message<DiscardAsyncCallResponse> {
+  constructor(data?: PartialMessage<DiscardAsyncCallResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DiscardAsyncCallResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiscardAsyncCallResponse {
+    return new DiscardAsyncCallResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiscardAsyncCallResponse {
+    return new DiscardAsyncCallResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiscardAsyncCallResponse {
+    return new DiscardAsyncCallResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiscardAsyncCallResponse | PlainMessage<DiscardAsyncCallResponse> | undefined, b: DiscardAsyncCallResponse | PlainMessage<DiscardAsyncCallResponse> | undefined): boolean {
+    return proto3.util.equals(DiscardAsyncCallResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CronJob
+ */
+export class CronJob extends Message<CronJob> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string deployment_key = 2;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 3;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: string schedule = 4;
+   */
+  schedule = "";
+
+  /**
+   * One of "idle" or "executing".
+   *
+   * @generated from field: string state = 5;
+   */
+  state = "";
+
+  /**
+   * @generated from field: bool paused = 6;
+   */
+  paused = false;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_execution = 7;
+   */
+  nextExecution?: Timestamp;
+
+  /**
+   * When the job last started executing.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 8;
+   */
+  startTime?: Timestamp;
+
+  constructor(data?: PartialMessage<CronJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CronJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "verb", kind: "message", T: Ref },
+    { no: 4, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "paused", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "next_execution", kind: "message", T: Timestamp },
+    { no: 8, name: "start_time", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CronJob {
+    return new CronJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CronJob {
+    return new CronJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CronJob {
+    return new CronJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CronJob | PlainMessage<CronJob> | undefined, b: CronJob | PlainMessage<CronJob> | undefined): boolean {
+    return proto3.util.equals(CronJob, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListCronJobsRequest
+ */
+export class ListCronJobsRequest extends Message<ListCronJobsRequest> {
+  constructor(data?: PartialMessage<ListCronJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListCronJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCronJobsRequest | PlainMessage<ListCronJobsRequest> | undefined, b: ListCronJobsRequest | PlainMessage<ListCronJobsRequest> | undefined): boolean {
+    return proto3.util.equals(ListCronJobsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListCronJobsResponse
+ */
+export class ListCronJobsResponse extends Message<ListCronJobsResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.CronJob jobs = 1;
+   */
+  jobs: CronJob[] = [];
+
+  constructor(data?: PartialMessage<ListCronJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListCronJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "jobs", kind: "message", T: CronJob, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCronJobsResponse | PlainMessage<ListCronJobsResponse> | undefined, b: ListCronJobsResponse | PlainMessage<ListCronJobsResponse> | undefined): boolean {
+    return proto3.util.equals(ListCronJobsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PauseCronJobRequest
+ */
+export class PauseCronJobRequest extends Message<PauseCronJobRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  constructor(data?: PartialMessage<PauseCronJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PauseCronJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PauseCronJobRequest {
+    return new PauseCronJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PauseCronJobRequest {
+    return new PauseCronJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PauseCronJobRequest {
+    return new PauseCronJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PauseCronJobRequest | PlainMessage<PauseCronJobRequest> | undefined, b: PauseCronJobRequest | PlainMessage<PauseCronJobRequest> | undefined): boolean {
+    return proto3.util.equals(PauseCronJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PauseCronJobResponse
+ */
+export class PauseCronJobResponse extends Message<PauseCronJobResponse> {
+  constructor(data?: PartialMessage<PauseCronJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PauseCronJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PauseCronJobResponse {
+    return new PauseCronJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PauseCronJobResponse {
+    return new PauseCronJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PauseCronJobResponse {
+    return new PauseCronJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PauseCronJobResponse | PlainMessage<PauseCronJobResponse> | undefined, b: PauseCronJobResponse | PlainMessage<PauseCronJobResponse> | undefined): boolean {
+    return proto3.util.equals(PauseCronJobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ResumeCronJobRequest
+ */
+export class ResumeCronJobRequest extends Message<ResumeCronJobRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  constructor(data?: PartialMessage<ResumeCronJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ResumeCronJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeCronJobRequest {
+    return new ResumeCronJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeCronJobRequest {
+    return new ResumeCronJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeCronJobRequest {
+    return new ResumeCronJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeCronJobRequest | PlainMessage<ResumeCronJobRequest> | undefined, b: ResumeCronJobRequest | PlainMessage<ResumeCronJobRequest> | undefined): boolean {
+    return proto3.util.equals(ResumeCronJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ResumeCronJobResponse
+ */
+export class ResumeCronJobResponse extends Message<ResumeCronJobResponse> {
+  constructor(data?: PartialMessage<ResumeCronJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ResumeCronJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeCronJobResponse {
+    return new ResumeCronJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeCronJobResponse {
+    return new ResumeCronJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeCronJobResponse {
+    return new ResumeCronJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeCronJobResponse | PlainMessage<ResumeCronJobResponse> | undefined, b: ResumeCronJobResponse | PlainMessage<ResumeCronJobResponse> | undefined): boolean {
+    return proto3.util.equals(ResumeCronJobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TriggerCronJobRequest
+ */
+export class TriggerCronJobRequest extends Message<TriggerCronJobRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  constructor(data?: PartialMessage<TriggerCronJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TriggerCronJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerCronJobRequest | PlainMessage<TriggerCronJobRequest> | undefined, b: TriggerCronJobRequest | PlainMessage<TriggerCronJobRequest> | undefined): boolean {
+    return proto3.util.equals(TriggerCronJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TriggerCronJobResponse
+ */
+export class TriggerCronJobResponse extends Message<TriggerCronJobResponse> {
+  constructor(data?: PartialMessage<TriggerCronJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TriggerCronJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_Controller {
-    return new StatusResponse_Controller().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StatusResponse_Controller | PlainMessage<StatusResponse_Controller> | undefined, b: StatusResponse_Controller | PlainMessage<StatusResponse_Controller> | undefined): boolean {
-    return proto3.util.equals(StatusResponse_Controller, a, b);
+  static equals(a: TriggerCronJobResponse | PlainMessage<TriggerCronJobResponse> | undefined, b: TriggerCronJobResponse | PlainMessage<TriggerCronJobResponse> | undefined): boolean {
+    return proto3.util.equals(TriggerCronJobResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StatusResponse.Runner
+ * @generated from message xyz.block.ftl.v1.CronJobExecution
  */
-export class StatusResponse_Runner extends Message<StatusResponse_Runner> {
-  /**
-   * @generated from field: string key = 1;
-   */
-  key = "";
-
+export class CronJobExecution extends Message<CronJobExecution> {
   /**
-   * @generated from field: repeated string languages = 2;
+   * @generated from field: string request_key = 1;
    */
-  languages: string[] = [];
+  requestKey = "";
 
   /**
-   * @generated from field: string endpoint = 3;
+   * @generated from field: string deployment_key = 2;
    */
-  endpoint = "";
+  deploymentKey = "";
 
   /**
-   * @generated from field: xyz.block.ftl.v1.RunnerState state = 4;
+   * @generated from field: google.protobuf.Timestamp time_stamp = 3;
    */
-  state = RunnerState.RUNNER_IDLE;
+  timeStamp?: Timestamp;
 
   /**
-   * @generated from field: optional string deployment = 5;
+   * @generated from field: google.protobuf.Duration duration = 4;
    */
-  deployment?: string;
+  duration?: Duration;
 
   /**
-   * @generated from field: google.protobuf.Struct labels = 6;
+   * @generated from field: optional string error = 5;
    */
-  labels?: Struct;
+  error?: string;
 
-  constructor(data?: PartialMessage<StatusResponse_Runner>) {
+  constructor(data?: PartialMessage<CronJobExecution>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.Runner";
+  static readonly typeName = "xyz.block.ftl.v1.CronJobExecution";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "languages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 3, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RunnerState) },
-    { no: 5, name: "deployment", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 6, name: "labels", kind: "message", T: Struct },
+    { no: 1, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "time_stamp", kind: "message", T: Timestamp },
+    { no: 4, name: "duration", kind: "message", T: Duration },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Runner {
-    return new StatusResponse_Runner().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CronJobExecution {
+    return new CronJobExecution().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_Runner {
-    return new StatusResponse_Runner().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CronJobExecution {
+    return new CronJobExecution().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_Runner {
-    return new StatusResponse_Runner().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CronJobExecution {
+    return new CronJobExecution().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StatusResponse_Runner | PlainMessage<StatusResponse_Runner> | undefined, b: StatusResponse_Runner | PlainMessage<StatusResponse_Runner> | undefined): boolean {
-    return proto3.util.equals(StatusResponse_Runner, a, b);
+  static equals(a: CronJobExecution | PlainMessage<CronJobExecution> | undefined, b: CronJobExecution | PlainMessage<CronJobExecution> | undefined): boolean {
+    return proto3.util.equals(CronJobExecution, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StatusResponse.Deployment
+ * @generated from message xyz.block.ftl.v1.GetCronJobHistoryRequest
  */
-export class StatusResponse_Deployment extends Message<StatusResponse_Deployment> {
-  /**
-   * @generated from field: string key = 1;
-   */
-  key = "";
-
-  /**
-   * @generated from field: string language = 2;
-   */
-  language = "";
-
-  /**
-   * @generated from field: string name = 3;
-   */
-  name = "";
-
-  /**
-   * @generated from field: int32 min_replicas = 4;
-   */
-  minReplicas = 0;
-
+export class GetCronJobHistoryRequest extends Message<GetCronJobHistoryRequest> {
   /**
-   * @generated from field: int32 replicas = 7;
-   */
-  replicas = 0;
-
-  /**
-   * @generated from field: google.protobuf.Struct labels = 5;
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
    */
-  labels?: Struct;
+  verb?: Ref;
 
   /**
-   * @generated from field: xyz.block.ftl.v1.schema.Module schema = 6;
+   * Maximum number of executions to return, defaults to 10.
+   *
+   * @generated from field: int32 limit = 2;
    */
-  schema?: Module;
+  limit = 0;
 
-  constructor(data?: PartialMessage<StatusResponse_Deployment>) {
+  constructor(data?: PartialMessage<GetCronJobHistoryRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.Deployment";
+  static readonly typeName = "xyz.block.ftl.v1.GetCronJobHistoryRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 7, name: "replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "labels", kind: "message", T: Struct },
-    { no: 6, name: "schema", kind: "message", T: Module },
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Deployment {
-    return new StatusResponse_Deployment().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCronJobHistoryRequest {
+    return new GetCronJobHistoryRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_Deployment {
-    return new StatusResponse_Deployment().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCronJobHistoryRequest {
+    return new GetCronJobHistoryRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_Deployment {
-    return new StatusResponse_Deployment().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCronJobHistoryRequest {
+    return new GetCronJobHistoryRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StatusResponse_Deployment | PlainMessage<StatusResponse_Deployment> | undefined, b: StatusResponse_Deployment | PlainMessage<StatusResponse_Deployment> | undefined): boolean {
-    return proto3.util.equals(StatusResponse_Deployment, a, b);
+  static equals(a: GetCronJobHistoryRequest | PlainMessage<GetCronJobHistoryRequest> | undefined, b: GetCronJobHistoryRequest | PlainMessage<GetCronJobHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetCronJobHistoryRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StatusResponse.IngressRoute
+ * @generated from message xyz.block.ftl.v1.GetCronJobHistoryResponse
  */
-export class StatusResponse_IngressRoute extends Message<StatusResponse_IngressRoute> {
-  /**
-   * @generated from field: string deployment_key = 1;
-   */
-  deploymentKey = "";
-
-  /**
-   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 5;
-   */
-  verb?: Ref;
-
+export class GetCronJobHistoryResponse extends Message<GetCronJobHistoryResponse> {
   /**
-   * @generated from field: string method = 3;
-   */
-  method = "";
-
-  /**
-   * @generated from field: string path = 4;
+   * Most recent first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.CronJobExecution executions = 1;
    */
-  path = "";
+  executions: CronJobExecution[] = [];
 
-  constructor(data?: PartialMessage<StatusResponse_IngressRoute>) {
+  constructor(data?: PartialMessage<GetCronJobHistoryResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.IngressRoute";
+  static readonly typeName = "xyz.block.ftl.v1.GetCronJobHistoryResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "verb", kind: "message", T: Ref },
-    { no: 3, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "executions", kind: "message", T: CronJobExecution, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_IngressRoute {
-    return new StatusResponse_IngressRoute().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCronJobHistoryResponse {
+    return new GetCronJobHistoryResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_IngressRoute {
-    return new StatusResponse_IngressRoute().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCronJobHistoryResponse {
+    return new GetCronJobHistoryResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_IngressRoute {
-    return new StatusResponse_IngressRoute().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCronJobHistoryResponse {
+    return new GetCronJobHistoryResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StatusResponse_IngressRoute | PlainMessage<StatusResponse_IngressRoute> | undefined, b: StatusResponse_IngressRoute | PlainMessage<StatusResponse_IngressRoute> | undefined): boolean {
-    return proto3.util.equals(StatusResponse_IngressRoute, a, b);
+  static equals(a: GetCronJobHistoryResponse | PlainMessage<GetCronJobHistoryResponse> | undefined, b: GetCronJobHistoryResponse | PlainMessage<GetCronJobHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetCronJobHistoryResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.StatusResponse.Route
+ * @generated from message xyz.block.ftl.v1.Lease
  */
-export class StatusResponse_Route extends Message<StatusResponse_Route> {
+export class Lease extends Message<Lease> {
   /**
-   * @generated from field: string module = 1;
+   * @generated from field: string key = 1;
    */
-  module = "";
+  key = "";
 
   /**
-   * @generated from field: string runner = 2;
+   * Set for leases acquired by modules.
+   *
+   * @generated from field: optional string module = 2;
    */
-  runner = "";
+  module?: string;
 
   /**
-   * @generated from field: string deployment = 3;
+   * The key passed by the module when acquiring the lease.
+   *
+   * @generated from field: repeated string user_key = 3;
    */
-  deployment = "";
+  userKey: string[] = [];
 
   /**
-   * @generated from field: string endpoint = 4;
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
    */
-  endpoint = "";
+  createdAt?: Timestamp;
 
-  constructor(data?: PartialMessage<StatusResponse_Route>) {
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: int64 fencing_token = 6;
+   */
+  fencingToken = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Lease>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.StatusResponse.Route";
+  static readonly typeName = "xyz.block.ftl.v1.Lease";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "runner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "deployment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "user_key", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+    { no: 5, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 6, name: "fencing_token", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Route {
-    return new StatusResponse_Route().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Lease {
+    return new Lease().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusResponse_Route {
-    return new StatusResponse_Route().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Lease {
+    return new Lease().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusResponse_Route {
-    return new StatusResponse_Route().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Lease {
+    return new Lease().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StatusResponse_Route | PlainMessage<StatusResponse_Route> | undefined, b: StatusResponse_Route | PlainMessage<StatusResponse_Route> | undefined): boolean {
-    return proto3.util.equals(StatusResponse_Route, a, b);
+  static equals(a: Lease | PlainMessage<Lease> | undefined, b: Lease | PlainMessage<Lease> | undefined): boolean {
+    return proto3.util.equals(Lease, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ProcessListRequest
+ * @generated from message xyz.block.ftl.v1.ListLeasesRequest
  */
-export class ProcessListRequest extends Message<ProcessListRequest> {
-  constructor(data?: PartialMessage<ProcessListRequest>) {
+export class ListLeasesRequest extends Message<ListLeasesRequest> {
+  /**
+   * Only list leases held by this module.
+   *
+   * @generated from field: optional string module = 1;
+   */
+  module?: string;
+
+  constructor(data?: PartialMessage<ListLeasesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ProcessListRequest";
+  static readonly typeName = "xyz.block.ftl.v1.ListLeasesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListRequest {
-    return new ProcessListRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLeasesRequest {
+    return new ListLeasesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessListRequest {
-    return new ProcessListRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLeasesRequest {
+    return new ListLeasesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessListRequest {
-    return new ProcessListRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLeasesRequest {
+    return new ListLeasesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ProcessListRequest | PlainMessage<ProcessListRequest> | undefined, b: ProcessListRequest | PlainMessage<ProcessListRequest> | undefined): boolean {
-    return proto3.util.equals(ProcessListRequest, a, b);
+  static equals(a: ListLeasesRequest | PlainMessage<ListLeasesRequest> | undefined, b: ListLeasesRequest | PlainMessage<ListLeasesRequest> | undefined): boolean {
+    return proto3.util.equals(ListLeasesRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ProcessListResponse
+ * @generated from message xyz.block.ftl.v1.ListLeasesResponse
  */
-export class ProcessListResponse extends Message<ProcessListResponse> {
+export class ListLeasesResponse extends Message<ListLeasesResponse> {
   /**
-   * @generated from field: repeated xyz.block.ftl.v1.ProcessListResponse.Process processes = 1;
+   * @generated from field: repeated xyz.block.ftl.v1.Lease leases = 1;
    */
-  processes: ProcessListResponse_Process[] = [];
+  leases: Lease[] = [];
 
-  constructor(data?: PartialMessage<ProcessListResponse>) {
+  constructor(data?: PartialMessage<ListLeasesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ProcessListResponse";
+  static readonly typeName = "xyz.block.ftl.v1.ListLeasesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "processes", kind: "message", T: ProcessListResponse_Process, repeated: true },
+    { no: 1, name: "leases", kind: "message", T: Lease, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListResponse {
-    return new ProcessListResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLeasesResponse {
+    return new ListLeasesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessListResponse {
-    return new ProcessListResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLeasesResponse {
+    return new ListLeasesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessListResponse {
-    return new ProcessListResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLeasesResponse {
+    return new ListLeasesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ProcessListResponse | PlainMessage<ProcessListResponse> | undefined, b: ProcessListResponse | PlainMessage<ProcessListResponse> | undefined): boolean {
-    return proto3.util.equals(ProcessListResponse, a, b);
+  static equals(a: ListLeasesResponse | PlainMessage<ListLeasesResponse> | undefined, b: ListLeasesResponse | PlainMessage<ListLeasesResponse> | undefined): boolean {
+    return proto3.util.equals(ListLeasesResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ProcessListResponse.ProcessRunner
+ * @generated from message xyz.block.ftl.v1.ReleaseLeaseRequest
  */
-export class ProcessListResponse_ProcessRunner extends Message<ProcessListResponse_ProcessRunner> {
+export class ReleaseLeaseRequest extends Message<ReleaseLeaseRequest> {
   /**
    * @generated from field: string key = 1;
    */
   key = "";
 
-  /**
-   * @generated from field: string endpoint = 2;
-   */
-  endpoint = "";
-
-  /**
-   * @generated from field: google.protobuf.Struct labels = 3;
-   */
-  labels?: Struct;
-
-  constructor(data?: PartialMessage<ProcessListResponse_ProcessRunner>) {
+  constructor(data?: PartialMessage<ReleaseLeaseRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ProcessListResponse.ProcessRunner";
+  static readonly typeName = "xyz.block.ftl.v1.ReleaseLeaseRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "labels", kind: "message", T: Struct },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListResponse_ProcessRunner {
-    return new ProcessListResponse_ProcessRunner().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseLeaseRequest {
+    return new ReleaseLeaseRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessListResponse_ProcessRunner {
-    return new ProcessListResponse_ProcessRunner().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseLeaseRequest {
+    return new ReleaseLeaseRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessListResponse_ProcessRunner {
-    return new ProcessListResponse_ProcessRunner().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseLeaseRequest {
+    return new ReleaseLeaseRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ProcessListResponse_ProcessRunner | PlainMessage<ProcessListResponse_ProcessRunner> | undefined, b: ProcessListResponse_ProcessRunner | PlainMessage<ProcessListResponse_ProcessRunner> | undefined): boolean {
-    return proto3.util.equals(ProcessListResponse_ProcessRunner, a, b);
+  static equals(a: ReleaseLeaseRequest | PlainMessage<ReleaseLeaseRequest> | undefined, b: ReleaseLeaseRequest | PlainMessage<ReleaseLeaseRequest> | undefined): boolean {
+    return proto3.util.equals(ReleaseLeaseRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ProcessListResponse.Process
+ * @generated from message xyz.block.ftl.v1.ReleaseLeaseResponse
  */
-export class ProcessListResponse_Process extends Message<ProcessListResponse_Process> {
-  /**
-   * @generated from field: string deployment = 1;
-   */
-  deployment = "";
-
-  /**
-   * @generated from field: int32 min_replicas = 2;
-   */
-  minReplicas = 0;
-
-  /**
-   * @generated from field: google.protobuf.Struct labels = 3;
-   */
-  labels?: Struct;
-
-  /**
-   * @generated from field: optional xyz.block.ftl.v1.ProcessListResponse.ProcessRunner runner = 4;
-   */
-  runner?: ProcessListResponse_ProcessRunner;
-
-  constructor(data?: PartialMessage<ProcessListResponse_Process>) {
+export class ReleaseLeaseResponse extends Message<ReleaseLeaseResponse> {
+  constructor(data?: PartialMessage<ReleaseLeaseResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ProcessListResponse.Process";
+  static readonly typeName = "xyz.block.ftl.v1.ReleaseLeaseResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "labels", kind: "message", T: Struct },
-    { no: 4, name: "runner", kind: "message", T: ProcessListResponse_ProcessRunner, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListResponse_Process {
-    return new ProcessListResponse_Process().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseLeaseResponse {
+    return new ReleaseLeaseResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessListResponse_Process {
-    return new ProcessListResponse_Process().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseLeaseResponse {
+    return new ReleaseLeaseResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessListResponse_Process {
-    return new ProcessListResponse_Process().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseLeaseResponse {
+    return new ReleaseLeaseResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ProcessListResponse_Process | PlainMessage<ProcessListResponse_Process> | undefined, b: ProcessListResponse_Process | PlainMessage<ProcessListResponse_Process> | undefined): boolean {
-    return proto3.util.equals(ProcessListResponse_Process, a, b);
+  static equals(a: ReleaseLeaseResponse | PlainMessage<ReleaseLeaseResponse> | undefined, b: ReleaseLeaseResponse | PlainMessage<ReleaseLeaseResponse> | undefined): boolean {
+    return proto3.util.equals(ReleaseLeaseResponse, a, b);
   }
 }
 
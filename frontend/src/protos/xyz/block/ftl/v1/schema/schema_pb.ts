@@ -401,6 +401,18 @@ export class Decl extends Message<Decl> {
      */
     value: FSM;
     case: "fsm";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.Topic topic = 9;
+     */
+    value: Topic;
+    case: "topic";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.Subscription subscription = 10;
+     */
+    value: Subscription;
+    case: "subscription";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Decl>) {
@@ -419,6 +431,8 @@ export class Decl extends Message<Decl> {
     { no: 6, name: "config", kind: "message", T: Config, oneof: "value" },
     { no: 7, name: "secret", kind: "message", T: Secret, oneof: "value" },
     { no: 8, name: "fsm", kind: "message", T: FSM, oneof: "value" },
+    { no: 9, name: "topic", kind: "message", T: Topic, oneof: "value" },
+    { no: 10, name: "subscription", kind: "message", T: Subscription, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Decl {
@@ -999,6 +1013,11 @@ export class IngressPathParameter extends Message<IngressPathParameter> {
    */
   name = "";
 
+  /**
+   * @generated from field: bool wildcard = 3;
+   */
+  wildcard = false;
+
   constructor(data?: PartialMessage<IngressPathParameter>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1009,6 +1028,7 @@ export class IngressPathParameter extends Message<IngressPathParameter> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "pos", kind: "message", T: Position, opt: true },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "wildcard", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngressPathParameter {
@@ -1200,6 +1220,42 @@ export class Metadata extends Message<Metadata> {
      */
     value: MetadataRetry;
     case: "retry";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataSubscriber subscriber = 7;
+     */
+    value: MetadataSubscriber;
+    case: "subscriber";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataAuth auth = 8;
+     */
+    value: MetadataAuth;
+    case: "auth";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataStream stream = 9;
+     */
+    value: MetadataStream;
+    case: "stream";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataCORS cors = 10;
+     */
+    value: MetadataCORS;
+    case: "cors";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataCache cache = 11;
+     */
+    value: MetadataCache;
+    case: "cache";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataRateLimit rateLimit = 12;
+     */
+    value: MetadataRateLimit;
+    case: "rateLimit";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Metadata>) {
@@ -1216,6 +1272,12 @@ export class Metadata extends Message<Metadata> {
     { no: 4, name: "databases", kind: "message", T: MetadataDatabases, oneof: "value" },
     { no: 5, name: "alias", kind: "message", T: MetadataAlias, oneof: "value" },
     { no: 6, name: "retry", kind: "message", T: MetadataRetry, oneof: "value" },
+    { no: 7, name: "subscriber", kind: "message", T: MetadataSubscriber, oneof: "value" },
+    { no: 8, name: "auth", kind: "message", T: MetadataAuth, oneof: "value" },
+    { no: 9, name: "stream", kind: "message", T: MetadataStream, oneof: "value" },
+    { no: 10, name: "cors", kind: "message", T: MetadataCORS, oneof: "value" },
+    { no: 11, name: "cache", kind: "message", T: MetadataCache, oneof: "value" },
+    { no: 12, name: "rateLimit", kind: "message", T: MetadataRateLimit, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Metadata {
@@ -1284,6 +1346,159 @@ export class MetadataAlias extends Message<MetadataAlias> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataAuth
+ */
+export class MetadataAuth extends Message<MetadataAuth> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string method = 2;
+   */
+  method = "";
+
+  constructor(data?: PartialMessage<MetadataAuth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataAuth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataAuth {
+    return new MetadataAuth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataAuth {
+    return new MetadataAuth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataAuth {
+    return new MetadataAuth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataAuth | PlainMessage<MetadataAuth> | undefined, b: MetadataAuth | PlainMessage<MetadataAuth> | undefined): boolean {
+    return proto3.util.equals(MetadataAuth, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataCORS
+ */
+export class MetadataCORS extends Message<MetadataCORS> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated string allowOrigins = 2;
+   */
+  allowOrigins: string[] = [];
+
+  /**
+   * @generated from field: repeated string allowMethods = 3;
+   */
+  allowMethods: string[] = [];
+
+  /**
+   * @generated from field: repeated string allowHeaders = 4;
+   */
+  allowHeaders: string[] = [];
+
+  /**
+   * @generated from field: bool allowCredentials = 5;
+   */
+  allowCredentials = false;
+
+  /**
+   * @generated from field: int64 maxAge = 6;
+   */
+  maxAge = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MetadataCORS>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataCORS";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "allowOrigins", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "allowMethods", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "allowHeaders", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "allowCredentials", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "maxAge", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCORS {
+    return new MetadataCORS().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataCORS {
+    return new MetadataCORS().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataCORS {
+    return new MetadataCORS().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataCORS | PlainMessage<MetadataCORS> | undefined, b: MetadataCORS | PlainMessage<MetadataCORS> | undefined): boolean {
+    return proto3.util.equals(MetadataCORS, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataCache
+ */
+export class MetadataCache extends Message<MetadataCache> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string ttl = 2;
+   */
+  ttl = "";
+
+  constructor(data?: PartialMessage<MetadataCache>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataCache";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "ttl", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCache {
+    return new MetadataCache().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataCache {
+    return new MetadataCache().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataCache {
+    return new MetadataCache().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataCache | PlainMessage<MetadataCache> | undefined, b: MetadataCache | PlainMessage<MetadataCache> | undefined): boolean {
+    return proto3.util.equals(MetadataCache, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataCalls
  */
@@ -1341,6 +1556,26 @@ export class MetadataCronJob extends Message<MetadataCronJob> {
    */
   cron = "";
 
+  /**
+   * @generated from field: string concurrency = 3;
+   */
+  concurrency = "";
+
+  /**
+   * @generated from field: string jitter = 4;
+   */
+  jitter = "";
+
+  /**
+   * @generated from field: string catchup = 5;
+   */
+  catchup = "";
+
+  /**
+   * @generated from field: int64 maxCatchup = 6;
+   */
+  maxCatchup = protoInt64.zero;
+
   constructor(data?: PartialMessage<MetadataCronJob>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1351,6 +1586,10 @@ export class MetadataCronJob extends Message<MetadataCronJob> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "pos", kind: "message", T: Position, opt: true },
     { no: 2, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "concurrency", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "jitter", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "catchup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "maxCatchup", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCronJob {
@@ -1437,6 +1676,11 @@ export class MetadataIngress extends Message<MetadataIngress> {
    */
   path: IngressPathComponent[] = [];
 
+  /**
+   * @generated from field: string host = 5;
+   */
+  host = "";
+
   constructor(data?: PartialMessage<MetadataIngress>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1449,6 +1693,7 @@ export class MetadataIngress extends Message<MetadataIngress> {
     { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "path", kind: "message", T: IngressPathComponent, repeated: true },
+    { no: 5, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataIngress {
@@ -1468,6 +1713,61 @@ export class MetadataIngress extends Message<MetadataIngress> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataRateLimit
+ */
+export class MetadataRateLimit extends Message<MetadataRateLimit> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 limit = 2;
+   */
+  limit = protoInt64.zero;
+
+  /**
+   * @generated from field: string period = 3;
+   */
+  period = "";
+
+  /**
+   * @generated from field: string by = 4;
+   */
+  by = "";
+
+  constructor(data?: PartialMessage<MetadataRateLimit>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataRateLimit";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "period", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataRateLimit | PlainMessage<MetadataRateLimit> | undefined, b: MetadataRateLimit | PlainMessage<MetadataRateLimit> | undefined): boolean {
+    return proto3.util.equals(MetadataRateLimit, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataRetry
  */
@@ -1523,6 +1823,86 @@ export class MetadataRetry extends Message<MetadataRetry> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataStream
+ */
+export class MetadataStream extends Message<MetadataStream> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  constructor(data?: PartialMessage<MetadataStream>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataStream";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataStream {
+    return new MetadataStream().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataStream {
+    return new MetadataStream().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataStream {
+    return new MetadataStream().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataStream | PlainMessage<MetadataStream> | undefined, b: MetadataStream | PlainMessage<MetadataStream> | undefined): boolean {
+    return proto3.util.equals(MetadataStream, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataSubscriber
+ */
+export class MetadataSubscriber extends Message<MetadataSubscriber> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<MetadataSubscriber>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataSubscriber";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataSubscriber {
+    return new MetadataSubscriber().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataSubscriber {
+    return new MetadataSubscriber().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataSubscriber {
+    return new MetadataSubscriber().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataSubscriber | PlainMessage<MetadataSubscriber> | undefined, b: MetadataSubscriber | PlainMessage<MetadataSubscriber> | undefined): boolean {
+    return proto3.util.equals(MetadataSubscriber, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Module
  */
@@ -1915,6 +2295,61 @@ export class StringValue extends Message<StringValue> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.Subscription
+ */
+export class Subscription extends Message<Subscription> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated string comments = 2;
+   */
+  comments: string[] = [];
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref topic = 4;
+   */
+  topic?: Ref;
+
+  constructor(data?: PartialMessage<Subscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.Subscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "topic", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Subscription {
+    return new Subscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Subscription {
+    return new Subscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Subscription {
+    return new Subscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Subscription | PlainMessage<Subscription> | undefined, b: Subscription | PlainMessage<Subscription> | undefined): boolean {
+    return proto3.util.equals(Subscription, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Time
  */
@@ -1952,6 +2387,67 @@ export class Time extends Message<Time> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.Topic
+ */
+export class Topic extends Message<Topic> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated string comments = 2;
+   */
+  comments: string[] = [];
+
+  /**
+   * @generated from field: bool export = 3;
+   */
+  export = false;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Type event = 5;
+   */
+  event?: Type;
+
+  constructor(data?: PartialMessage<Topic>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.Topic";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "export", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "event", kind: "message", T: Type },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Topic {
+    return new Topic().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Topic {
+    return new Topic().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Topic {
+    return new Topic().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Topic | PlainMessage<Topic> | undefined, b: Topic | PlainMessage<Topic> | undefined): boolean {
+    return proto3.util.equals(Topic, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Type
  */
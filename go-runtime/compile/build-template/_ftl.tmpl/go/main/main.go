@@ -32,7 +32,9 @@ func init() {
 func main() {
 	verbConstructor := server.NewUserVerbServer("{{.Name}}",
 {{- range .Verbs}}
-	{{- if and .HasRequest .HasResponse}}
+	{{- if .IsStream}}
+		server.HandleStream({{$.Name}}.{{.Name}}),
+	{{- else if and .HasRequest .HasResponse}}
 		server.HandleCall({{$.Name}}.{{.Name}}),
 	{{- else if .HasRequest}}
 		server.HandleSink({{$.Name}}.{{.Name}}),
@@ -45,6 +45,7 @@ type goVerb struct {
 	Name        string
 	HasRequest  bool
 	HasResponse bool
+	IsStream    bool
 }
 
 type mainModuleContext struct {
@@ -172,7 +173,7 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema, filesTrans
 				return fmt.Errorf("missing native name for verb %s", verb.Name)
 			}
 
-			goverb := goVerb{Name: nativeName}
+			goverb := goVerb{Name: nativeName, IsStream: verb.Kind() == schema.VerbKindStream}
 			if _, ok := verb.Request.(*schema.Unit); !ok {
 				goverb.HasRequest = true
 			}
@@ -291,6 +292,11 @@ var scaffoldFuncs = scaffolder.FuncMap{
 			case *schema.Optional, *schema.Unit:
 				imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = ""
 
+			case *schema.Topic:
+				if n.IsExported() {
+					imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = ""
+				}
+
 			default:
 			}
 			return next()
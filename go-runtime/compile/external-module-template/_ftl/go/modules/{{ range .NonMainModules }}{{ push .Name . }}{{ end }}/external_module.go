@@ -41,6 +41,9 @@ type {{.Name|title}} {{type $ .Value.Value}}
 {{end}}
 func ({{.Name|title}}) {{$enumInterfaceFuncName}}() {}
 {{- end}}
+{{- else if is "Topic" .}}
+//ftl:export
+var {{.Name|title}} = ftl.Topic[{{type $ .Event}}]("{{.Name}}")
 {{- else if is "TypeAlias" .}}
 //ftl:typealias
 type {{.Name|title}} {{type $ .Type}}
@@ -104,31 +104,123 @@ func (d *directiveTypeAlias) IsExported() bool {
 type directiveIngress struct {
 	Pos schema.Position
 
-	Type   string                        `parser:"'ingress' @('http')?"`
-	Method string                        `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')"`
+	Type   string                        `parser:"'ingress' @('http' | 'ws')?"`
+	Method string                        `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')?"`
 	Path   []schema.IngressPathComponent `parser:"('/' @@)+"`
+	Host   string                        `parser:"('host' @String)?"`
+	Auth   string                        `parser:"('auth' '=' @('jwt' | 'apikey'))?"`
 }
 
 func (*directiveIngress) directive() {}
 func (d *directiveIngress) String() string {
 	w := &strings.Builder{}
-	fmt.Fprintf(w, "ftl:ingress %s", d.Method)
+	w.WriteString("ftl:ingress")
+	if d.Type == "ws" {
+		w.WriteString(" ws")
+	}
+	if d.Method != "" {
+		fmt.Fprintf(w, " %s", d.Method)
+	}
+	w.WriteString(" ")
 	for _, p := range d.Path {
 		fmt.Fprintf(w, "/%s", p)
 	}
+	if d.Host != "" {
+		fmt.Fprintf(w, " host %q", d.Host)
+	}
+	if d.Auth != "" {
+		fmt.Fprintf(w, " auth=%s", d.Auth)
+	}
 	return w.String()
 }
 
+type directiveCORS struct {
+	Pos schema.Position
+
+	AllowOrigins     []string `parser:"'cors' @String (',' @String)*"`
+	AllowMethods     []string `parser:"('methods' @('GET' | 'POST' | 'PUT' | 'DELETE') (',' @('GET' | 'POST' | 'PUT' | 'DELETE'))*)?"`
+	AllowHeaders     []string `parser:"('headers' @String (',' @String)*)?"`
+	AllowCredentials bool     `parser:"@'credentials'?"`
+	MaxAge           int      `parser:"('maxAge' @Number)?"`
+}
+
+func (*directiveCORS) directive() {}
+
+func (d *directiveCORS) String() string {
+	return "ftl:" + strings.TrimPrefix(d.metadata().String(), "+")
+}
+
+func (d *directiveCORS) metadata() *schema.MetadataCORS {
+	return &schema.MetadataCORS{
+		Pos:              d.Pos,
+		AllowOrigins:     d.AllowOrigins,
+		AllowMethods:     d.AllowMethods,
+		AllowHeaders:     d.AllowHeaders,
+		AllowCredentials: d.AllowCredentials,
+		MaxAge:           d.MaxAge,
+	}
+}
+
+type directiveCache struct {
+	Pos schema.Position
+
+	TTL string `parser:"'cache' @(Number (?! Whitespace) Ident)"`
+}
+
+func (*directiveCache) directive() {}
+
+func (d *directiveCache) String() string {
+	return fmt.Sprintf("ftl:cache %s", d.TTL)
+}
+
+type directiveRateLimit struct {
+	Pos schema.Position
+
+	Limit  int    `parser:"'ratelimit' @Number '/'"`
+	Period string `parser:"@(Number (?! Whitespace) Ident | Ident)"`
+	By     string `parser:"('by' '=' @('ip' | 'caller' '-' 'module' | 'header' ':' Ident ('-' Ident)*))?"`
+}
+
+func (*directiveRateLimit) directive() {}
+
+func (d *directiveRateLimit) String() string {
+	return "ftl:" + strings.TrimPrefix(d.metadata().String(), "+")
+}
+
+func (d *directiveRateLimit) metadata() *schema.MetadataRateLimit {
+	return &schema.MetadataRateLimit{
+		Pos:    d.Pos,
+		Limit:  d.Limit,
+		Period: d.Period,
+		By:     d.By,
+	}
+}
+
 type directiveCronJob struct {
 	Pos schema.Position
 
-	Cron string `parser:"'cron' Whitespace @((' ' | Number | '-' | '/' | '*' | ',')+)"`
+	Cron        string `parser:"'cron' Whitespace @(('TZ' '=' Ident ((?! Whitespace) (Ident | Number | '/' | '-' | '+'))* ' ')? ('@' Ident (' ' Number ((?! Whitespace) (Number | Ident))*)? | (Number | '-' | '/' | '*' | ',') ((?! Whitespace) (Number | '-' | '/' | '*' | ','))* (' ' (Number | '-' | '/' | '*' | ',') ((?! Whitespace) (Number | '-' | '/' | '*' | ','))*)*))"`
+	Concurrency string `parser:"('concurrency' '=' @('forbid' | 'allow' | 'replace'))?"`
+	Jitter      string `parser:"('jitter' '=' @(Number (?! Whitespace) Ident))?"`
+	Catchup     string `parser:"('catchup' '=' @('none' | 'last' | 'all'))?"`
+	MaxCatchup  int    `parser:"(':' @Number)?"`
 }
 
 func (*directiveCronJob) directive() {}
 
 func (d *directiveCronJob) String() string {
-	return fmt.Sprintf("cron %s", d.Cron)
+	return strings.TrimPrefix(d.metadata().String(), "+")
+}
+
+func (d *directiveCronJob) metadata() *schema.MetadataCronJob {
+	return &schema.MetadataCronJob{
+		Pos:         d.Pos,
+		Cron:        d.Cron,
+		Concurrency: d.Concurrency,
+		Jitter:      d.Jitter,
+		Catchup:     d.Catchup,
+		MaxCatchup:  d.MaxCatchup,
+	}
 }
 
 type directiveRetry struct {
@@ -153,13 +245,37 @@ func (d *directiveRetry) String() string {
 	return strings.Join(components, " ")
 }
 
+type directiveExport struct {
+	Pos schema.Position
+
+	Export bool `parser:"@'export'"`
+}
+
+func (*directiveExport) directive() {}
+
+func (d *directiveExport) String() string {
+	return "ftl:export"
+}
+
+type directiveSubscriber struct {
+	Pos schema.Position
+
+	Name string `parser:"'subscribe' @Ident"`
+}
+
+func (*directiveSubscriber) directive() {}
+
+func (d *directiveSubscriber) String() string {
+	return fmt.Sprintf("subscribe %s", d.Name)
+}
+
 var directiveParser = participle.MustBuild[directiveWrapper](
 	participle.Lexer(schema.Lexer),
 	participle.Elide("Whitespace"),
 	participle.Unquote(),
 	participle.UseLookahead(2),
 	participle.Union[directive](&directiveVerb{}, &directiveData{}, &directiveEnum{}, &directiveTypeAlias{},
-		&directiveIngress{}, &directiveCronJob{}, &directiveRetry{}),
+		&directiveIngress{}, &directiveCORS{}, &directiveCache{}, &directiveRateLimit{}, &directiveCronJob{}, &directiveRetry{}, &directiveExport{}, &directiveSubscriber{}),
 	participle.Union[schema.IngressPathComponent](&schema.IngressPathLiteral{}, &schema.IngressPathParameter{}),
 )
 
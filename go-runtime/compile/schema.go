@@ -34,16 +34,19 @@ var (
 		return mustLoadRef("builtin", "error").Type().Underlying().(*types.Interface) //nolint:forcetypeassert
 	})
 
-	ftlCallFuncPath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Call"
-	ftlFSMFuncPath        = "github.com/TBD54566975/ftl/go-runtime/ftl.FSM"
-	ftlTransitionFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Transition"
-	ftlStartFuncPath      = "github.com/TBD54566975/ftl/go-runtime/ftl.Start"
-	ftlConfigFuncPath     = "github.com/TBD54566975/ftl/go-runtime/ftl.Config"
-	ftlSecretFuncPath     = "github.com/TBD54566975/ftl/go-runtime/ftl.Secret" //nolint:gosec
-	ftlPostgresDBFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.PostgresDatabase"
-	ftlUnitTypePath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Unit"
-	ftlOptionTypePath     = "github.com/TBD54566975/ftl/go-runtime/ftl.Option"
-	aliasFieldTag         = "json"
+	ftlCallFuncPath         = "github.com/TBD54566975/ftl/go-runtime/ftl.Call"
+	ftlFSMFuncPath          = "github.com/TBD54566975/ftl/go-runtime/ftl.FSM"
+	ftlTransitionFuncPath   = "github.com/TBD54566975/ftl/go-runtime/ftl.Transition"
+	ftlStartFuncPath        = "github.com/TBD54566975/ftl/go-runtime/ftl.Start"
+	ftlConfigFuncPath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Config"
+	ftlSecretFuncPath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Secret" //nolint:gosec
+	ftlPostgresDBFuncPath   = "github.com/TBD54566975/ftl/go-runtime/ftl.PostgresDatabase"
+	ftlTopicFuncPath        = "github.com/TBD54566975/ftl/go-runtime/ftl.Topic"
+	ftlSubscriptionFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Subscription"
+	ftlUnitTypePath         = "github.com/TBD54566975/ftl/go-runtime/ftl.Unit"
+	ftlOptionTypePath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Option"
+	ftlStreamTypePath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Stream"
+	aliasFieldTag           = "json"
 )
 
 // NativeNames is a map of top-level declarations to their native Go names.
@@ -294,7 +297,7 @@ func extractTypeDeclsForNode(pctx *parseContext, node *ast.GenDecl) {
 			pctx.module.Decls = append(pctx.module.Decls, alias)
 			pctx.nativeNames[alias] = nativeName
 			foundDeclType = optional.Some("type alias")
-		case *directiveData, *directiveIngress, *directiveVerb, *directiveCronJob, *directiveRetry:
+		case *directiveData, *directiveIngress, *directiveVerb, *directiveCORS, *directiveCache, *directiveRateLimit, *directiveCronJob, *directiveRetry, *directiveExport, *directiveSubscriber:
 			continue
 		}
 		if foundDeclType, ok := foundDeclType.Get(); ok {
@@ -324,6 +327,12 @@ func visitCallExpr(pctx *parseContext, node *ast.CallExpr) {
 
 	case ftlPostgresDBFuncPath:
 		parseDatabaseDecl(pctx, node, schema.PostgresDatabaseType)
+
+	case ftlTopicFuncPath:
+		parseTopicDecl(pctx, node)
+
+	case ftlSubscriptionFuncPath:
+		parseSubscriptionDecl(pctx, node)
 	}
 }
 
@@ -561,6 +570,162 @@ func parseDatabaseDecl(pctx *parseContext, node *ast.CallExpr, dbType string) {
 	pctx.module.Decls = append(pctx.module.Decls, decl)
 }
 
+func parseTopicDecl(pctx *parseContext, node *ast.CallExpr) {
+	name, ok := parseTopicName(node)
+	if !ok {
+		pctx.errors.add(errorf(node, "first argument to a topic declaration must be the name as a string literal"))
+		return
+	}
+	if !schema.ValidateName(name) {
+		pctx.errors.add(errorf(node, "topic names must be valid identifiers"))
+	}
+
+	// Topics are exported by annotating the enclosing var declaration with //ftl:export
+	isExported := false
+	_, path, _ := pctx.pathEnclosingInterval(node.Pos(), node.End())
+	for _, n := range path {
+		genDecl, ok := n.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		directives, err := parseDirectives(genDecl, fset, genDecl.Doc)
+		if err != nil {
+			pctx.errors.add(err)
+		}
+		for _, dir := range directives {
+			if _, ok := dir.(*directiveExport); ok {
+				isExported = true
+			}
+		}
+		break
+	}
+
+	index, ok := node.Fun.(*ast.IndexExpr)
+	if !ok {
+		pctx.errors.add(errorf(node, "topic declarations must have an explicit event type, eg. ftl.Topic[Event](\"name\")"))
+		return
+	}
+	tp := pctx.pkg.TypesInfo.Types[index.Index].Type
+	event, ok := visitType(pctx, index.Index.Pos(), tp, isExported).Get()
+	if !ok {
+		pctx.errors.add(errorf(index.Index, "unsupported event type %q", tp))
+		return
+	}
+
+	// Check for duplicates
+	_, endCol := goNodePosToSchemaPos(node)
+	for _, d := range pctx.module.Decls {
+		t, ok := d.(*schema.Topic)
+		if ok && t.Name == name {
+			pctx.errors.add(errorf(node, "duplicate topic declaration at %d:%d-%d", t.Pos.Line, t.Pos.Column, endCol))
+			return
+		}
+	}
+
+	pctx.module.Decls = append(pctx.module.Decls, &schema.Topic{
+		Pos:    goPosToSchemaPos(node.Pos()),
+		Export: isExported,
+		Name:   name,
+		Event:  event,
+	})
+}
+
+// parseTopicName extracts the topic name from a call to ftl.Topic.
+func parseTopicName(node *ast.CallExpr) (string, bool) {
+	if len(node.Args) != 1 {
+		return "", false
+	}
+	literal, ok := node.Args[0].(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", false
+	}
+	name, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		panic(err) // Should never happen
+	}
+	return name, true
+}
+
+func parseSubscriptionDecl(pctx *parseContext, node *ast.CallExpr) {
+	if len(node.Args) != 2 {
+		pctx.errors.add(errorf(node, "subscription declarations must have a topic and a name"))
+		return
+	}
+	topicRef := parseTopicRef(pctx, node.Args[0])
+	if topicRef == nil {
+		pctx.errors.add(errorf(node.Args[0], "first argument to a subscription declaration must be a topic declared with ftl.Topic"))
+		return
+	}
+	literal, ok := node.Args[1].(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		pctx.errors.add(errorf(node.Args[1], "second argument to a subscription declaration must be the name as a string literal"))
+		return
+	}
+	name, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		panic(err) // Should never happen
+	}
+	if !schema.ValidateName(name) {
+		pctx.errors.add(errorf(node, "subscription names must be valid identifiers"))
+	}
+
+	// Check for duplicates
+	_, endCol := goNodePosToSchemaPos(node)
+	for _, d := range pctx.module.Decls {
+		s, ok := d.(*schema.Subscription)
+		if ok && s.Name == name {
+			pctx.errors.add(errorf(node, "duplicate subscription declaration at %d:%d-%d", s.Pos.Line, s.Pos.Column, endCol))
+			return
+		}
+	}
+
+	pctx.module.Decls = append(pctx.module.Decls, &schema.Subscription{
+		Pos:   goPosToSchemaPos(node.Pos()),
+		Name:  name,
+		Topic: topicRef,
+	})
+}
+
+// parseTopicRef resolves a reference to a topic handle variable.
+//
+// If the declaration of the variable is available its name is taken from the
+// call to ftl.Topic, otherwise it is derived from the variable name, matching
+// the generated stubs for external modules.
+func parseTopicRef(pctx *parseContext, node ast.Expr) *schema.Ref {
+	_, topicVar := deref[*types.Var](pctx.pkg, node)
+	if topicVar == nil {
+		return nil
+	}
+	moduleName, ok := ftlModuleFromGoModule(topicVar.Pkg().Path()).Get()
+	if !ok {
+		return nil
+	}
+	ref := &schema.Ref{
+		Pos:    goPosToSchemaPos(node.Pos()),
+		Module: moduleName,
+		Name:   strcase.ToLowerCamel(topicVar.Name()),
+	}
+	_, path, _ := pctx.pathEnclosingInterval(topicVar.Pos(), topicVar.Pos())
+	for _, n := range path {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+		for i, ident := range spec.Names {
+			if ident.Pos() != topicVar.Pos() || i >= len(spec.Values) {
+				continue
+			}
+			if call, ok := spec.Values[i].(*ast.CallExpr); ok {
+				if name, ok := parseTopicName(call); ok {
+					ref.Name = name
+				}
+			}
+		}
+		break
+	}
+	return ref
+}
+
 func visitFile(pctx *parseContext, node *ast.File) {
 	if node.Doc == nil {
 		return
@@ -576,20 +741,28 @@ func isType[T types.Type](t types.Type) bool {
 	return ok
 }
 
-func checkSignature(pctx *parseContext, node *ast.FuncDecl, sig *types.Signature) (req, resp optional.Option[*types.Var]) {
+// checkSignature checks the signature of a verb, returning its request and
+// response, and for streaming verbs the element type of its ftl.Stream.
+func checkSignature(pctx *parseContext, node *ast.FuncDecl, sig *types.Signature) (req, resp optional.Option[*types.Var], stream optional.Option[types.Type]) {
 	params := sig.Params()
 	results := sig.Results()
 
-	if params.Len() > 2 {
+	if params.Len() == 3 {
+		stream = streamElementType(params.At(2).Type())
+	}
+	if params.Len() > 3 || (params.Len() == 3 && !stream.Ok()) {
 		pctx.errors.add(errorf(node, "must have at most two parameters (context.Context, struct)"))
 	}
+	if stream.Ok() && results.Len() != 1 {
+		pctx.errors.add(errorf(node, "streaming verbs must only return an error"))
+	}
 	if params.Len() == 0 {
 		pctx.errors.add(errorf(node, "first parameter must be context.Context"))
 	} else if !types.AssertableTo(contextIfaceType(), params.At(0).Type()) {
 		pctx.errors.add(tokenErrorf(params.At(0).Pos(), params.At(0).Name(), "first parameter must be of type context.Context but is %s", params.At(0).Type()))
 	}
 
-	if params.Len() == 2 {
+	if params.Len() == 2 || stream.Ok() {
 		if !isType[*types.Struct](params.At(1).Type()) {
 			pctx.errors.add(tokenErrorf(params.At(1).Pos(), params.At(1).Name(), "second parameter must be a struct but is %s", params.At(1).Type()))
 		}
@@ -617,7 +790,19 @@ func checkSignature(pctx *parseContext, node *ast.FuncDecl, sig *types.Signature
 		}
 		resp = optional.Some(results.At(0))
 	}
-	return req, resp
+	return req, resp, stream
+}
+
+// streamElementType returns T if t is ftl.Stream[T].
+func streamElementType(t types.Type) optional.Option[types.Type] {
+	named, ok := t.(*types.Named)
+	if !ok || named.TypeArgs().Len() != 1 {
+		return optional.None[types.Type]()
+	}
+	if named.Origin().Obj().Pkg() == nil || named.Origin().Obj().Pkg().Path()+"."+named.Origin().Obj().Name() != ftlStreamTypePath {
+		return optional.None[types.Type]()
+	}
+	return optional.Some(named.TypeArgs().At(0))
 }
 
 func goPosToSchemaPos(pos token.Pos) schema.Position {
@@ -707,7 +892,7 @@ func visitGenDecl(pctx *parseContext, node *ast.GenDecl) {
 						visitType(pctx, node.Pos(), pctx.pkg.TypesInfo.Defs[t.Name].Type(), isExported)
 					}
 				}
-			case *directiveIngress, *directiveCronJob, *directiveRetry:
+			case *directiveIngress, *directiveCORS, *directiveCache, *directiveRateLimit, *directiveCronJob, *directiveRetry, *directiveExport, *directiveSubscriber:
 			}
 		}
 		return
@@ -992,14 +1177,27 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb) {
 				Type:   typ,
 				Method: dir.Method,
 				Path:   dir.Path,
+				Host:   dir.Host,
 			})
+			if dir.Auth != "" {
+				metadata = append(metadata, &schema.MetadataAuth{
+					Pos:    dir.Pos,
+					Method: dir.Auth,
+				})
+			}
+		case *directiveCORS:
+			metadata = append(metadata, dir.metadata())
+		case *directiveCache:
+			metadata = append(metadata, &schema.MetadataCache{
+				Pos: dir.Pos,
+				TTL: dir.TTL,
+			})
+		case *directiveRateLimit:
+			metadata = append(metadata, dir.metadata())
 		case *directiveCronJob:
 			isVerb = true
 			isExported = false
-			metadata = append(metadata, &schema.MetadataCronJob{
-				Pos:  dir.Pos,
-				Cron: dir.Cron,
-			})
+			metadata = append(metadata, dir.metadata())
 		case *directiveRetry:
 			metadata = append(metadata, &schema.MetadataRetry{
 				Pos:        dir.Pos,
@@ -1007,7 +1205,13 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb) {
 				MinBackoff: dir.MinBackoff,
 				MaxBackoff: dir.MaxBackoff,
 			})
-		case *directiveData, *directiveEnum, *directiveTypeAlias:
+		case *directiveSubscriber:
+			isVerb = true
+			metadata = append(metadata, &schema.MetadataSubscriber{
+				Pos:  dir.Pos,
+				Name: dir.Name,
+			})
+		case *directiveData, *directiveEnum, *directiveTypeAlias, *directiveExport:
 			pctx.errors.add(errorf(node, "unexpected directive %T", dir))
 		}
 	}
@@ -1030,7 +1234,7 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb) {
 	}
 	params := sig.Params()
 	results := sig.Results()
-	reqt, respt := checkSignature(pctx, node, sig)
+	reqt, respt, streamt := checkSignature(pctx, node, sig)
 
 	var req optional.Option[schema.Type]
 	if reqt.Ok() {
@@ -1039,7 +1243,10 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb) {
 		req = optional.Some[schema.Type](&schema.Unit{})
 	}
 	var resp optional.Option[schema.Type]
-	if respt.Ok() {
+	if elem, ok := streamt.Get(); ok {
+		resp = visitType(pctx, node.Pos(), elem, isExported)
+		metadata = append(metadata, &schema.MetadataStream{Pos: goPosToSchemaPos(node.Pos())})
+	} else if respt.Ok() {
 		resp = visitType(pctx, node.Pos(), results.At(0).Type(), isExported)
 	} else {
 		resp = optional.Some[schema.Type](&schema.Unit{})
@@ -1051,8 +1258,13 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb) {
 			"unsupported request type %q", params.At(1).Type()))
 	}
 	if !respOk {
-		pctx.errors.add(tokenErrorf(results.At(0).Pos(), results.At(0).Name(),
-			"unsupported response type %q", results.At(0).Type()))
+		if elem, ok := streamt.Get(); ok {
+			pctx.errors.add(tokenErrorf(params.At(2).Pos(), params.At(2).Name(),
+				"unsupported stream type %q", elem))
+		} else {
+			pctx.errors.add(tokenErrorf(results.At(0).Pos(), results.At(0).Name(),
+				"unsupported response type %q", results.At(0).Type()))
+		}
 	}
 	verb = &schema.Verb{
 		Pos:      goPosToSchemaPos(node.Pos()),
@@ -1259,7 +1471,7 @@ func visitType(pctx *parseContext, pos token.Pos, tnode types.Type, isExported b
 			switch decl.(type) {
 			case *schema.TypeAlias, *schema.Enum:
 				return visitNamedRef(pctx, pos, named, isExported)
-			case *schema.Data, *schema.Verb, *schema.Config, *schema.Secret, *schema.Database, *schema.FSM:
+			case *schema.Data, *schema.Verb, *schema.Config, *schema.Secret, *schema.Database, *schema.FSM, *schema.Topic, *schema.Subscription:
 			}
 		}
 	}
@@ -1560,7 +1772,9 @@ func (p *parseContext) markAsExported(node schema.Node) {
 				decl.Export = true
 			case *schema.Verb:
 				decl.Export = true
-			case *schema.Config, *schema.Secret, *schema.Database, *schema.FSM:
+			case *schema.Topic:
+				decl.Export = true
+			case *schema.Config, *schema.Secret, *schema.Database, *schema.FSM, *schema.Subscription:
 				return next()
 			}
 		} else if r, ok := n.(*schema.Ref); ok {
@@ -1581,7 +1795,7 @@ func (p *parseContext) markAsExported(node schema.Node) {
 					if d.Name != r.Name {
 						continue
 					}
-				case *schema.Verb, *schema.Config, *schema.Secret, *schema.Database, *schema.FSM:
+				case *schema.Verb, *schema.Config, *schema.Secret, *schema.Database, *schema.FSM, *schema.Topic, *schema.Subscription:
 					// does not support implicit exporting
 					continue
 				default:
@@ -158,6 +158,8 @@ func TestExtractModuleSchema(t *testing.T) {
 
   export verb http(builtin.HttpRequest<one.Req>) builtin.HttpResponse<one.Resp, Unit>
     +ingress http GET /get
+    +cors "https://example.com" maxAge 600
+    +cache 5m
 
   export verb nothing(Unit) Unit
 
@@ -165,6 +167,10 @@ func TestExtractModuleSchema(t *testing.T) {
 
   verb source(Unit) one.SourceResp
 
+  export verb stream(builtin.HttpRequest<one.Req>) one.Resp
+    +ingress http GET /stream
+    +stream
+
   verb verb(one.Req) one.Resp
 }
 `
@@ -271,6 +277,32 @@ func TestExtractModuleSchemaFSM(t *testing.T) {
 	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
 }
 
+func TestExtractModuleSchemaPubSub(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	r, err := ExtractModuleSchema("testdata/pubsub", &schema.Schema{})
+	assert.NoError(t, err)
+	assert.Equal(t, r.MustGet().Errors, nil, "expected no schema errors")
+	actual := schema.Normalise(r.MustGet().Module)
+	expected := `module pubsub {
+		export topic payins pubsub.PayinEvent
+
+		subscription paymentProcessing pubsub.payins
+
+		export data PayinEvent {
+			name String
+		}
+
+		verb payin(Unit) Unit
+
+		verb processPayin(pubsub.PayinEvent) Unit
+			+subscribe paymentProcessing
+	}
+`
+	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
+}
+
 func TestExtractModuleSchemaNamedTypes(t *testing.T) {
 	prebuildTestModule(t, "testdata/named", "testdata/namedext")
 	if testing.Short() {
@@ -379,6 +411,62 @@ func TestParsedirectives(t *testing.T) {
 				},
 			},
 		}},
+		{name: "IngressAuth", input: `ftl:ingress http GET /users/{id} auth=jwt`, expected: &directiveIngress{
+			Type:   "http",
+			Method: "GET",
+			Path: []schema.IngressPathComponent{
+				&schema.IngressPathLiteral{
+					Text: "users",
+				},
+				&schema.IngressPathParameter{
+					Name: "id",
+				},
+			},
+			Auth: "jwt",
+		}},
+		{name: "IngressWildcardHost", input: `ftl:ingress GET /files/{path...} host "files.example.com"`, expected: &directiveIngress{
+			Method: "GET",
+			Path: []schema.IngressPathComponent{
+				&schema.IngressPathLiteral{Text: "files"},
+				&schema.IngressPathParameter{Name: "path", Wildcard: true},
+			},
+			Host: "files.example.com",
+		}},
+		{name: "IngressWebSocket", input: `ftl:ingress ws /chat/{room}`, expected: &directiveIngress{
+			Type: "ws",
+			Path: []schema.IngressPathComponent{
+				&schema.IngressPathLiteral{Text: "chat"},
+				&schema.IngressPathParameter{Name: "room"},
+			},
+		}},
+		{name: "CORS", input: `ftl:cors "https://example.com", "https://*.example.com" methods GET, POST headers "Authorization" credentials maxAge 600`, expected: &directiveCORS{
+			AllowOrigins:     []string{"https://example.com", "https://*.example.com"},
+			AllowMethods:     []string{"GET", "POST"},
+			AllowHeaders:     []string{"Authorization"},
+			AllowCredentials: true,
+			MaxAge:           600,
+		}},
+		{name: "CORSOrigin", input: `ftl:cors "*"`, expected: &directiveCORS{
+			AllowOrigins: []string{"*"},
+		}},
+		{name: "Cache", input: `ftl:cache 1h30m`, expected: &directiveCache{TTL: "1h30m"}},
+		{name: "RateLimit", input: `ftl:ratelimit 100/1m by=header:X-Api-Key`, expected: &directiveRateLimit{
+			Limit:  100,
+			Period: "1m",
+			By:     "header:X-Api-Key",
+		}},
+		{name: "CronTimeZone", input: `ftl:cron TZ=Australia/Sydney 0 9 * * 1-5`, expected: &directiveCronJob{Cron: "TZ=Australia/Sydney 0 9 * * 1-5"}},
+		{name: "CronDescriptor", input: `ftl:cron @daily`, expected: &directiveCronJob{Cron: "@daily"}},
+		{name: "CronEvery", input: `ftl:cron @every 15m`, expected: &directiveCronJob{Cron: "@every 15m"}},
+		{name: "CronOptions", input: `ftl:cron */5 * * * * concurrency=replace jitter=30s catchup=all:3`, expected: &directiveCronJob{
+			Cron:        "*/5 * * * *",
+			Concurrency: "replace",
+			Jitter:      "30s",
+			Catchup:     "all",
+			MaxCatchup:  3,
+		}},
+		{name: "CronDescriptorOptions", input: `ftl:cron @hourly catchup=none`, expected: &directiveCronJob{Cron: "@hourly", Catchup: "none"}},
+		{name: "RateLimitUnit", input: `ftl:ratelimit 10/s`, expected: &directiveRateLimit{Limit: 10, Period: "s"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -442,7 +530,7 @@ func TestErrorReporting(t *testing.T) {
 		`19:14-44: duplicate database declaration at 18:14-44`,
 		`22:2-10: unsupported type "error" for field "BadParam"`,
 		`25:2-17: unsupported type "uint64" for field "AnotherBadParam"`,
-		`28:3-3: unexpected token "export" (expected Directive)`,
+		`29:1-2: unexpected directive *compile.directiveExport`,
 		`34:36-39: unsupported request type "ftl/failing.Request"`,
 		`34:50-50: unsupported response type "ftl/failing.Response"`,
 		`35:16-29: call first argument must be a function but is an unresolved reference to lib.OtherFunc`,
@@ -165,10 +165,17 @@ func Nothing(ctx context.Context) error {
 }
 
 //ftl:ingress http GET /get
+//ftl:cors "https://example.com" maxAge 600
+//ftl:cache 5m
 func Http(ctx context.Context, req builtin.HttpRequest[Req]) (builtin.HttpResponse[Resp, ftl.Unit], error) {
 	return builtin.HttpResponse[Resp, ftl.Unit]{}, nil
 }
 
+//ftl:ingress http GET /stream
+func Stream(ctx context.Context, req builtin.HttpRequest[Req], stream ftl.Stream[Resp]) error {
+	return stream.Send(ctx, Resp{})
+}
+
 //ftl:data
 type DataWithType[T any] struct {
 	Value T
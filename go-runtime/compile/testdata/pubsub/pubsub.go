@@ -0,0 +1,27 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+)
+
+type PayinEvent struct {
+	Name string
+}
+
+//ftl:export
+var Payins = ftl.Topic[PayinEvent]("payins")
+
+var _ = ftl.Subscription(Payins, "paymentProcessing")
+
+//ftl:verb
+func Payin(ctx context.Context) error {
+	return Payins.Publish(ctx, PayinEvent{Name: "Test"})
+}
+
+//ftl:verb
+//ftl:subscribe paymentProcessing
+func ProcessPayin(ctx context.Context, event PayinEvent) error {
+	return nil
+}
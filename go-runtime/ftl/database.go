@@ -3,7 +3,9 @@ package ftl
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sync"
 
 	_ "github.com/jackc/pgx/v5/stdlib" // Register Postgres driver
 
@@ -34,3 +36,94 @@ func (d Database) Get(ctx context.Context) *sql.DB {
 	}
 	return db
 }
+
+// ErrStaleFencingToken is returned by [Database.Fenced] when the lease has
+// since been acquired by another holder.
+var ErrStaleFencingToken = errors.New("stale fencing token")
+
+// Fenced runs fn in a transaction that is only committed if the lease has not
+// since been acquired by another holder.
+//
+// The highest fencing token seen for each lease is recorded in the
+// "ftl_fencing_tokens" table, which is created by the first fenced
+// transaction if it does not exist. If a newer token has already been recorded
+// the transaction is rolled back and [ErrStaleFencingToken] is returned. Fenced
+// transactions for the same lease are serialised.
+func (d Database) Fenced(ctx context.Context, lease LeaseHandle, fn func(tx *sql.Tx) error) error {
+	if err := lease.Err(); err != nil {
+		return fmt.Errorf("%s: %w", d, err)
+	}
+	db := d.Get(ctx)
+	if err := createFencingTokensTable(ctx, db); err != nil {
+		return fmt.Errorf("%s: failed to create fencing tokens table: %w", d, err)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: failed to begin transaction: %w", d, err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO ftl_fencing_tokens (lease_key, token)
+		VALUES ($1, $2)
+		ON CONFLICT (lease_key) DO UPDATE
+		SET token = EXCLUDED.token
+		WHERE ftl_fencing_tokens.token <= EXCLUDED.token`,
+		lease.fencingKey(), lease.Token())
+	if err != nil {
+		return fmt.Errorf("%s: failed to check fencing token: %w", d, err)
+	}
+	if rows, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("%s: failed to check fencing token: %w", d, err)
+	} else if rows == 0 {
+		return fmt.Errorf("%s: %w %d", d, ErrStaleFencingToken, lease.Token())
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: failed to commit transaction: %w", d, err)
+	}
+	return nil
+}
+
+// fencingTokensTables records the databases in which the fencing tokens table
+// is known to exist.
+var fencingTokensTables = struct {
+	sync.Mutex
+	created map[*sql.DB]bool
+}{created: map[*sql.DB]bool{}}
+
+// createFencingTokensTable creates the "ftl_fencing_tokens" table once per
+// database.
+//
+// Concurrent "CREATE TABLE IF NOT EXISTS" statements can fail on Postgres
+// catalog conflicts, so creation is serialised within the process by a mutex,
+// and across processes by an advisory lock.
+func createFencingTokensTable(ctx context.Context, db *sql.DB) error {
+	fencingTokensTables.Lock()
+	defer fencingTokensTables.Unlock()
+	if fencingTokensTables.created[db] {
+		return nil
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('ftl_fencing_tokens'))`); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS ftl_fencing_tokens (
+			lease_key TEXT PRIMARY KEY,
+			token BIGINT NOT NULL
+		)`)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	fencingTokensTables.created[db] = true
+	return nil
+}
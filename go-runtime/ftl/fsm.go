@@ -1,8 +1,21 @@
 package ftl
 
-import "github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
 
 type FSMHandle struct {
+	reflection.Ref
 	transitions []FSMTransition
 }
 
@@ -25,5 +38,32 @@ func Transition[FromIn, ToIn any](from Sink[FromIn], to Sink[ToIn]) FSMTransitio
 
 // FSM creates a new finite-state machine.
 func FSM(name string, transitions ...FSMTransition) *FSMHandle {
-	return &FSMHandle{transitions: transitions}
+	return &FSMHandle{Ref: reflection.Ref{Module: callerModule(), Name: name}, transitions: transitions}
+}
+
+func (f *FSMHandle) String() string { return fmt.Sprintf("fsm \"%s\"", f.Ref) }
+
+// Send an event to an instance of the FSM.
+//
+// "instance" must uniquely identify an instance of the FSM. If the instance
+// does not exist it will be created. The event must be the input type of one
+// of the states reachable from the instance's current state, or of a start
+// state for a new instance.
+func (f *FSMHandle) Send(ctx context.Context, instance string, event any) error {
+	body, err := encoding.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%s: failed to marshal event: %w", f, err)
+	}
+	eventType := &schemapb.Type{Value: &schemapb.Type_Ref{Ref: reflection.TypeRefFromValue(event).ToProto()}}
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	_, err = client.SendFSMEvent(ctx, connect.NewRequest(&ftlv1.SendFSMEventRequest{
+		Fsm:      f.Ref.ToProto(),
+		Instance: instance,
+		Event:    eventType,
+		Body:     body,
+	}))
+	if err != nil {
+		return fmt.Errorf("%s: failed to send event: %w", f, err)
+	}
+	return nil
 }
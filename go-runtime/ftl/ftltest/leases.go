@@ -15,8 +15,9 @@ import (
 //
 // It does not include any checks on module names, as it assumes that all leases are within the module being tested
 type fakeLeaseClient struct {
-	lock      sync.Mutex
-	deadlines map[string]time.Time
+	lock          sync.Mutex
+	deadlines     map[string]time.Time
+	fencingTokens int64
 }
 
 var _ modulecontext.LeaseClient = &fakeLeaseClient{}
@@ -31,19 +32,20 @@ func keyForKeys(keys []string) string {
 	return strings.Join(keys, "\n")
 }
 
-func (c *fakeLeaseClient) Acquire(ctx context.Context, module string, key []string, ttl time.Duration) error {
+func (c *fakeLeaseClient) Acquire(ctx context.Context, module string, key []string, ttl time.Duration) (int64, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	k := keyForKeys(key)
 	if deadline, ok := c.deadlines[k]; ok {
 		if time.Now().Before(deadline) {
-			return ftl.ErrLeaseHeld
+			return 0, ftl.ErrLeaseHeld
 		}
 	}
 
 	c.deadlines[k] = time.Now().Add(ttl)
-	return nil
+	c.fencingTokens++
+	return c.fencingTokens, nil
 }
 
 func (c *fakeLeaseClient) Heartbeat(ctx context.Context, module string, key []string, ttl time.Duration) error {
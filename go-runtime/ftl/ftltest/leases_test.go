@@ -21,9 +21,9 @@ func TestDoubleAcquireLease(t *testing.T) {
 	client := newFakeLeaseClient()
 
 	// Acquire a lease, and immediately try to acquire it again.
-	err := client.Acquire(ctx, module, keys1, 1*time.Second)
+	_, err := client.Acquire(ctx, module, keys1, 1*time.Second)
 	assert.NoError(t, err)
-	err = client.Acquire(ctx, module, keys1, 1*time.Second)
+	_, err = client.Acquire(ctx, module, keys1, 1*time.Second)
 	assert.True(t, errors.Is(err, ftl.ErrLeaseHeld), "expected lease to already be held")
 }
 
@@ -31,9 +31,9 @@ func TestAcquireTwoDifferentLeases(t *testing.T) {
 	ctx := context.Background()
 	client := newFakeLeaseClient()
 
-	err := client.Acquire(ctx, module, keys1, 1*time.Second)
+	_, err := client.Acquire(ctx, module, keys1, 1*time.Second)
 	assert.NoError(t, err)
-	err = client.Acquire(ctx, module, keys2, 1*time.Second)
+	_, err = client.Acquire(ctx, module, keys2, 1*time.Second)
 	assert.NoError(t, err)
 }
 
@@ -41,7 +41,7 @@ func TestExpiry(t *testing.T) {
 	ctx := context.Background()
 	client := newFakeLeaseClient()
 
-	err := client.Acquire(ctx, module, keys1, 500*time.Millisecond)
+	_, err := client.Acquire(ctx, module, keys1, 500*time.Millisecond)
 	assert.NoError(t, err)
 	time.Sleep(250 * time.Millisecond)
 	err = client.Heartbeat(ctx, module, keys1, 500*time.Millisecond)
@@ -58,6 +58,19 @@ func TestExpiry(t *testing.T) {
 	assert.Error(t, err, "expected error for heartbeating expired lease")
 
 	// try and acquire again
-	err = client.Acquire(ctx, module, keys1, 1*time.Second)
+	_, err = client.Acquire(ctx, module, keys1, 1*time.Second)
 	assert.NoError(t, err)
 }
+
+func TestFencingTokens(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeLeaseClient()
+
+	token1, err := client.Acquire(ctx, module, keys1, 1*time.Second)
+	assert.NoError(t, err)
+	err = client.Release(ctx, keys1)
+	assert.NoError(t, err)
+	token2, err := client.Acquire(ctx, module, keys1, 1*time.Second)
+	assert.NoError(t, err)
+	assert.True(t, token2 > token1, "expected fencing tokens to increase")
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -26,11 +27,30 @@ var ErrLeaseHeld = fmt.Errorf("lease already held")
 
 type LeaseHandle struct {
 	client modulecontext.LeaseClient
+	module string
 	key    []string
+	token  int64
 	errMu  *sync.Mutex
 	err    error
 }
 
+// Token returns the fencing token of this acquisition of the lease.
+//
+// Tokens increase with every lease acquired, so writes made by a holder that
+// has lost its lease, eg. because it was paused for longer than the TTL, can be
+// told apart from those made by the holder that acquired it since. See
+// [Database.Fenced].
+func (l LeaseHandle) Token() int64 { return l.token }
+
+// fencingKey identifies the lease in the fencing tokens table.
+func (l LeaseHandle) fencingKey() string {
+	parts := []string{"", "module", url.PathEscape(l.module)}
+	for _, part := range l.key {
+		parts = append(parts, url.PathEscape(part))
+	}
+	return strings.Join(parts, "/")
+}
+
 // Err returns an error if the lease heartbeat fails.
 func (l LeaseHandle) Err() error {
 	l.errMu.Lock()
@@ -68,7 +88,7 @@ func Lease(ctx context.Context, ttl time.Duration, key ...string) (LeaseHandle,
 
 	module := reflection.Module()
 	logger.Tracef("Acquiring lease")
-	err := client.Acquire(ctx, module, key, ttl)
+	token, err := client.Acquire(ctx, module, key, ttl)
 	if err != nil {
 		if errors.Is(err, ErrLeaseHeld) {
 			return LeaseHandle{}, ErrLeaseHeld
@@ -77,7 +97,7 @@ func Lease(ctx context.Context, ttl time.Duration, key ...string) (LeaseHandle,
 		return LeaseHandle{}, err
 	}
 
-	lease := LeaseHandle{key: key, errMu: &sync.Mutex{}, client: client}
+	lease := LeaseHandle{module: module, key: key, token: token, errMu: &sync.Mutex{}, client: client}
 	// Heartbeat the lease.
 	go func() {
 		for {
@@ -119,24 +139,24 @@ type leaseClient struct {
 
 var _ modulecontext.LeaseClient = &leaseClient{}
 
-func (c *leaseClient) Acquire(ctx context.Context, module string, key []string, ttl time.Duration) error {
+func (c *leaseClient) Acquire(ctx context.Context, module string, key []string, ttl time.Duration) (int64, error) {
 	c.stream = rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx).AcquireLease(ctx)
 	req := &ftlv1.AcquireLeaseRequest{Key: key, Module: module, Ttl: durationpb.New(ttl)}
 	if err := c.stream.Send(req); err != nil {
 		if connect.CodeOf(err) == connect.CodeResourceExhausted {
-			return ErrLeaseHeld
+			return 0, ErrLeaseHeld
 		}
-		return fmt.Errorf("lease acquisition failed: %w", err)
+		return 0, fmt.Errorf("lease acquisition failed: %w", err)
 	}
 	// Wait for response.
-	_, err := c.stream.Receive()
+	resp, err := c.stream.Receive()
 	if err == nil {
-		return nil
+		return resp.FencingToken, nil
 	}
 	if connect.CodeOf(err) == connect.CodeResourceExhausted {
-		return ErrLeaseHeld
+		return 0, ErrLeaseHeld
 	}
-	return fmt.Errorf("lease acquisition failed: %w", err)
+	return 0, fmt.Errorf("lease acquisition failed: %w", err)
 }
 
 func (c *leaseClient) Heartbeat(ctx context.Context, module string, key []string, ttl time.Duration) error {
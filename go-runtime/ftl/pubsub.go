@@ -0,0 +1,56 @@
+package ftl
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+// Topic declares a topic.
+//
+// Topics publish events, and subscriptions can listen to them.
+func Topic[E any](name string) TopicHandle[E] {
+	return TopicHandle[E]{Ref: reflection.Ref{Module: callerModule(), Name: name}}
+}
+
+// TopicHandle is a typed handle to a topic.
+type TopicHandle[E any] struct {
+	reflection.Ref
+}
+
+func (t TopicHandle[E]) String() string { return fmt.Sprintf("topic \"%s\"", t.Ref) }
+
+// Publish publishes an event to a topic.
+func (t TopicHandle[E]) Publish(ctx context.Context, event E) error {
+	body, err := encoding.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%s: failed to marshal event: %w", t, err)
+	}
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	_, err = client.PublishEvent(ctx, connect.NewRequest(&ftlv1.PublishEventRequest{Topic: t.Ref.ToProto(), Body: body}))
+	if err != nil {
+		return fmt.Errorf("%s: failed to publish event: %w", t, err)
+	}
+	return nil
+}
+
+// SubscriptionHandle is a named cursor into a topic.
+type SubscriptionHandle[E any] struct {
+	Name  string
+	Topic TopicHandle[E]
+}
+
+// Subscription declares a subscription to a topic.
+//
+// Sinks annotated with "//ftl:subscribe <name>" receive the events delivered
+// to the subscription.
+func Subscription[E any](topic TopicHandle[E], name string) SubscriptionHandle[E] {
+	return SubscriptionHandle[E]{Name: name, Topic: topic}
+}
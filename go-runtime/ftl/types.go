@@ -26,3 +26,19 @@ type Source[Resp any] func(context.Context) (Resp, error)
 
 // An Empty is a function that does not accept input or return output.
 type Empty func(context.Context) error
+
+// A StreamVerb is a function that accepts input and sends zero or more outputs
+// to a [Stream].
+type StreamVerb[Req, Resp any] func(context.Context, Req, Stream[Resp]) error
+
+// Stream is the sink that a streaming verb sends its responses to.
+type Stream[T any] interface {
+	// Send a response to the caller.
+	Send(ctx context.Context, msg T) error
+}
+
+// StreamFunc is a [Stream] implemented by a function, eg. for testing
+// streaming verbs.
+type StreamFunc[T any] func(ctx context.Context, msg T) error
+
+func (s StreamFunc[T]) Send(ctx context.Context, msg T) error { return s(ctx, msg) }
@@ -0,0 +1,47 @@
+package ftl
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+// SendToConnection sends a message to a WebSocket connection to one of the
+// module's "//ftl:ingress ws" verbs.
+//
+// [connectionID] is the connection ID of a received builtin.WebSocketMessage.
+// Strings are sent as text messages, byte slices as binary messages, and all
+// other values as JSON encoded text messages.
+func SendToConnection[T any](ctx context.Context, connectionID string, msg T) error {
+	var body []byte
+	binary := false
+	switch msg := any(msg).(type) {
+	case string:
+		body = []byte(msg)
+	case []byte:
+		body = msg
+		binary = true
+	default:
+		var err error
+		body, err = encoding.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("connection %q: failed to marshal message: %w", connectionID, err)
+		}
+	}
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	_, err := client.SendToConnection(ctx, connect.NewRequest(&ftlv1.SendToConnectionRequest{
+		ConnectionId: connectionID,
+		Body:         body,
+		Binary:       binary,
+	}))
+	if err != nil {
+		return fmt.Errorf("connection %q: failed to send message: %w", connectionID, err)
+	}
+	return nil
+}
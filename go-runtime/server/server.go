@@ -60,6 +60,8 @@ func NewUserVerbServer(moduleName string, handlers ...Handler) plugin.Constructo
 type Handler struct {
 	ref reflection.Ref
 	fn  func(ctx context.Context, req []byte) ([]byte, error)
+	// stream is set instead of fn for streaming verbs.
+	stream func(ctx context.Context, req []byte, send func([]byte) error) error
 }
 
 func handler[Req, Resp any](ref reflection.Ref, verb func(ctx context.Context, req Req) (Resp, error)) Handler {
@@ -117,6 +119,33 @@ func HandleEmpty(empty func(ctx context.Context) error) Handler {
 	})
 }
 
+// HandleStream creates a Handler from a streaming Verb.
+func HandleStream[Req, Resp any](verb func(ctx context.Context, req Req, stream ftl.Stream[Resp]) error) Handler {
+	ref := reflection.FuncRef(verb)
+	return Handler{
+		ref: ref,
+		stream: func(ctx context.Context, reqdata []byte, send func([]byte) error) error {
+			var req Req
+			err := encoding.Unmarshal(reqdata, &req)
+			if err != nil {
+				return fmt.Errorf("invalid request to verb %s: %w", ref, err)
+			}
+
+			err = verb(ctx, req, ftl.StreamFunc[Resp](func(ctx context.Context, resp Resp) error {
+				respdata, err := encoding.Marshal(resp)
+				if err != nil {
+					return err
+				}
+				return send(respdata)
+			}))
+			if err != nil {
+				return fmt.Errorf("call to verb %s failed: %w", ref, err)
+			}
+			return nil
+		},
+	}
+}
+
 var _ ftlv1connect.VerbServiceHandler = (*moduleServer)(nil)
 
 // This is the server that is compiled into the same binary as user-defined Verbs.
@@ -147,6 +176,9 @@ func (m *moduleServer) Call(ctx context.Context, req *connect.Request[ftlv1.Call
 	if !ok {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %q not found", req.Msg.Verb))
 	}
+	if handler.fn == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q is a streaming verb", req.Msg.Verb))
+	}
 
 	respdata, err := handler.fn(ctx, req.Msg.Body)
 	if err != nil {
@@ -161,6 +193,44 @@ func (m *moduleServer) Call(ctx context.Context, req *connect.Request[ftlv1.Call
 	}), nil
 }
 
+func (m *moduleServer) ServerStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) (err error) {
+	logger := log.FromContext(ctx)
+	// Recover from panics and send an error ftlv1.CallResponse.
+	defer func() {
+		if r := recover(); r != nil {
+			var rerr error
+			if e, ok := r.(error); ok {
+				rerr = e
+			} else {
+				rerr = fmt.Errorf("%v", r)
+			}
+			stack := string(debug.Stack())
+			logger.Errorf(rerr, "panic in verb %s.%s", req.Msg.Verb.Module, req.Msg.Verb.Name)
+			err = stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{
+				Message: rerr.Error(),
+				Stack:   &stack,
+			}}})
+		}
+	}()
+	handler, ok := m.handlers[reflection.RefFromProto(req.Msg.Verb)]
+	if !ok {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %q not found", req.Msg.Verb))
+	}
+	if handler.stream == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q is not a streaming verb", req.Msg.Verb))
+	}
+
+	err = handler.stream(ctx, req.Msg.Body, func(respdata []byte) error {
+		return stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: respdata}})
+	})
+	if err != nil {
+		return stream.Send(&ftlv1.CallResponse{
+			Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: err.Error()}},
+		})
+	}
+	return nil
+}
+
 func (m *moduleServer) GetModuleContext(ctx context.Context, req *connect.Request[ftlv1.ModuleContextRequest]) (*connect.Response[ftlv1.ModuleContextResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("GetModuleContext not implemented"))
 }
@@ -169,6 +239,18 @@ func (m *moduleServer) AcquireLease(context.Context, *connect.BidiStream[ftlv1.A
 	return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("AcquireLease not implemented"))
 }
 
+func (m *moduleServer) PublishEvent(context.Context, *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("PublishEvent not implemented"))
+}
+
+func (m *moduleServer) SendFSMEvent(context.Context, *connect.Request[ftlv1.SendFSMEventRequest]) (*connect.Response[ftlv1.SendFSMEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("SendFSMEvent not implemented"))
+}
+
+func (m *moduleServer) SendToConnection(context.Context, *connect.Request[ftlv1.SendToConnectionRequest]) (*connect.Response[ftlv1.SendToConnectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("SendToConnection not implemented"))
+}
+
 func (m *moduleServer) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
 	return connect.NewResponse(&ftlv1.PingResponse{}), nil
 }
@@ -3,6 +3,9 @@ package cron
 import (
 	"fmt"
 	"time"
+	// Embed the time zone database so that patterns with time zones can be
+	// evaluated on hosts without one.
+	_ "time/tzdata"
 
 	"github.com/TBD54566975/ftl/internal/slices"
 )
@@ -21,6 +24,13 @@ It supports the following features:
 - ranges with - (eg 1-5)
 - steps with / (eg 1-5/2)
 - lists with , (eg 1,2,3)
+- a time zone prefix (eg TZ=Australia/Sydney 0 9 * * 1-5), defaulting to UTC
+- the descriptors @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly
+- fixed intervals with @every (eg @every 15m), aligned to the Unix epoch
+
+Patterns with a time zone match wall clock times in that time zone:
+- wall clock times skipped when clocks go forward match at the instant clocks go forward
+- wall clock times repeated when clocks go back only match at their first occurrence
 */
 
 type componentType int
@@ -57,7 +67,7 @@ func Next(pattern Pattern, allowCurrentTime bool) (time.Time, error) {
 
 // NextAfter calculcates the next time that matches the pattern after the origin time
 // If inclusive is true, the origin time is considered a valid match
-// All calculations are done in UTC, and the result is returned in UTC
+// Calculations are done in the pattern's time zone, and the result is returned in UTC
 func NextAfter(pattern Pattern, origin time.Time, inclusive bool) (time.Time, error) {
 	// set original to the first acceptable time, irregardless of pattern
 	origin = origin.UTC()
@@ -65,6 +75,19 @@ func NextAfter(pattern Pattern, origin time.Time, inclusive bool) (time.Time, er
 		origin = origin.Add(time.Second - time.Duration(origin.Nanosecond())*time.Nanosecond)
 	}
 
+	if pattern.Descriptor == "@every" {
+		interval, err := pattern.interval()
+		if err != nil {
+			return origin, err
+		}
+		return nextInterval(interval, origin), nil
+	}
+
+	loc, err := pattern.location()
+	if err != nil {
+		return origin, err
+	}
+
 	components, err := pattern.standardizedComponents()
 	if err != nil {
 		return origin, err
@@ -76,6 +99,103 @@ func NextAfter(pattern Pattern, origin time.Time, inclusive bool) (time.Time, er
 		}
 	}
 
+	// Components match wall clock times in the pattern's time zone, which are
+	// represented as UTC times until they are resolved to an instant.
+	wall := wallTime(origin, loc)
+	for {
+		next, ok := nextWallTime(components, wall)
+		if !ok {
+			return origin, fmt.Errorf("could not find next time for pattern %q", pattern.String())
+		}
+		result := resolveWallTime(next, loc)
+		if !result.Before(origin) {
+			return result.UTC(), nil
+		}
+		// Clocks went back since the wall clock time first occurred.
+		wall = next.Add(time.Second)
+	}
+}
+
+// minIntervalSamples is the number of executions sampled by [MinInterval].
+const minIntervalSamples = 100
+
+// MinInterval returns the shortest interval between consecutive executions of
+// a pattern.
+//
+// This is exact for "@every" patterns. For other patterns it is the shortest
+// interval between the next [minIntervalSamples] executions after origin.
+func MinInterval(pattern Pattern, origin time.Time) (time.Duration, error) {
+	if pattern.Descriptor == "@every" {
+		return pattern.interval()
+	}
+	previous, err := NextAfter(pattern, origin, false)
+	if err != nil {
+		return 0, err
+	}
+	var shortest time.Duration
+	for range minIntervalSamples {
+		next, err := NextAfter(pattern, previous, false)
+		if err != nil {
+			return 0, err
+		}
+		if interval := next.Sub(previous); shortest == 0 || interval < shortest {
+			shortest = interval
+		}
+		previous = next
+	}
+	return shortest, nil
+}
+
+// nextInterval returns the first multiple of interval since the Unix epoch at
+// or after origin, so that all controllers agree on the schedule.
+func nextInterval(interval time.Duration, origin time.Time) time.Time {
+	seconds := int64(interval / time.Second)
+	next := origin.Unix()
+	if remainder := ((next % seconds) + seconds) % seconds; remainder != 0 {
+		next += seconds - remainder
+	}
+	return time.Unix(next, 0).UTC()
+}
+
+// wallTime returns the wall clock time of t in loc, as a UTC time.
+func wallTime(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// resolveWallTime returns the first instant at which clocks in loc show a wall
+// clock time.
+//
+// Wall clock times skipped when clocks go forward resolve to the instant
+// clocks went forward.
+func resolveWallTime(wall time.Time, loc *time.Location) time.Time {
+	t := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+	switch shown := wallTime(t, loc); {
+	case shown.After(wall):
+		// Skipped, and t is after clocks went forward.
+		start, _ := t.ZoneBounds()
+		return start
+	case shown.Before(wall):
+		// Skipped, and t is before clocks went forward.
+		_, end := t.ZoneBounds()
+		return end
+	}
+	// Clocks may have shown the same time before they went back.
+	start, _ := t.ZoneBounds()
+	if start.IsZero() {
+		return t
+	}
+	_, offset := t.Zone()
+	_, previousOffset := start.Add(-time.Second).Zone()
+	if earlier := t.Add(time.Duration(offset-previousOffset) * time.Second); earlier.Before(start) {
+		return earlier
+	}
+	return t
+}
+
+// nextWallTime calculates the first wall clock time at or after origin that
+// matches the standardized components.
+func nextWallTime(components []Component, origin time.Time) (time.Time, bool) {
 	// dayOfMonth used to represent processing day, using dayOfMonth and dayOfWeek
 	processingOrder := []componentType{year, month, dayOfMonth, hour, minute, second}
 
@@ -89,7 +209,7 @@ func NextAfter(pattern Pattern, origin time.Time, inclusive bool) (time.Time, er
 		}
 	}
 	if firstDisallowedIdx == -1 {
-		return timeFromValues(values), nil
+		return timeFromValues(values), true
 	}
 
 	i := firstDisallowedIdx
@@ -123,10 +243,10 @@ func NextAfter(pattern Pattern, origin time.Time, inclusive bool) (time.Time, er
 			continue
 		}
 
-		return timeFromValues(values), nil
+		return timeFromValues(values), true
 	}
 
-	return origin, fmt.Errorf("could not find next time for pattern %q", pattern.String())
+	return time.Time{}, false
 }
 
 func componentValuesFromTime(t time.Time) componentValues {
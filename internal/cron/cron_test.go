@@ -31,6 +31,13 @@ func TestParsingAndValidationErrors(t *testing.T) {
 		{"* * * * * 1999", "could not find next time for pattern \"* * * * * 1999\""},
 		{"* * * * * * 1999", "could not find next time for pattern \"* * * * * * 1999\""},
 		{"* * * 29 2 * 2021", "could not find next time for pattern \"* * * 29 2 * 2021\""},
+		{"TZ=Mars/Olympus_Mons 0 9 * * *", "unknown time zone \"Mars/Olympus_Mons\""},
+		{"@fortnightly", "unknown descriptor \"@fortnightly\""},
+		{"@daily 1h", "@daily does not take an interval"},
+		{"@every", "@every requires an interval, eg. \"@every 15m\""},
+		{"@every 500ms", "interval 500ms must be at least 1s"},
+		{"@every 1500ms", "interval 1.5s must be a whole number of seconds"},
+		{"TZ=Australia/Sydney @every 15m", "time zone can not be used with @every"},
 	} {
 		t.Run(fmt.Sprintf("CronValidation:%s", tt.str), func(t *testing.T) {
 			_, err := Parse(tt.str)
@@ -225,3 +232,151 @@ func TestSeries(t *testing.T) {
 		})
 	}
 }
+
+func TestDescriptors(t *testing.T) {
+	for _, tt := range []struct {
+		descriptor string
+		equivalent string
+	}{
+		{"@yearly", "0 0 1 1 *"},
+		{"@annually", "0 0 1 1 *"},
+		{"@monthly", "0 0 1 * *"},
+		{"@weekly", "0 0 * * 0"},
+		{"@daily", "0 0 * * *"},
+		{"@midnight", "0 0 * * *"},
+		{"@hourly", "0 * * * *"},
+	} {
+		t.Run(tt.descriptor, func(t *testing.T) {
+			pattern, err := Parse(tt.descriptor)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.descriptor, pattern.String())
+			equivalent, err := Parse(tt.equivalent)
+			assert.NoError(t, err)
+
+			value := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+			for range 10 {
+				next, err := NextAfter(pattern, value, false)
+				assert.NoError(t, err)
+				expected, err := NextAfter(equivalent, value, false)
+				assert.NoError(t, err)
+				assert.Equal(t, expected, next)
+				value = next
+			}
+		})
+	}
+}
+
+func TestEvery(t *testing.T) {
+	pattern, err := Parse("@every 15m")
+	assert.NoError(t, err)
+	assert.Equal(t, "@every 15m", pattern.String())
+
+	// Intervals are aligned to the Unix epoch.
+	next, err := NextAfter(pattern, time.Date(2024, 1, 1, 10, 7, 12, 300, time.UTC), false)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC), next)
+	next, err = NextAfter(pattern, next, false)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC), next)
+	next, err = NextAfter(pattern, next, true)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC), next)
+
+	pattern, err = Parse("@every 1h30m")
+	assert.NoError(t, err)
+	next, err = NextAfter(pattern, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 1, 30, 0, 0, time.UTC), next)
+}
+
+func TestMinInterval(t *testing.T) {
+	origin := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		pattern  string
+		expected time.Duration
+	}{
+		{"@every 90s", time.Second * 90},
+		{"*/5 * * * *", time.Minute * 5},
+		{"0,1 * * * * * *", time.Second},
+		{"0 9 * * 1-5", time.Hour * 24},
+		{"@hourly", time.Hour},
+	} {
+		t.Run(tt.pattern, func(t *testing.T) {
+			pattern, err := Parse(tt.pattern)
+			assert.NoError(t, err)
+			interval, err := MinInterval(pattern, origin)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, interval)
+		})
+	}
+}
+
+func TestTimeZones(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	assert.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	for _, tt := range []struct {
+		name     string
+		str      string
+		input    time.Time
+		expected []time.Time
+	}{
+		{"WeekdaysInSydney", "TZ=Australia/Sydney 0 9 * * 1-5",
+			// Clocks go forward from 2am to 3am on Sunday 6 October 2024.
+			time.Date(2024, 10, 3, 12, 0, 0, 0, sydney),
+			[]time.Time{
+				time.Date(2024, 10, 4, 9, 0, 0, 0, sydney),
+				time.Date(2024, 10, 7, 9, 0, 0, 0, sydney),
+				time.Date(2024, 10, 8, 9, 0, 0, 0, sydney),
+			}},
+		{"SkippedWhenClocksGoForward", "TZ=Australia/Sydney 30 2 * * *",
+			time.Date(2024, 10, 5, 12, 0, 0, 0, sydney),
+			[]time.Time{
+				// 2:30am does not exist, so runs when clocks go forward.
+				time.Date(2024, 10, 6, 3, 0, 0, 0, sydney),
+				time.Date(2024, 10, 7, 2, 30, 0, 0, sydney),
+			}},
+		{"EveryMinuteWhenClocksGoForward", "TZ=America/New_York * * * * *",
+			// Clocks go forward from 2am to 3am on Sunday 10 March 2024.
+			time.Date(2024, 3, 10, 1, 58, 30, 0, newYork),
+			[]time.Time{
+				time.Date(2024, 3, 10, 1, 59, 0, 0, newYork),
+				time.Date(2024, 3, 10, 3, 0, 0, 0, newYork),
+				time.Date(2024, 3, 10, 3, 1, 0, 0, newYork),
+			}},
+		{"RepeatedWhenClocksGoBack", "TZ=America/New_York 30 1 * * *",
+			// Clocks go back from 2am to 1am on Sunday 3 November 2024.
+			time.Date(2024, 11, 2, 12, 0, 0, 0, newYork),
+			[]time.Time{
+				time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC), // 1:30am EDT
+				time.Date(2024, 11, 4, 6, 30, 0, 0, time.UTC), // 1:30am EST
+			}},
+		{"HourlyWhenClocksGoBack", "TZ=America/New_York @hourly",
+			time.Date(2024, 11, 3, 0, 30, 0, 0, newYork),
+			[]time.Time{
+				time.Date(2024, 11, 3, 5, 0, 0, 0, time.UTC), // 1am EDT
+				time.Date(2024, 11, 3, 7, 0, 0, 0, time.UTC), // 2am EST
+				time.Date(2024, 11, 3, 8, 0, 0, 0, time.UTC), // 3am EST
+			}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, err := Parse(tt.str)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.str, pattern.String())
+			value := tt.input
+			for _, expected := range tt.expected {
+				value, err = NextAfter(pattern, value, false)
+				assert.NoError(t, err)
+				assert.Equal(t, expected.UTC(), value, "NextAfter(%q) = %v; want %v", tt.str, value.In(expected.Location()), expected)
+			}
+		})
+	}
+
+	// Starting within the repeated hour when clocks go back.
+	pattern, err := Parse("TZ=America/New_York 30 1 * * *")
+	assert.NoError(t, err)
+	next, err := NextAfter(pattern, time.Date(2024, 11, 3, 6, 15, 0, 0, time.UTC), false) // 1:15am EST
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 11, 4, 6, 30, 0, 0, time.UTC), next)
+}
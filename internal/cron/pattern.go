@@ -15,6 +15,9 @@ import (
 var (
 	lex = lexer.MustSimple([]lexer.SimpleRule{
 		{Name: "Whitespace", Pattern: `\s+`},
+		{Name: "TimeZone", Pattern: `TZ=\S+`},
+		{Name: "Descriptor", Pattern: `@[a-z]+`},
+		{Name: "Duration", Pattern: `(?:[0-9]+(?:\.[0-9]+)?(?:ns|us|µs|ms|s|m|h))+`},
 		{Name: "Ident", Pattern: `\b[a-zA-Z_][a-zA-Z0-9_]*\b`},
 		{Name: "Comment", Pattern: `//.*`},
 		{Name: "String", Pattern: `"(?:\\.|[^"])*"`},
@@ -30,22 +33,102 @@ var (
 			token.Value = strings.TrimSpace(strings.TrimPrefix(token.Value, "//"))
 			return token, nil
 		}, "Comment"),
+
+		participle.Map(func(token lexer.Token) (lexer.Token, error) {
+			token.Value = strings.TrimPrefix(token.Value, "TZ=")
+			return token, nil
+		}, "TimeZone"),
 	}
 
 	parser = participle.MustBuild[Pattern](parserOptions...)
 )
 
+// descriptors are the standard descriptors that can be used in place of
+// components, except for "@every".
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 * *",
+	"@annually": "0 0 0 1 1 * *",
+	"@monthly":  "0 0 0 1 * * *",
+	"@weekly":   "0 0 0 * * 0 *",
+	"@daily":    "0 0 0 * * * *",
+	"@midnight": "0 0 0 * * * *",
+	"@hourly":   "0 0 * * * * *",
+}
+
 type Pattern struct {
-	Components []Component `parser:"@@*"`
+	// TimeZone is the IANA time zone the pattern is evaluated in, eg.
+	// "Australia/Sydney". Defaults to UTC.
+	TimeZone   string      `parser:"@TimeZone?"`
+	Descriptor string      `parser:"(@Descriptor"`
+	Interval   string      `parser:"  @Duration?"`
+	Components []Component `parser:"| @@*)"`
 }
 
 func (p Pattern) String() string {
-	return strings.Join(slices.Map(p.Components, func(component Component) string {
+	var parts []string
+	if p.TimeZone != "" {
+		parts = append(parts, "TZ="+p.TimeZone)
+	}
+	if p.Descriptor != "" {
+		parts = append(parts, p.Descriptor)
+		if p.Interval != "" {
+			parts = append(parts, p.Interval)
+		}
+		return strings.Join(parts, " ")
+	}
+	return strings.Join(append(parts, slices.Map(p.Components, func(component Component) string {
 		return component.String()
-	}), " ")
+	})...), " ")
+}
+
+// location returns the time zone the pattern is evaluated in.
+func (p Pattern) location() (*time.Location, error) {
+	if p.TimeZone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(p.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", p.TimeZone)
+	}
+	return loc, nil
+}
+
+// interval returns the interval of an "@every" pattern.
+func (p Pattern) interval() (time.Duration, error) {
+	if p.TimeZone != "" {
+		return 0, fmt.Errorf("time zone can not be used with @every")
+	}
+	if p.Interval == "" {
+		return 0, fmt.Errorf("@every requires an interval, eg. \"@every 15m\"")
+	}
+	interval, err := time.ParseDuration(p.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval %q: %w", p.Interval, err)
+	}
+	if interval < time.Second {
+		return 0, fmt.Errorf("interval %s must be at least 1s", interval)
+	}
+	if interval%time.Second != 0 {
+		return 0, fmt.Errorf("interval %s must be a whole number of seconds", interval)
+	}
+	return interval, nil
 }
 
 func (p Pattern) standardizedComponents() ([]Component, error) {
+	if p.Descriptor != "" {
+		expanded, ok := descriptors[p.Descriptor]
+		if !ok {
+			return nil, fmt.Errorf("unknown descriptor %q", p.Descriptor)
+		}
+		if p.Interval != "" {
+			return nil, fmt.Errorf("%s does not take an interval", p.Descriptor)
+		}
+		pattern, err := parser.ParseString("", expanded)
+		if err != nil {
+			return nil, err
+		}
+		return pattern.Components, nil
+	}
 	switch len(p.Components) {
 	case 5:
 		// Convert "a b c d e" -> "0 a b c d e *"
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+// ConnectionKey identifies a WebSocket connection to an ingress verb.
+type ConnectionKey = KeyType[ConnectionPayload, *ConnectionPayload]
+
+func NewConnectionKey(module string) ConnectionKey { return newKey[ConnectionPayload](module) }
+
+func ParseConnectionKey(key string) (ConnectionKey, error) {
+	return parseKey[ConnectionPayload](key)
+}
+
+type ConnectionPayload struct {
+	Module string
+}
+
+var _ KeyPayload = (*ConnectionPayload)(nil)
+
+func (c *ConnectionPayload) Kind() string   { return "con" }
+func (c *ConnectionPayload) String() string { return c.Module }
+func (c *ConnectionPayload) Parse(parts []string) error {
+	if len(parts) == 0 {
+		return errors.New("expected <module> but got empty string")
+	}
+	c.Module = strings.Join(parts, "-")
+	return nil
+}
+func (c *ConnectionPayload) RandomBytes() int { return 12 }
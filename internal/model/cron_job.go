@@ -13,6 +13,19 @@ const (
 	CronJobStateExecuting = "executing"
 )
 
+// CronJobConcurrency is what to do when a cron job is due while its previous
+// execution is still running.
+type CronJobConcurrency string
+
+const (
+	// CronJobConcurrencyForbid skips the execution.
+	CronJobConcurrencyForbid CronJobConcurrency = "forbid"
+	// CronJobConcurrencyAllow runs the executions concurrently.
+	CronJobConcurrencyAllow CronJobConcurrency = "allow"
+	// CronJobConcurrencyReplace cancels the previous execution.
+	CronJobConcurrencyReplace CronJobConcurrency = "replace"
+)
+
 type CronJob struct {
 	Key           CronJobKey
 	DeploymentKey DeploymentKey
@@ -21,4 +34,13 @@ type CronJob struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         CronJobState
+	// Paused jobs are not executed until resumed.
+	Paused bool
+	// Concurrency is the policy for overlapping executions.
+	Concurrency CronJobConcurrency
+	// Jitter is the maximum random delay added to each execution.
+	Jitter time.Duration
+	// CatchupLimit is the maximum number of executions missed while no
+	// controller was running to run.
+	CatchupLimit int
 }
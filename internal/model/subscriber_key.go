@@ -0,0 +1,31 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+type SubscriberKey = KeyType[SubscriberPayload, *SubscriberPayload]
+
+func NewSubscriberKey(module, subscription, sink string) SubscriberKey {
+	return newKey[SubscriberPayload](strings.Join([]string{module, subscription, sink}, "-"))
+}
+
+func ParseSubscriberKey(key string) (SubscriberKey, error) { return parseKey[SubscriberPayload](key) }
+
+type SubscriberPayload struct {
+	Ref string
+}
+
+var _ KeyPayload = (*SubscriberPayload)(nil)
+
+func (s *SubscriberPayload) Kind() string   { return "subr" }
+func (s *SubscriberPayload) String() string { return s.Ref }
+func (s *SubscriberPayload) Parse(parts []string) error {
+	if len(parts) == 0 {
+		return errors.New("expected <module>-<subscription>-<sink> but got empty string")
+	}
+	s.Ref = strings.Join(parts, "-")
+	return nil
+}
+func (s *SubscriberPayload) RandomBytes() int { return 10 }
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+type SubscriptionKey = KeyType[SubscriptionPayload, *SubscriptionPayload]
+
+func NewSubscriptionKey(module, subscription string) SubscriptionKey {
+	return newKey[SubscriptionPayload](strings.Join([]string{module, subscription}, "-"))
+}
+
+func ParseSubscriptionKey(key string) (SubscriptionKey, error) {
+	return parseKey[SubscriptionPayload](key)
+}
+
+type SubscriptionPayload struct {
+	Ref string
+}
+
+var _ KeyPayload = (*SubscriptionPayload)(nil)
+
+func (s *SubscriptionPayload) Kind() string   { return "sub" }
+func (s *SubscriptionPayload) String() string { return s.Ref }
+func (s *SubscriptionPayload) Parse(parts []string) error {
+	if len(parts) == 0 {
+		return errors.New("expected <module>-<subscription> but got empty string")
+	}
+	s.Ref = strings.Join(parts, "-")
+	return nil
+}
+func (s *SubscriptionPayload) RandomBytes() int { return 10 }
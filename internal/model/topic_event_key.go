@@ -0,0 +1,31 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+type TopicEventKey = KeyType[TopicEventPayload, *TopicEventPayload]
+
+func NewTopicEventKey(module, topic string) TopicEventKey {
+	return newKey[TopicEventPayload](strings.Join([]string{module, topic}, "-"))
+}
+
+func ParseTopicEventKey(key string) (TopicEventKey, error) { return parseKey[TopicEventPayload](key) }
+
+type TopicEventPayload struct {
+	Ref string
+}
+
+var _ KeyPayload = (*TopicEventPayload)(nil)
+
+func (t *TopicEventPayload) Kind() string   { return "evt" }
+func (t *TopicEventPayload) String() string { return t.Ref }
+func (t *TopicEventPayload) Parse(parts []string) error {
+	if len(parts) == 0 {
+		return errors.New("expected <module>-<topic> but got empty string")
+	}
+	t.Ref = strings.Join(parts, "-")
+	return nil
+}
+func (t *TopicEventPayload) RandomBytes() int { return 12 }
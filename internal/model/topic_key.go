@@ -0,0 +1,31 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+type TopicKey = KeyType[TopicPayload, *TopicPayload]
+
+func NewTopicKey(module, topic string) TopicKey {
+	return newKey[TopicPayload](strings.Join([]string{module, topic}, "-"))
+}
+
+func ParseTopicKey(key string) (TopicKey, error) { return parseKey[TopicPayload](key) }
+
+type TopicPayload struct {
+	Ref string
+}
+
+var _ KeyPayload = (*TopicPayload)(nil)
+
+func (t *TopicPayload) Kind() string   { return "top" }
+func (t *TopicPayload) String() string { return t.Ref }
+func (t *TopicPayload) Parse(parts []string) error {
+	if len(parts) == 0 {
+		return errors.New("expected <module>-<topic> but got empty string")
+	}
+	t.Ref = strings.Join(parts, "-")
+	return nil
+}
+func (t *TopicPayload) RandomBytes() int { return 10 }
@@ -146,8 +146,9 @@ func (m ModuleContext) GetDatabase(name string, dbType DBType) (*sql.DB, error)
 
 // LeaseClient is the interface for acquiring, heartbeating and releasing leases
 type LeaseClient interface {
-	// Returns ResourceExhausted if the lease is held.
-	Acquire(ctx context.Context, module string, key []string, ttl time.Duration) error
+	// Returns ResourceExhausted if the lease is held, otherwise the fencing
+	// token of this acquisition of the lease.
+	Acquire(ctx context.Context, module string, key []string, ttl time.Duration) (fencingToken int64, err error)
 	Heartbeat(ctx context.Context, module string, key []string, ttl time.Duration) error
 	Release(ctx context.Context, key []string) error
 }